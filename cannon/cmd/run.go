@@ -80,6 +80,18 @@ var (
 		Name:  "pprof.cpu",
 		Usage: "enable pprof cpu profiling",
 	}
+	RunPProfGuestFlag = &cli.PathFlag{
+		Name:      "pprof.guest",
+		Usage:     "path of pprof output file to profile the guest program with. Requires --meta for symbol names. Not profiled if empty.",
+		TakesFile: true,
+		Required:  false,
+	}
+	RunPProfGuestIntervalFlag = &cli.Uint64Flag{
+		Name:     "pprof.guest-interval",
+		Usage:    "number of steps between guest program profile samples",
+		Value:    1000,
+		Required: false,
+	}
 )
 
 type Proof struct {
@@ -284,6 +296,12 @@ func Run(ctx *cli.Context) error {
 		stepFn = Guard(po.cmd.ProcessState, stepFn)
 	}
 
+	var guestProf *mipsevm.GuestProfiler
+	guestProfPath := ctx.Path(RunPProfGuestFlag.Name)
+	if guestProfPath != "" {
+		guestProf = mipsevm.NewGuestProfiler(meta, ctx.Uint64(RunPProfGuestIntervalFlag.Name))
+	}
+
 	start := time.Now()
 	startStep := state.Step
 
@@ -320,6 +338,10 @@ func Run(ctx *cli.Context) error {
 			break
 		}
 
+		if guestProf != nil {
+			guestProf.Observe(state)
+		}
+
 		if snapshotAt(state) {
 			if err := writeJSON(fmt.Sprintf(snapshotFmt, step), state); err != nil {
 				return fmt.Errorf("failed to write state snapshot: %w", err)
@@ -365,9 +387,26 @@ func Run(ctx *cli.Context) error {
 	if err := writeJSON(ctx.Path(RunOutputFlag.Name), state); err != nil {
 		return fmt.Errorf("failed to write state output: %w", err)
 	}
+	if guestProf != nil {
+		if err := writeGuestProfile(guestProfPath, guestProf); err != nil {
+			return fmt.Errorf("failed to write guest profile: %w", err)
+		}
+	}
 	return nil
 }
 
+func writeGuestProfile(path string, prof *mipsevm.GuestProfiler) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open guest profile output file: %w", err)
+	}
+	if err := prof.WriteProfile(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 var RunCommand = &cli.Command{
 	Name:        "run",
 	Usage:       "Run VM step(s) and generate proof data to replicate onchain.",
@@ -384,5 +423,7 @@ var RunCommand = &cli.Command{
 		RunMetaFlag,
 		RunInfoAtFlag,
 		RunPProfCPU,
+		RunPProfGuestFlag,
+		RunPProfGuestIntervalFlag,
 	},
 }
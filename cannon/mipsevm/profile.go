@@ -0,0 +1,161 @@
+package mipsevm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/pprof/profile"
+)
+
+// maxProfileStackDepth bounds the tracked guest call stack,
+// to not grow without limit when calls and returns do not pair up (e.g. goroutine switches).
+const maxProfileStackDepth = 1024
+
+type profileFrame struct {
+	// callSite is the PC of the call instruction
+	callSite uint32
+	// returnAddr is where the callee is expected to return to
+	returnAddr uint32
+}
+
+type profileSample struct {
+	pcs   []uint32 // leaf first
+	count int64
+}
+
+// GuestProfiler samples the PC and call stack of the guest program every N steps,
+// and produces a pprof-compatible profile, symbolized with the program metadata.
+//
+// The call stack is tracked by observing call (jal, jalr) and return (jr $ra) instructions,
+// which is how the Go MIPS compiler emits function calls.
+type GuestProfiler struct {
+	meta     *Metadata
+	interval uint64
+
+	stack   []profileFrame
+	samples map[string]*profileSample
+}
+
+func NewGuestProfiler(meta *Metadata, interval uint64) *GuestProfiler {
+	if interval == 0 {
+		interval = 1
+	}
+	return &GuestProfiler{
+		meta:     meta,
+		interval: interval,
+		samples:  make(map[string]*profileSample),
+	}
+}
+
+// Observe must be called with the state before every step, to track the call stack and take samples.
+func (p *GuestProfiler) Observe(state *State) {
+	if state.Step%p.interval == 0 {
+		p.sample(state.PC)
+	}
+
+	insn := state.Memory.GetMemory(state.PC)
+	opcode := insn >> 26
+	switch {
+	case opcode == 3: // jal
+		p.push(state.PC)
+	case opcode == 0 && insn&0x3F == 9: // jalr
+		p.push(state.PC)
+	case opcode == 0 && insn&0x3F == 8 && (insn>>21)&0x1F == 31: // jr $ra
+		p.pop(state.Registers[31])
+	}
+}
+
+func (p *GuestProfiler) push(pc uint32) {
+	if len(p.stack) >= maxProfileStackDepth {
+		// drop the outermost frame, the innermost frames are the most useful
+		copy(p.stack, p.stack[1:])
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+	p.stack = append(p.stack, profileFrame{callSite: pc, returnAddr: pc + 8})
+}
+
+func (p *GuestProfiler) pop(target uint32) {
+	// Unwind to the frame that matches the return target, if any.
+	// Otherwise, e.g. after a goroutine switch, just drop the innermost frame.
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		if p.stack[i].returnAddr == target {
+			p.stack = p.stack[:i]
+			return
+		}
+	}
+	if len(p.stack) > 0 {
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+}
+
+func (p *GuestProfiler) sample(pc uint32) {
+	pcs := make([]uint32, 0, len(p.stack)+1)
+	pcs = append(pcs, pc)
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		pcs = append(pcs, p.stack[i].callSite)
+	}
+	var key strings.Builder
+	for _, v := range pcs {
+		key.WriteString(HexU32(v).String())
+	}
+	if s, ok := p.samples[key.String()]; ok {
+		s.count += 1
+	} else {
+		p.samples[key.String()] = &profileSample{pcs: pcs, count: 1}
+	}
+}
+
+// Profile builds the pprof profile of all samples taken so far.
+func (p *GuestProfiler) Profile() *profile.Profile {
+	prof := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "samples", Unit: "count"},
+			{Type: "steps", Unit: "count"},
+		},
+		PeriodType: &profile.ValueType{Type: "steps", Unit: "count"},
+		Period:     int64(p.interval),
+	}
+	functions := make(map[string]*profile.Function)
+	locations := make(map[uint32]*profile.Location)
+	location := func(pc uint32) *profile.Location {
+		if loc, ok := locations[pc]; ok {
+			return loc
+		}
+		name := p.meta.LookupSymbol(pc)
+		fn, ok := functions[name]
+		if !ok {
+			fn = &profile.Function{ID: uint64(len(prof.Function) + 1), Name: name, SystemName: name}
+			functions[name] = fn
+			prof.Function = append(prof.Function, fn)
+		}
+		loc := &profile.Location{
+			ID:      uint64(len(prof.Location) + 1),
+			Address: uint64(pc),
+			Line:    []profile.Line{{Function: fn}},
+		}
+		locations[pc] = loc
+		prof.Location = append(prof.Location, loc)
+		return loc
+	}
+	for _, s := range p.samples {
+		locs := make([]*profile.Location, len(s.pcs))
+		for i, pc := range s.pcs {
+			locs[i] = location(pc)
+		}
+		prof.Sample = append(prof.Sample, &profile.Sample{
+			Location: locs,
+			Value:    []int64{s.count, s.count * int64(p.interval)},
+		})
+	}
+	return prof
+}
+
+// WriteProfile writes the gzip-compressed pprof profile of all samples taken so far.
+func (p *GuestProfiler) WriteProfile(w io.Writer) error {
+	prof := p.Profile()
+	if err := prof.CheckValid(); err != nil {
+		return fmt.Errorf("invalid guest profile: %w", err)
+	}
+	return prof.Write(w)
+}
@@ -0,0 +1,65 @@
+package mipsevm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestProfiler(t *testing.T) {
+	meta := &Metadata{Symbols: []Symbol{
+		{Name: "main.main", Start: 0x1000, Size: 0x100},
+		{Name: "main.callee", Start: 0x2000, Size: 0x100},
+	}}
+	state := &State{Memory: NewMemory()}
+	state.Memory.SetMemory(0x1000, 0x0C000800) // jal 0x2000
+	state.Memory.SetMemory(0x2000, 0x00000000) // nop
+	state.Memory.SetMemory(0x2004, 0x03E00008) // jr $ra
+
+	prof := NewGuestProfiler(meta, 1)
+	observe := func(pc uint32) {
+		state.PC = pc
+		prof.Observe(state)
+		state.Step += 1
+	}
+	observe(0x1000)
+	require.Len(t, prof.stack, 1)
+	observe(0x2000)
+	state.Registers[31] = 0x1008
+	observe(0x2004)
+	require.Empty(t, prof.stack)
+
+	var buf bytes.Buffer
+	require.NoError(t, prof.WriteProfile(&buf))
+	parsed, err := profile.Parse(&buf)
+	require.NoError(t, err)
+	require.Len(t, parsed.Sample, 3)
+
+	stacks := make(map[string]int)
+	for _, s := range parsed.Sample {
+		var names string
+		for _, loc := range s.Location {
+			names += loc.Line[0].Function.Name + ";"
+		}
+		stacks[names] += int(s.Value[0])
+	}
+	require.Equal(t, 1, stacks["main.main;"])
+	require.Equal(t, 2, stacks["main.callee;main.main;"])
+}
+
+func TestGuestProfilerUnmatchedReturn(t *testing.T) {
+	state := &State{Memory: NewMemory()}
+	state.Memory.SetMemory(0x1000, 0x03E00008) // jr $ra
+	prof := NewGuestProfiler(&Metadata{}, 100)
+	prof.push(0x100)
+	prof.push(0x200)
+	state.PC = 0x1000
+	state.Registers[31] = 0x108
+	prof.Observe(state)
+	require.Empty(t, prof.stack, "unwinds to matching frame")
+	prof.push(0x100)
+	prof.Observe(state) // no frame matches, still pops one
+	require.Empty(t, prof.stack)
+}
@@ -31,6 +31,30 @@ export interface DepositResponse {
   hasNextPage: boolean;
   items: DepositItem[];
 }
+/**
+ * NFTDepositItem ... NFT deposit item model for API responses
+ */
+export interface NFTDepositItem {
+  guid: string;
+  from: string;
+  to: string;
+  timestamp: number /* uint64 */;
+  l1BlockHash: string;
+  l1TxHash: string;
+  l2TxHash: string;
+  crossDomainMessageHash: string;
+  l1TokenAddress: string;
+  l2TokenAddress: string;
+  tokenId: string;
+}
+/**
+ * NFTDepositResponse ... Data model for API JSON response
+ */
+export interface NFTDepositResponse {
+  cursor: string;
+  hasNextPage: boolean;
+  items: NFTDepositItem[];
+}
 /**
  * WithdrawalItem ... Data model for API JSON response
  */
@@ -47,6 +71,12 @@ export interface WithdrawalItem {
   l1FinalizedTxHash: string;
   l1TokenAddress: string;
   l2TokenAddress: string;
+  /**
+   * Fault proofs. Only set for withdrawals proven against a dispute game
+   */
+  provenDisputeGameAddress: string;
+  provenDisputeGameStatus: string;
+  finalizableTimestamp: number /* uint64 */;
 }
 /**
  * WithdrawalResponse ... Data model for API JSON response
@@ -56,6 +86,85 @@ export interface WithdrawalResponse {
   hasNextPage: boolean;
   items: WithdrawalItem[];
 }
+/**
+ * NFTWithdrawalItem ... Data model for API JSON response
+ */
+export interface NFTWithdrawalItem {
+  guid: string;
+  from: string;
+  to: string;
+  transactionHash: string;
+  crossDomainMessageHash: string;
+  timestamp: number /* uint64 */;
+  l2BlockHash: string;
+  l1ProvenTxHash: string;
+  l1FinalizedTxHash: string;
+  l1TokenAddress: string;
+  l2TokenAddress: string;
+  tokenId: string;
+}
+/**
+ * NFTWithdrawalResponse ... Data model for API JSON response
+ */
+export interface NFTWithdrawalResponse {
+  cursor: string;
+  hasNextPage: boolean;
+  items: NFTWithdrawalItem[];
+}
+/**
+ * Bridge transaction types & lifecycle statuses
+ */
+export const TransactionTypeDeposit = "deposit";
+export const TransactionTypeWithdrawal = "withdrawal";
+export const TransactionStatusInitiated = "initiated";
+export const TransactionStatusRelayed = "relayed";
+export const TransactionStatusProven = "proven";
+export const TransactionStatusFinalized = "finalized";
+/**
+ * CrossDomainMessageItem ... Data model for a message sent through the CrossDomainMessenger
+ */
+export interface CrossDomainMessageItem {
+  messageHash: string;
+  nonce: string;
+  sender: string;
+  target: string;
+  value: string;
+  gasLimit: string;
+  relayedTxHash: string;
+}
+/**
+ * TransactionItem ... Data model for the lifecycle of a bridge transaction
+ */
+export interface TransactionItem {
+  guid: string;
+  type: string;
+  status: string;
+  from: string;
+  to: string;
+  amount: string;
+  timestamp: number /* uint64 */;
+  /**
+   * Bridge transfer (ETH/ERC20 or ERC721) information, empty otherwise
+   */
+  l1TokenAddress: string;
+  l2TokenAddress: string;
+  tokenId: string;
+  /**
+   * Lifecycle transaction hashes, empty if not yet reached. L1 for deposits and L2 for withdrawals
+   */
+  initiatedTxHash: string;
+  l2TxHash: string;
+  provenTxHash: string;
+  finalizedTxHash: string;
+  crossDomainMessage?: CrossDomainMessageItem;
+}
+/**
+ * TransactionResponse ... Data model for API JSON response
+ */
+export interface TransactionResponse {
+  transactionHash: string;
+  items: TransactionItem[];
+}
 export interface BridgeSupplyView {
   l1DepositSum: number /* float64 */;
   l2WithdrawalSum: number /* float64 */;
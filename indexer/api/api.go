@@ -26,16 +26,21 @@ import (
 )
 
 const ethereumAddressRegex = `^0x[a-fA-F0-9]{40}$`
+const transactionHashRegex = `^0x[a-fA-F0-9]{64}$`
 
 const (
 	MetricsNamespace = "op_indexer_api"
 	addressParam     = "{address:%s}"
+	hashParam        = "{hash:%s}"
 
 	// Endpoint paths
-	DocsPath        = "/docs"
-	HealthPath      = "/healthz"
-	DepositsPath    = "/api/v0/deposits/"
-	WithdrawalsPath = "/api/v0/withdrawals/"
+	DocsPath           = "/docs"
+	HealthPath         = "/healthz"
+	DepositsPath       = "/api/v0/deposits/"
+	WithdrawalsPath    = "/api/v0/withdrawals/"
+	NFTDepositsPath    = "/api/v0/nft-deposits/"
+	NFTWithdrawalsPath = "/api/v0/nft-withdrawals/"
+	TransactionsPath   = "/api/v0/transactions/"
 
 	SupplyPath = "/api/v0/supply"
 )
@@ -47,6 +52,7 @@ type APIService struct {
 	router *chi.Mux
 
 	bv      database.BridgeTransfersView
+	views   service.Views
 	dbClose func() error
 
 	metricsRegistry *prometheus.Registry
@@ -134,13 +140,18 @@ func (a *APIService) initDB(ctx context.Context, connector DBConnector) error {
 	}
 	a.dbClose = db.Closer
 	a.bv = db.BridgeTransfers
+	a.views = service.Views{
+		BridgeTransactions: db.BridgeTransactions,
+		BridgeMessages:     db.BridgeMessages,
+		ContractEvents:     db.ContractEvents,
+	}
 	return nil
 }
 
 func (a *APIService) initRouter(apiConfig config.ServerConfig) {
 	v := new(service.Validator)
 
-	svc := service.New(v, a.bv, a.log)
+	svc := service.New(v, a.bv, a.views, a.log)
 	apiRouter := chi.NewRouter()
 	h := routes.NewRoutes(a.log, apiRouter, svc)
 
@@ -152,6 +163,9 @@ func (a *APIService) initRouter(apiConfig config.ServerConfig) {
 
 	apiRouter.Get(fmt.Sprintf(DepositsPath+addressParam, ethereumAddressRegex), h.L1DepositsHandler)
 	apiRouter.Get(fmt.Sprintf(WithdrawalsPath+addressParam, ethereumAddressRegex), h.L2WithdrawalsHandler)
+	apiRouter.Get(fmt.Sprintf(NFTDepositsPath+addressParam, ethereumAddressRegex), h.L1NFTDepositsHandler)
+	apiRouter.Get(fmt.Sprintf(NFTWithdrawalsPath+addressParam, ethereumAddressRegex), h.L2NFTWithdrawalsHandler)
+	apiRouter.Get(fmt.Sprintf(TransactionsPath+hashParam, transactionHashRegex), h.TransactionHandler)
 	apiRouter.Get(SupplyPath, h.SupplyView)
 	apiRouter.Get(DocsPath, h.DocsHandler)
 	a.router = apiRouter
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -51,6 +53,22 @@ var (
 			TokenPair:              database.TokenPair{},
 		},
 	}
+
+	nftDeposit = database.L1BridgeNFTDeposit{
+		TransactionSourceHash: common.HexToHash("def"),
+		NFTBridgeTransfer: database.NFTBridgeTransfer{
+			TokenPair: database.TokenPair{},
+			TokenID:   big.NewInt(1),
+		},
+	}
+
+	nftWithdrawal = database.L2BridgeNFTWithdrawal{
+		TransactionWithdrawalHash: common.HexToHash("0x421"),
+		NFTBridgeTransfer: database.NFTBridgeTransfer{
+			TokenPair: database.TokenPair{},
+			TokenID:   big.NewInt(2),
+		},
+	}
 )
 
 func (mbv *MockBridgeTransfersView) L1BridgeDeposit(hash common.Hash) (*database.L1BridgeDeposit, error) {
@@ -96,6 +114,41 @@ func (mbv *MockBridgeTransfersView) L2BridgeWithdrawalsByAddress(address common.
 	}, nil
 }
 
+func (mbv *MockBridgeTransfersView) L1BridgeNFTDeposit(hash common.Hash) (*database.L1BridgeNFTDeposit, error) {
+	return &nftDeposit, nil
+}
+
+func (mbv *MockBridgeTransfersView) L1BridgeNFTDepositsByAddress(address common.Address, cursor string, limit int) (*database.L1BridgeNFTDepositsResponse, error) {
+	return &database.L1BridgeNFTDepositsResponse{
+		Deposits: []database.L1BridgeNFTDepositWithTransactionHashes{
+			{
+				L1BridgeNFTDeposit: nftDeposit,
+				L1TransactionHash:  common.HexToHash("0x123"),
+				L2TransactionHash:  common.HexToHash("0x555"),
+				L1BlockHash:        common.HexToHash("0x456"),
+			},
+		},
+	}, nil
+}
+
+func (mbv *MockBridgeTransfersView) L2BridgeNFTWithdrawal(hash common.Hash) (*database.L2BridgeNFTWithdrawal, error) {
+	return &nftWithdrawal, nil
+}
+
+func (mbv *MockBridgeTransfersView) L2BridgeNFTWithdrawalsByAddress(address common.Address, cursor string, limit int) (*database.L2BridgeNFTWithdrawalsResponse, error) {
+	return &database.L2BridgeNFTWithdrawalsResponse{
+		Withdrawals: []database.L2BridgeNFTWithdrawalWithTransactionHashes{
+			{
+				L2BridgeNFTWithdrawal:      nftWithdrawal,
+				L2TransactionHash:          common.HexToHash("0x789"),
+				L2BlockHash:                common.HexToHash("0x456"),
+				ProvenL1TransactionHash:    common.HexToHash("0x123"),
+				FinalizedL1TransactionHash: common.HexToHash("0x123"),
+			},
+		},
+	}, nil
+}
+
 func (mbv *MockBridgeTransfersView) L1TxDepositSum() (float64, error) {
 	return 69, nil
 }
@@ -103,6 +156,72 @@ func (mbv *MockBridgeTransfersView) L2BridgeWithdrawalSum(database.WithdrawFilte
 	return 420, nil
 }
 
+// MockBridgeTransactionsView mocks the BridgeTransactionsView interface. Methods
+// not overridden are unused by the API and panic if called
+type MockBridgeTransactionsView struct {
+	database.BridgeTransactionsView
+}
+
+// MockBridgeMessagesView mocks the BridgeMessagesView interface
+type MockBridgeMessagesView struct {
+	database.BridgeMessagesView
+}
+
+// MockContractEventsView mocks the ContractEventsView interface
+type MockContractEventsView struct {
+	database.ContractEventsView
+}
+
+var (
+	mockTransactionHash = common.HexToHash("0x999")
+	relayedEventGUID    = uuid.New()
+
+	txDeposit = database.L1TransactionDeposit{
+		SourceHash:        deposit.TransactionSourceHash,
+		L2TransactionHash: common.HexToHash("0x555"),
+		Tx:                database.Transaction{Amount: big.NewInt(1)},
+	}
+
+	depositMessage = database.L1BridgeMessage{
+		TransactionSourceHash: deposit.TransactionSourceHash,
+		BridgeMessage: database.BridgeMessage{
+			MessageHash:             common.HexToHash("0x777"),
+			Nonce:                   big.NewInt(0),
+			GasLimit:                big.NewInt(100_000),
+			RelayedMessageEventGUID: &relayedEventGUID,
+			Tx:                      database.Transaction{Amount: big.NewInt(1)},
+		},
+	}
+)
+
+func (mbv *MockBridgeTransactionsView) L1TransactionDepositsWithTransactionHash(common.Hash) ([]database.L1TransactionDeposit, error) {
+	return []database.L1TransactionDeposit{txDeposit}, nil
+}
+
+func (mbv *MockBridgeTransactionsView) L2TransactionWithdrawalsWithTransactionHash(common.Hash) ([]database.L2TransactionWithdrawal, error) {
+	return nil, nil
+}
+
+func (mbv *MockBridgeMessagesView) L1BridgeMessagesRelayedInTransaction(common.Hash) ([]database.L1BridgeMessage, error) {
+	return []database.L1BridgeMessage{depositMessage}, nil
+}
+
+func (mbv *MockBridgeMessagesView) L1BridgeMessageWithTransactionSourceHash(common.Hash) (*database.L1BridgeMessage, error) {
+	return &depositMessage, nil
+}
+
+func (mbv *MockBridgeMessagesView) L2BridgeMessagesRelayedInTransaction(common.Hash) ([]database.L2BridgeMessage, error) {
+	return nil, nil
+}
+
+func (mcv *MockContractEventsView) L1ContractEvent(uuid.UUID) (*database.L1ContractEvent, error) {
+	return &database.L1ContractEvent{ContractEvent: database.ContractEvent{TransactionHash: common.HexToHash("0x123")}}, nil
+}
+
+func (mcv *MockContractEventsView) L2ContractEvent(uuid.UUID) (*database.L2ContractEvent, error) {
+	return &database.L2ContractEvent{ContractEvent: database.ContractEvent{TransactionHash: mockTransactionHash}}, nil
+}
+
 func TestHealthz(t *testing.T) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	cfg := &Config{
@@ -184,3 +303,116 @@ func TestL2BridgeWithdrawalsByAddressHandler(t *testing.T) {
 	assert.Equal(t, resp.Items[0].Timestamp, withdrawal.Tx.Timestamp)
 
 }
+
+func TestL1BridgeNFTDepositsHandler(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cfg := &Config{
+		DB:            &TestDBConnector{BridgeTransfers: &MockBridgeTransfersView{}},
+		HTTPServer:    apiConfig,
+		MetricsServer: metricsConfig,
+	}
+	api, err := NewApi(context.Background(), logger, cfg)
+	require.NoError(t, err)
+	request, err := http.NewRequest("GET", fmt.Sprintf("http://"+api.Addr()+"/api/v0/nft-deposits/%s", mockAddress), nil)
+	assert.Nil(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	api.router.ServeHTTP(responseRecorder, request)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var resp models.NFTDepositResponse
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+
+	require.Len(t, resp.Items, 1)
+
+	assert.Equal(t, resp.Items[0].Guid, nftDeposit.TransactionSourceHash.String())
+	assert.Equal(t, resp.Items[0].L1BlockHash, common.HexToHash("0x456").String())
+	assert.Equal(t, resp.Items[0].L1TxHash, common.HexToHash("0x123").String())
+	assert.Equal(t, resp.Items[0].L2TxHash, common.HexToHash("0x555").String())
+	assert.Equal(t, resp.Items[0].TokenID, nftDeposit.TokenID.String())
+}
+
+func TestL2BridgeNFTWithdrawalsHandler(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cfg := &Config{
+		DB:            &TestDBConnector{BridgeTransfers: &MockBridgeTransfersView{}},
+		HTTPServer:    apiConfig,
+		MetricsServer: metricsConfig,
+	}
+	api, err := NewApi(context.Background(), logger, cfg)
+	require.NoError(t, err)
+	request, err := http.NewRequest("GET", fmt.Sprintf("http://"+api.Addr()+"/api/v0/nft-withdrawals/%s", mockAddress), nil)
+	assert.Nil(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	api.router.ServeHTTP(responseRecorder, request)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var resp models.NFTWithdrawalResponse
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &resp)
+	assert.Nil(t, err)
+
+	require.Len(t, resp.Items, 1)
+
+	assert.Equal(t, resp.Items[0].Guid, nftWithdrawal.TransactionWithdrawalHash.String())
+	assert.Equal(t, resp.Items[0].L2BlockHash, common.HexToHash("0x456").String())
+	assert.Equal(t, resp.Items[0].TransactionHash, common.HexToHash("0x789").String())
+	assert.Equal(t, resp.Items[0].L1ProvenTxHash, common.HexToHash("0x123").String())
+	assert.Equal(t, resp.Items[0].L1FinalizedTxHash, common.HexToHash("0x123").String())
+	assert.Equal(t, resp.Items[0].TokenID, nftWithdrawal.TokenID.String())
+}
+
+func TestTransactionHandler(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cfg := &Config{
+		DB: &TestDBConnector{
+			BridgeTransfers:    &MockBridgeTransfersView{},
+			BridgeTransactions: &MockBridgeTransactionsView{},
+			BridgeMessages:     &MockBridgeMessagesView{},
+			ContractEvents:     &MockContractEventsView{},
+		},
+		HTTPServer:    apiConfig,
+		MetricsServer: metricsConfig,
+	}
+	api, err := NewApi(context.Background(), logger, cfg)
+	require.NoError(t, err)
+
+	t.Run("invalid hash", func(t *testing.T) {
+		request, err := http.NewRequest("GET", "http://"+api.Addr()+"/api/v0/transactions/0x123", nil)
+		require.NoError(t, err)
+
+		responseRecorder := httptest.NewRecorder()
+		api.router.ServeHTTP(responseRecorder, request)
+		assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	})
+
+	t.Run("relayed deposit", func(t *testing.T) {
+		request, err := http.NewRequest("GET", fmt.Sprintf("http://"+api.Addr()+"/api/v0/transactions/%s", mockTransactionHash), nil)
+		require.NoError(t, err)
+
+		responseRecorder := httptest.NewRecorder()
+		api.router.ServeHTTP(responseRecorder, request)
+		assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+		var resp models.TransactionResponse
+		err = json.Unmarshal(responseRecorder.Body.Bytes(), &resp)
+		require.NoError(t, err)
+
+		// the deposit is matched on both initiation & relay but only reported once
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, resp.TransactionHash, mockTransactionHash.String())
+		assert.Equal(t, resp.Items[0].Guid, txDeposit.SourceHash.String())
+		assert.Equal(t, resp.Items[0].Type, models.TransactionTypeDeposit)
+		assert.Equal(t, resp.Items[0].Status, models.TransactionStatusRelayed)
+		assert.Equal(t, resp.Items[0].InitiatedTxHash, common.HexToHash("0x123").String())
+		assert.Equal(t, resp.Items[0].L2TxHash, txDeposit.L2TransactionHash.String())
+		assert.Equal(t, resp.Items[0].TokenID, "")
+
+		require.NotNil(t, resp.Items[0].CrossDomainMessage)
+		assert.Equal(t, resp.Items[0].CrossDomainMessage.MessageHash, depositMessage.MessageHash.String())
+		assert.Equal(t, resp.Items[0].CrossDomainMessage.RelayedTxHash, mockTransactionHash.String())
+	})
+}
@@ -12,8 +12,11 @@ import (
 
 // DB represents the abstract DB access the API has.
 type DB struct {
-	BridgeTransfers database.BridgeTransfersView
-	Closer          func() error
+	BridgeTransfers    database.BridgeTransfersView
+	BridgeTransactions database.BridgeTransactionsView
+	BridgeMessages     database.BridgeMessagesView
+	ContractEvents     database.ContractEventsView
+	Closer             func() error
 }
 
 // DBConfigConnector implements a fully config based DBConnector
@@ -27,18 +30,27 @@ func (cfg *DBConfigConnector) OpenDB(ctx context.Context, log log.Logger) (*DB,
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 	return &DB{
-		BridgeTransfers: db.BridgeTransfers,
-		Closer:          db.Close,
+		BridgeTransfers:    db.BridgeTransfers,
+		BridgeTransactions: db.BridgeTransactions,
+		BridgeMessages:     db.BridgeMessages,
+		ContractEvents:     db.ContractEvents,
+		Closer:             db.Close,
 	}, nil
 }
 
 type TestDBConnector struct {
-	BridgeTransfers database.BridgeTransfersView
+	BridgeTransfers    database.BridgeTransfersView
+	BridgeTransactions database.BridgeTransactionsView
+	BridgeMessages     database.BridgeMessagesView
+	ContractEvents     database.ContractEventsView
 }
 
 func (tdb *TestDBConnector) OpenDB(ctx context.Context, log log.Logger) (*DB, error) {
 	return &DB{
-		BridgeTransfers: tdb.BridgeTransfers,
+		BridgeTransfers:    tdb.BridgeTransfers,
+		BridgeTransactions: tdb.BridgeTransactions,
+		BridgeMessages:     tdb.BridgeMessages,
+		ContractEvents:     tdb.ContractEvents,
 		Closer: func() error {
 			log.Info("API service closed test DB view")
 			return nil
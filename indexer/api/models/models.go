@@ -31,6 +31,28 @@ type DepositResponse struct {
 	Items       []DepositItem `json:"items"`
 }
 
+// NFTDepositItem ... NFT deposit item model for API responses
+type NFTDepositItem struct {
+	Guid                   string `json:"guid"`
+	From                   string `json:"from"`
+	To                     string `json:"to"`
+	Timestamp              uint64 `json:"timestamp"`
+	L1BlockHash            string `json:"l1BlockHash"`
+	L1TxHash               string `json:"l1TxHash"`
+	L2TxHash               string `json:"l2TxHash"`
+	CrossDomainMessageHash string `json:"crossDomainMessageHash"`
+	L1TokenAddress         string `json:"l1TokenAddress"`
+	L2TokenAddress         string `json:"l2TokenAddress"`
+	TokenID                string `json:"tokenId"`
+}
+
+// NFTDepositResponse ... Data model for API JSON response
+type NFTDepositResponse struct {
+	Cursor      string           `json:"cursor"`
+	HasNextPage bool             `json:"hasNextPage"`
+	Items       []NFTDepositItem `json:"items"`
+}
+
 // WithdrawalItem ... Data model for API JSON response
 type WithdrawalItem struct {
 	Guid                   string `json:"guid"`
@@ -45,6 +67,11 @@ type WithdrawalItem struct {
 	L1FinalizedTxHash      string `json:"l1FinalizedTxHash"`
 	L1TokenAddress         string `json:"l1TokenAddress"`
 	L2TokenAddress         string `json:"l2TokenAddress"`
+
+	// Fault proofs. Only set for withdrawals proven against a dispute game
+	ProvenDisputeGameAddress string `json:"provenDisputeGameAddress"`
+	ProvenDisputeGameStatus  string `json:"provenDisputeGameStatus"`
+	FinalizableTimestamp     uint64 `json:"finalizableTimestamp"`
 }
 
 // WithdrawalResponse ... Data model for API JSON response
@@ -54,6 +81,81 @@ type WithdrawalResponse struct {
 	Items       []WithdrawalItem `json:"items"`
 }
 
+// NFTWithdrawalItem ... Data model for API JSON response
+type NFTWithdrawalItem struct {
+	Guid                   string `json:"guid"`
+	From                   string `json:"from"`
+	To                     string `json:"to"`
+	TransactionHash        string `json:"transactionHash"`
+	CrossDomainMessageHash string `json:"crossDomainMessageHash"`
+	Timestamp              uint64 `json:"timestamp"`
+	L2BlockHash            string `json:"l2BlockHash"`
+	L1ProvenTxHash         string `json:"l1ProvenTxHash"`
+	L1FinalizedTxHash      string `json:"l1FinalizedTxHash"`
+	L1TokenAddress         string `json:"l1TokenAddress"`
+	L2TokenAddress         string `json:"l2TokenAddress"`
+	TokenID                string `json:"tokenId"`
+}
+
+// NFTWithdrawalResponse ... Data model for API JSON response
+type NFTWithdrawalResponse struct {
+	Cursor      string              `json:"cursor"`
+	HasNextPage bool                `json:"hasNextPage"`
+	Items       []NFTWithdrawalItem `json:"items"`
+}
+
+// Bridge transaction types & lifecycle statuses
+const (
+	TransactionTypeDeposit    = "deposit"
+	TransactionTypeWithdrawal = "withdrawal"
+
+	TransactionStatusInitiated = "initiated"
+	TransactionStatusRelayed   = "relayed"
+	TransactionStatusProven    = "proven"
+	TransactionStatusFinalized = "finalized"
+)
+
+// CrossDomainMessageItem ... Data model for a message sent through the CrossDomainMessenger
+type CrossDomainMessageItem struct {
+	MessageHash   string `json:"messageHash"`
+	Nonce         string `json:"nonce"`
+	Sender        string `json:"sender"`
+	Target        string `json:"target"`
+	Value         string `json:"value"`
+	GasLimit      string `json:"gasLimit"`
+	RelayedTxHash string `json:"relayedTxHash"`
+}
+
+// TransactionItem ... Data model for the lifecycle of a bridge transaction
+type TransactionItem struct {
+	Guid      string `json:"guid"`
+	Type      string `json:"type"`
+	Status    string `json:"status"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Amount    string `json:"amount"`
+	Timestamp uint64 `json:"timestamp"`
+
+	// Bridge transfer (ETH/ERC20 or ERC721) information, empty otherwise
+	L1TokenAddress string `json:"l1TokenAddress"`
+	L2TokenAddress string `json:"l2TokenAddress"`
+	TokenID        string `json:"tokenId"`
+
+	// Lifecycle transaction hashes, empty if not yet reached. L1 for deposits and L2 for withdrawals
+	InitiatedTxHash string `json:"initiatedTxHash"`
+	L2TxHash        string `json:"l2TxHash"`
+	ProvenTxHash    string `json:"provenTxHash"`
+	FinalizedTxHash string `json:"finalizedTxHash"`
+
+	CrossDomainMessage *CrossDomainMessageItem `json:"crossDomainMessage"`
+}
+
+// TransactionResponse ... Data model for API JSON response
+type TransactionResponse struct {
+	TransactionHash string            `json:"transactionHash"`
+	Items           []TransactionItem `json:"items"`
+}
+
 type BridgeSupplyView struct {
 	L1DepositSum         float64 `json:"l1DepositSum"`
 	InitWithdrawalSum    float64 `json:"l2WithdrawalSum"`
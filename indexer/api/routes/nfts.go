@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// L1NFTDepositsHandler ... Handles /api/v0/nft-deposits/{address} GET requests
+func (h Routes) L1NFTDepositsHandler(w http.ResponseWriter, r *http.Request) {
+	address := chi.URLParam(r, "address")
+	cursor := r.URL.Query().Get("cursor")
+	limit := r.URL.Query().Get("limit")
+
+	params, err := h.svc.QueryParams(address, cursor, limit)
+	if err != nil {
+		http.Error(w, "invalid query params", http.StatusBadRequest)
+		h.logger.Error("error reading request params", "err", err.Error())
+		return
+	}
+
+	deposits, err := h.svc.GetNFTDeposits(params)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		h.logger.Error("error fetching nft deposits", "err", err.Error())
+		return
+	}
+
+	resp := h.svc.NFTDepositResponse(deposits)
+	err = jsonResponse(w, resp, http.StatusOK)
+	if err != nil {
+		h.logger.Error("error writing response", "err", err)
+	}
+}
+
+// L2NFTWithdrawalsHandler ... Handles /api/v0/nft-withdrawals/{address} GET requests
+func (h Routes) L2NFTWithdrawalsHandler(w http.ResponseWriter, r *http.Request) {
+	address := chi.URLParam(r, "address")
+	cursor := r.URL.Query().Get("cursor")
+	limit := r.URL.Query().Get("limit")
+
+	params, err := h.svc.QueryParams(address, cursor, limit)
+	if err != nil {
+		http.Error(w, "invalid query params", http.StatusBadRequest)
+		h.logger.Error("error reading request params", "err", err.Error())
+		return
+	}
+
+	withdrawals, err := h.svc.GetNFTWithdrawals(params)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		h.logger.Error("error fetching nft withdrawals", "err", err.Error())
+		return
+	}
+
+	resp := h.svc.NFTWithdrawResponse(withdrawals)
+	err = jsonResponse(w, resp, http.StatusOK)
+	if err != nil {
+		h.logger.Error("error writing response", "err", err)
+	}
+}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// TransactionHandler ... Handles /api/v0/transactions/{hash} GET requests
+func (h Routes) TransactionHandler(w http.ResponseWriter, r *http.Request) {
+	hash := chi.URLParam(r, "hash")
+
+	txHash, err := h.svc.TransactionHashParam(hash)
+	if err != nil {
+		http.Error(w, "invalid transaction hash", http.StatusBadRequest)
+		h.logger.Error("error reading request params", "err", err.Error())
+		return
+	}
+
+	resp, err := h.svc.GetTransaction(txHash)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		h.logger.Error("error fetching bridge transactions", "err", err.Error())
+		return
+	}
+
+	err = jsonResponse(w, resp, http.StatusOK)
+	if err != nil {
+		h.logger.Error("error writing response", "err", err)
+	}
+}
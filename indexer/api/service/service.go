@@ -13,22 +13,37 @@ type Service interface {
 	DepositResponse(*database.L1BridgeDepositsResponse) models.DepositResponse
 	GetWithdrawals(params *models.QueryParams) (*database.L2BridgeWithdrawalsResponse, error)
 	WithdrawResponse(*database.L2BridgeWithdrawalsResponse) models.WithdrawalResponse
+	GetNFTDeposits(*models.QueryParams) (*database.L1BridgeNFTDepositsResponse, error)
+	NFTDepositResponse(*database.L1BridgeNFTDepositsResponse) models.NFTDepositResponse
+	GetNFTWithdrawals(*models.QueryParams) (*database.L2BridgeNFTWithdrawalsResponse, error)
+	NFTWithdrawResponse(*database.L2BridgeNFTWithdrawalsResponse) models.NFTWithdrawalResponse
 	GetSupplyInfo() (*models.BridgeSupplyView, error)
+	GetTransaction(common.Hash) (*models.TransactionResponse, error)
 
 	QueryParams(address, cursor, limit string) (*models.QueryParams, error)
+	TransactionHashParam(hash string) (common.Hash, error)
+}
+
+// Views ... The database views backing the transaction lookups
+type Views struct {
+	BridgeTransactions database.BridgeTransactionsView
+	BridgeMessages     database.BridgeMessagesView
+	ContractEvents     database.ContractEventsView
 }
 
 type HandlerSvc struct {
 	v      *Validator
 	db     database.BridgeTransfersView
+	views  Views
 	logger log.Logger
 }
 
-func New(v *Validator, db database.BridgeTransfersView, l log.Logger) Service {
+func New(v *Validator, db database.BridgeTransfersView, views Views, l log.Logger) Service {
 	return &HandlerSvc{
 		logger: l,
 		v:      v,
 		db:     db,
+		views:  views,
 	}
 }
 
@@ -59,6 +74,16 @@ func (svc *HandlerSvc) QueryParams(a, c, l string) (*models.QueryParams, error)
 
 }
 
+func (svc *HandlerSvc) TransactionHashParam(h string) (common.Hash, error) {
+	hash, err := svc.v.ParseValidateHash(h)
+	if err != nil {
+		svc.logger.Error("invalid transaction hash param", "param", h, "err", err)
+		return common.Hash{}, err
+	}
+
+	return hash, nil
+}
+
 func (svc *HandlerSvc) GetWithdrawals(params *models.QueryParams) (*database.L2BridgeWithdrawalsResponse, error) {
 	withdrawals, err := svc.db.L2BridgeWithdrawalsByAddress(params.Address, params.Cursor, params.Limit)
 	if err != nil {
@@ -93,6 +118,17 @@ func (svc *HandlerSvc) WithdrawResponse(withdrawals *database.L2BridgeWithdrawal
 			L1TokenAddress:         withdrawal.L2BridgeWithdrawal.TokenPair.RemoteTokenAddress.String(),
 			L2TokenAddress:         withdrawal.L2BridgeWithdrawal.TokenPair.LocalTokenAddress.String(),
 		}
+
+		// the zero address marks a withdrawal proven without a dispute game
+		if withdrawal.ProvenDisputeGameAddress != nil && *withdrawal.ProvenDisputeGameAddress != (common.Address{}) {
+			item.ProvenDisputeGameAddress = withdrawal.ProvenDisputeGameAddress.String()
+		}
+		if withdrawal.ProvenDisputeGameStatus != nil {
+			item.ProvenDisputeGameStatus = withdrawal.ProvenDisputeGameStatus.String()
+		}
+		if withdrawal.FinalizableAt != nil {
+			item.FinalizableTimestamp = *withdrawal.FinalizableAt
+		}
 		items[i] = item
 	}
 
@@ -140,6 +176,83 @@ func (svc *HandlerSvc) DepositResponse(deposits *database.L1BridgeDepositsRespon
 	}
 }
 
+func (svc *HandlerSvc) GetNFTDeposits(params *models.QueryParams) (*database.L1BridgeNFTDepositsResponse, error) {
+	deposits, err := svc.db.L1BridgeNFTDepositsByAddress(params.Address, params.Cursor, params.Limit)
+	if err != nil {
+		svc.logger.Error("error getting nft deposits", "err", err.Error(), "address", params.Address.String())
+		return nil, err
+	}
+
+	svc.logger.Debug("read nft deposits from db", "count", len(deposits.Deposits), "address", params.Address.String())
+	return deposits, nil
+}
+
+// NFTDepositResponse ... Converts a database.L1BridgeNFTDepositsResponse to an api.NFTDepositResponse
+func (svc *HandlerSvc) NFTDepositResponse(deposits *database.L1BridgeNFTDepositsResponse) models.NFTDepositResponse {
+	items := make([]models.NFTDepositItem, len(deposits.Deposits))
+	for i, deposit := range deposits.Deposits {
+		item := models.NFTDepositItem{
+			Guid:                   deposit.L1BridgeNFTDeposit.TransactionSourceHash.String(),
+			L1BlockHash:            deposit.L1BlockHash.String(),
+			Timestamp:              deposit.L1BridgeNFTDeposit.Timestamp,
+			L1TxHash:               deposit.L1TransactionHash.String(),
+			L2TxHash:               deposit.L2TransactionHash.String(),
+			CrossDomainMessageHash: deposit.L1BridgeNFTDeposit.CrossDomainMessageHash.String(),
+			From:                   deposit.L1BridgeNFTDeposit.FromAddress.String(),
+			To:                     deposit.L1BridgeNFTDeposit.ToAddress.String(),
+			L1TokenAddress:         deposit.L1BridgeNFTDeposit.TokenPair.LocalTokenAddress.String(),
+			L2TokenAddress:         deposit.L1BridgeNFTDeposit.TokenPair.RemoteTokenAddress.String(),
+			TokenID:                deposit.L1BridgeNFTDeposit.TokenID.String(),
+		}
+		items[i] = item
+	}
+
+	return models.NFTDepositResponse{
+		Cursor:      deposits.Cursor,
+		HasNextPage: deposits.HasNextPage,
+		Items:       items,
+	}
+}
+
+func (svc *HandlerSvc) GetNFTWithdrawals(params *models.QueryParams) (*database.L2BridgeNFTWithdrawalsResponse, error) {
+	withdrawals, err := svc.db.L2BridgeNFTWithdrawalsByAddress(params.Address, params.Cursor, params.Limit)
+	if err != nil {
+		svc.logger.Error("error getting nft withdrawals", "err", err.Error(), "address", params.Address.String())
+		return nil, err
+	}
+
+	svc.logger.Debug("read nft withdrawals from db", "count", len(withdrawals.Withdrawals), "address", params.Address.String())
+	return withdrawals, nil
+}
+
+// NFTWithdrawResponse ... Converts a database.L2BridgeNFTWithdrawalsResponse to an api.NFTWithdrawalResponse
+func (svc *HandlerSvc) NFTWithdrawResponse(withdrawals *database.L2BridgeNFTWithdrawalsResponse) models.NFTWithdrawalResponse {
+	items := make([]models.NFTWithdrawalItem, len(withdrawals.Withdrawals))
+	for i, withdrawal := range withdrawals.Withdrawals {
+		item := models.NFTWithdrawalItem{
+			Guid:                   withdrawal.L2BridgeNFTWithdrawal.TransactionWithdrawalHash.String(),
+			L2BlockHash:            withdrawal.L2BlockHash.String(),
+			Timestamp:              withdrawal.L2BridgeNFTWithdrawal.Timestamp,
+			From:                   withdrawal.L2BridgeNFTWithdrawal.FromAddress.String(),
+			To:                     withdrawal.L2BridgeNFTWithdrawal.ToAddress.String(),
+			TransactionHash:        withdrawal.L2TransactionHash.String(),
+			CrossDomainMessageHash: withdrawal.L2BridgeNFTWithdrawal.CrossDomainMessageHash.String(),
+			L1ProvenTxHash:         withdrawal.ProvenL1TransactionHash.String(),
+			L1FinalizedTxHash:      withdrawal.FinalizedL1TransactionHash.String(),
+			L1TokenAddress:         withdrawal.L2BridgeNFTWithdrawal.TokenPair.RemoteTokenAddress.String(),
+			L2TokenAddress:         withdrawal.L2BridgeNFTWithdrawal.TokenPair.LocalTokenAddress.String(),
+			TokenID:                withdrawal.L2BridgeNFTWithdrawal.TokenID.String(),
+		}
+		items[i] = item
+	}
+
+	return models.NFTWithdrawalResponse{
+		Cursor:      withdrawals.Cursor,
+		HasNextPage: withdrawals.HasNextPage,
+		Items:       items,
+	}
+}
+
 // GetSupplyInfo ... Fetch native bridge supply info
 func (svc *HandlerSvc) GetSupplyInfo() (*models.BridgeSupplyView, error) {
 	depositSum, err := svc.db.L1TxDepositSum()
@@ -31,8 +31,11 @@ func assertFieldsAreSet(t *testing.T, item any) {
 }
 
 func TestWithdrawalResponse(t *testing.T) {
-	svc := service.New(nil, nil, nil)
+	svc := service.New(nil, nil, service.Views{}, nil)
 	cdh := common.HexToHash("0x2")
+	gameAddress := common.HexToAddress("0x8")
+	gameStatus := database.GameStatusDefenderWins
+	finalizableAt := uint64(9)
 
 	withdraws := &database.L2BridgeWithdrawalsResponse{
 		Withdrawals: []database.L2BridgeWithdrawalWithTransactionHashes{
@@ -52,6 +55,9 @@ func TestWithdrawalResponse(t *testing.T) {
 						},
 					},
 				},
+				ProvenDisputeGameAddress: &gameAddress,
+				ProvenDisputeGameStatus:  &gameStatus,
+				FinalizableAt:            &finalizableAt,
 			},
 		},
 	}
@@ -64,7 +70,7 @@ func TestWithdrawalResponse(t *testing.T) {
 
 func TestDepositResponse(t *testing.T) {
 	cdh := common.HexToHash("0x2")
-	svc := service.New(nil, nil, nil)
+	svc := service.New(nil, nil, service.Views{}, nil)
 
 	deposits := &database.L1BridgeDepositsResponse{
 		Deposits: []database.L1BridgeDepositWithTransactionHashes{
@@ -122,7 +128,7 @@ func TestQueryParams(t *testing.T) {
 	}
 
 	v := new(service.Validator)
-	svc := service.New(v, nil, log.New())
+	svc := service.New(v, nil, service.Views{}, log.New())
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/ethereum-optimism/optimism/indexer/api/models"
+	"github.com/ethereum-optimism/optimism/indexer/database"
+)
+
+// GetTransaction ... Looks up every bridge transaction that the supplied L1 or L2 transaction hash
+// takes part in, i.e. initiating or relaying a deposit and initiating, proving or finalizing a withdrawal
+func (svc *HandlerSvc) GetTransaction(txHash common.Hash) (*models.TransactionResponse, error) {
+	deposits, err := svc.views.BridgeTransactions.L1TransactionDepositsWithTransactionHash(txHash)
+	if err != nil {
+		svc.logger.Error("error getting deposits", "err", err.Error(), "tx_hash", txHash.String())
+		return nil, err
+	}
+
+	// include deposits with a message relayed in this transaction
+	relayedL1Messages, err := svc.views.BridgeMessages.L1BridgeMessagesRelayedInTransaction(txHash)
+	if err != nil {
+		svc.logger.Error("error getting relayed messages", "err", err.Error(), "tx_hash", txHash.String())
+		return nil, err
+	}
+	for _, message := range relayedL1Messages {
+		if containsDeposit(deposits, message.TransactionSourceHash) {
+			continue
+		}
+		deposit, err := svc.views.BridgeTransactions.L1TransactionDeposit(message.TransactionSourceHash)
+		if err != nil {
+			svc.logger.Error("error getting deposit", "err", err.Error(), "source_hash", message.TransactionSourceHash.String())
+			return nil, err
+		} else if deposit != nil {
+			deposits = append(deposits, *deposit)
+		}
+	}
+
+	withdrawals, err := svc.views.BridgeTransactions.L2TransactionWithdrawalsWithTransactionHash(txHash)
+	if err != nil {
+		svc.logger.Error("error getting withdrawals", "err", err.Error(), "tx_hash", txHash.String())
+		return nil, err
+	}
+
+	// include withdrawals with a message relayed in this transaction
+	relayedL2Messages, err := svc.views.BridgeMessages.L2BridgeMessagesRelayedInTransaction(txHash)
+	if err != nil {
+		svc.logger.Error("error getting relayed messages", "err", err.Error(), "tx_hash", txHash.String())
+		return nil, err
+	}
+	for _, message := range relayedL2Messages {
+		if containsWithdrawal(withdrawals, message.TransactionWithdrawalHash) {
+			continue
+		}
+		withdrawal, err := svc.views.BridgeTransactions.L2TransactionWithdrawal(message.TransactionWithdrawalHash)
+		if err != nil {
+			svc.logger.Error("error getting withdrawal", "err", err.Error(), "withdrawal_hash", message.TransactionWithdrawalHash.String())
+			return nil, err
+		} else if withdrawal != nil {
+			withdrawals = append(withdrawals, *withdrawal)
+		}
+	}
+
+	items := make([]models.TransactionItem, 0, len(deposits)+len(withdrawals))
+	for i := range deposits {
+		item, err := svc.depositItem(&deposits[i])
+		if err != nil {
+			svc.logger.Error("error reading deposit lifecycle", "err", err.Error(), "source_hash", deposits[i].SourceHash.String())
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	for i := range withdrawals {
+		item, err := svc.withdrawalItem(&withdrawals[i])
+		if err != nil {
+			svc.logger.Error("error reading withdrawal lifecycle", "err", err.Error(), "withdrawal_hash", withdrawals[i].WithdrawalHash.String())
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+
+	svc.logger.Debug("read bridge transactions from db", "count", len(items), "tx_hash", txHash.String())
+	return &models.TransactionResponse{TransactionHash: txHash.String(), Items: items}, nil
+}
+
+func (svc *HandlerSvc) depositItem(deposit *database.L1TransactionDeposit) (*models.TransactionItem, error) {
+	item := models.TransactionItem{
+		Guid:      deposit.SourceHash.String(),
+		Type:      models.TransactionTypeDeposit,
+		Status:    models.TransactionStatusInitiated,
+		From:      deposit.Tx.FromAddress.String(),
+		To:        deposit.Tx.ToAddress.String(),
+		Amount:    deposit.Tx.Amount.String(),
+		Timestamp: deposit.Tx.Timestamp,
+		L2TxHash:  deposit.L2TransactionHash.String(),
+	}
+
+	initiatedEvent, err := svc.views.ContractEvents.L1ContractEvent(deposit.InitiatedL1EventGUID)
+	if err != nil {
+		return nil, err
+	} else if initiatedEvent != nil {
+		item.InitiatedTxHash = initiatedEvent.TransactionHash.String()
+	}
+
+	message, err := svc.views.BridgeMessages.L1BridgeMessageWithTransactionSourceHash(deposit.SourceHash)
+	if err != nil {
+		return nil, err
+	} else if message == nil {
+		return &item, nil
+	}
+
+	item.CrossDomainMessage, err = svc.messageItem(&message.BridgeMessage, svc.l2TransactionHash)
+	if err != nil {
+		return nil, err
+	}
+	if message.RelayedMessageEventGUID != nil {
+		item.Status = models.TransactionStatusRelayed
+	}
+
+	// A message is sent either by the StandardBridge or the ERC721Bridge
+	bridgeDeposit, err := svc.db.L1BridgeDeposit(deposit.SourceHash)
+	if err != nil {
+		return nil, err
+	} else if bridgeDeposit != nil {
+		item.From, item.To = bridgeDeposit.Tx.FromAddress.String(), bridgeDeposit.Tx.ToAddress.String()
+		item.Amount = bridgeDeposit.Tx.Amount.String()
+		item.L1TokenAddress = bridgeDeposit.TokenPair.LocalTokenAddress.String()
+		item.L2TokenAddress = bridgeDeposit.TokenPair.RemoteTokenAddress.String()
+		return &item, nil
+	}
+
+	nftDeposit, err := svc.db.L1BridgeNFTDeposit(deposit.SourceHash)
+	if err != nil {
+		return nil, err
+	} else if nftDeposit != nil {
+		item.From, item.To = nftDeposit.FromAddress.String(), nftDeposit.ToAddress.String()
+		item.L1TokenAddress = nftDeposit.TokenPair.LocalTokenAddress.String()
+		item.L2TokenAddress = nftDeposit.TokenPair.RemoteTokenAddress.String()
+		item.TokenID = nftDeposit.TokenID.String()
+	}
+
+	return &item, nil
+}
+
+func (svc *HandlerSvc) withdrawalItem(withdrawal *database.L2TransactionWithdrawal) (*models.TransactionItem, error) {
+	item := models.TransactionItem{
+		Guid:      withdrawal.WithdrawalHash.String(),
+		Type:      models.TransactionTypeWithdrawal,
+		Status:    models.TransactionStatusInitiated,
+		From:      withdrawal.Tx.FromAddress.String(),
+		To:        withdrawal.Tx.ToAddress.String(),
+		Amount:    withdrawal.Tx.Amount.String(),
+		Timestamp: withdrawal.Tx.Timestamp,
+	}
+
+	var err error
+	if item.InitiatedTxHash, err = svc.l2TransactionHash(withdrawal.InitiatedL2EventGUID); err != nil {
+		return nil, err
+	}
+	if withdrawal.ProvenL1EventGUID != nil {
+		item.Status = models.TransactionStatusProven
+		if item.ProvenTxHash, err = svc.l1TransactionHash(*withdrawal.ProvenL1EventGUID); err != nil {
+			return nil, err
+		}
+	}
+	if withdrawal.FinalizedL1EventGUID != nil {
+		item.Status = models.TransactionStatusFinalized
+		if item.FinalizedTxHash, err = svc.l1TransactionHash(*withdrawal.FinalizedL1EventGUID); err != nil {
+			return nil, err
+		}
+	}
+
+	message, err := svc.views.BridgeMessages.L2BridgeMessageWithTransactionWithdrawalHash(withdrawal.WithdrawalHash)
+	if err != nil {
+		return nil, err
+	} else if message == nil {
+		return &item, nil
+	}
+
+	item.CrossDomainMessage, err = svc.messageItem(&message.BridgeMessage, svc.l1TransactionHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// A message is sent either by the StandardBridge or the ERC721Bridge
+	bridgeWithdrawal, err := svc.db.L2BridgeWithdrawal(withdrawal.WithdrawalHash)
+	if err != nil {
+		return nil, err
+	} else if bridgeWithdrawal != nil {
+		item.From, item.To = bridgeWithdrawal.Tx.FromAddress.String(), bridgeWithdrawal.Tx.ToAddress.String()
+		item.Amount = bridgeWithdrawal.Tx.Amount.String()
+		item.L1TokenAddress = bridgeWithdrawal.TokenPair.RemoteTokenAddress.String()
+		item.L2TokenAddress = bridgeWithdrawal.TokenPair.LocalTokenAddress.String()
+		return &item, nil
+	}
+
+	nftWithdrawal, err := svc.db.L2BridgeNFTWithdrawal(withdrawal.WithdrawalHash)
+	if err != nil {
+		return nil, err
+	} else if nftWithdrawal != nil {
+		item.From, item.To = nftWithdrawal.FromAddress.String(), nftWithdrawal.ToAddress.String()
+		item.L1TokenAddress = nftWithdrawal.TokenPair.RemoteTokenAddress.String()
+		item.L2TokenAddress = nftWithdrawal.TokenPair.LocalTokenAddress.String()
+		item.TokenID = nftWithdrawal.TokenID.String()
+	}
+
+	return &item, nil
+}
+
+// messageItem converts a bridge message, where the relayed event is looked up on the destination chain
+func (svc *HandlerSvc) messageItem(message *database.BridgeMessage, relayedTxHash func(uuid.UUID) (string, error)) (*models.CrossDomainMessageItem, error) {
+	item := models.CrossDomainMessageItem{
+		MessageHash: message.MessageHash.String(),
+		Nonce:       message.Nonce.String(),
+		Sender:      message.Tx.FromAddress.String(),
+		Target:      message.Tx.ToAddress.String(),
+		Value:       message.Tx.Amount.String(),
+		GasLimit:    message.GasLimit.String(),
+	}
+
+	if message.RelayedMessageEventGUID != nil {
+		txHash, err := relayedTxHash(*message.RelayedMessageEventGUID)
+		if err != nil {
+			return nil, err
+		}
+		item.RelayedTxHash = txHash
+	}
+
+	return &item, nil
+}
+
+func (svc *HandlerSvc) l1TransactionHash(guid uuid.UUID) (string, error) {
+	event, err := svc.views.ContractEvents.L1ContractEvent(guid)
+	if err != nil || event == nil {
+		return "", err
+	}
+	return event.TransactionHash.String(), nil
+}
+
+func (svc *HandlerSvc) l2TransactionHash(guid uuid.UUID) (string, error) {
+	event, err := svc.views.ContractEvents.L2ContractEvent(guid)
+	if err != nil || event == nil {
+		return "", err
+	}
+	return event.TransactionHash.String(), nil
+}
+
+func containsDeposit(deposits []database.L1TransactionDeposit, sourceHash common.Hash) bool {
+	for i := range deposits {
+		if deposits[i].SourceHash == sourceHash {
+			return true
+		}
+	}
+	return false
+}
+
+func containsWithdrawal(withdrawals []database.L2TransactionWithdrawal, withdrawalHash common.Hash) bool {
+	for i := range withdrawals {
+		if withdrawals[i].WithdrawalHash == withdrawalHash {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // Validator ... Validates API user request parameters
@@ -24,6 +25,20 @@ func (v *Validator) ParseValidateAddress(addr string) (common.Address, error) {
 	return parsedAddr, nil
 }
 
+// ParseValidateHash ... Validates and parses a 32 byte hash parameter
+func (v *Validator) ParseValidateHash(hash string) (common.Hash, error) {
+	if len(hash) != 66 || hash[:2] != "0x" { // 0x + 64 chars
+		return common.Hash{}, errors.New("hash must be a 0x prefixed 32 byte hex string")
+	}
+
+	b, err := hexutil.Decode(hash)
+	if err != nil {
+		return common.Hash{}, errors.New("hash must be represented as a valid hexadecimal string")
+	}
+
+	return common.BytesToHash(b), nil
+}
+
 // ValidateCursor ... Validates and parses the cursor query parameter
 func (v *Validator) ValidateCursor(cursor string) error {
 	if cursor == "" {
@@ -24,6 +24,7 @@ const (
 	deposits    = "get_deposits"
 	withdrawals = "get_withdrawals"
 	sum         = "get_sum"
+	transaction = "get_transaction"
 )
 
 // Option ... Provides configuration through callback injection
@@ -184,6 +185,24 @@ func (c *Client) GetSupplyAssessment() (*models.BridgeSupplyView, error) {
 	return bsv, nil
 }
 
+// GetTransaction ... Gets the bridge transactions, and their lifecycle status, that
+// the provided L1 or L2 transaction hash initiated, relayed, proved or finalized
+func (c *Client) GetTransaction(txHash common.Hash) (*models.TransactionResponse, error) {
+	url := c.cfg.BaseURL + api.TransactionsPath + txHash.String()
+
+	resp, err := c.doRecordRequest(transaction, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.TransactionResponse
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // GetAllWithdrawalsByAddress ... Gets all withdrawals provided a L2 address
 func (c *Client) GetAllWithdrawalsByAddress(l2Address common.Address) ([]models.WithdrawalItem, error) {
 	var withdrawals []models.WithdrawalItem
@@ -34,6 +34,9 @@ type L1Contracts struct {
 	OptimismPortalProxy common.Address `toml:"optimism-portal"`
 	L2OutputOracleProxy common.Address `toml:"l2-output-oracle"`
 
+	// fault proofs (optional, only settable on chains that have migrated to dispute games)
+	DisputeGameFactoryProxy common.Address `toml:"dispute-game-factory"`
+
 	// bridging
 	L1CrossDomainMessengerProxy common.Address `toml:"l1-cross-domain-messenger"`
 	L1StandardBridgeProxy       common.Address `toml:"l1-standard-bridge"`
@@ -103,8 +106,8 @@ type ChainConfig struct {
 	L1BedrockStartingHeight uint `toml:"-"`
 	L2BedrockStartingHeight uint `toml:"-"`
 
-	// These configuration options will be removed once
-	// native reorg handling is implemented
+	// Reorgs are handled natively by rolling back the indexed state to
+	// the common ancestor. Shallow depths result in more re-indexed work
 	L1ConfirmationDepth uint `toml:"l1-confirmation-depth"`
 	L2ConfirmationDepth uint `toml:"l2-confirmation-depth"`
 
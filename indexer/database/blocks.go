@@ -66,6 +66,11 @@ type BlocksDB interface {
 
 	StoreL1BlockHeaders([]L1BlockHeader) error
 	StoreL2BlockHeaders([]L2BlockHeader) error
+
+	// Reorg handling. Removes all headers above the supplied height. Contract
+	// events and bridge data that reference these headers are removed via cascade.
+	DeleteL1BlockHeadersAfter(*big.Int) error
+	DeleteL2BlockHeadersAfter(*big.Int) error
 }
 
 /**
@@ -93,6 +98,15 @@ func (db *blocksDB) StoreL1BlockHeaders(headers []L1BlockHeader) error {
 	return result.Error
 }
 
+func (db *blocksDB) DeleteL1BlockHeadersAfter(height *big.Int) error {
+	result := db.gorm.Where("number > ?", height).Delete(&L1BlockHeader{})
+	if result.Error == nil && result.RowsAffected > 0 {
+		db.log.Warn("deleted reorged L1 blocks", "after_block_number", height, "size", result.RowsAffected)
+	}
+
+	return result.Error
+}
+
 func (db *blocksDB) L1BlockHeader(hash common.Hash) (*L1BlockHeader, error) {
 	return db.L1BlockHeaderWithFilter(BlockHeader{Hash: hash})
 }
@@ -140,6 +154,15 @@ func (db *blocksDB) StoreL2BlockHeaders(headers []L2BlockHeader) error {
 	return result.Error
 }
 
+func (db *blocksDB) DeleteL2BlockHeadersAfter(height *big.Int) error {
+	result := db.gorm.Where("number > ?", height).Delete(&L2BlockHeader{})
+	if result.Error == nil && result.RowsAffected > 0 {
+		db.log.Warn("deleted reorged L2 blocks", "after_block_number", height, "size", result.RowsAffected)
+	}
+
+	return result.Error
+}
+
 func (db *blocksDB) L2BlockHeader(hash common.Hash) (*L2BlockHeader, error) {
 	return db.L2BlockHeaderWithFilter(BlockHeader{Hash: hash})
 }
@@ -47,9 +47,13 @@ type L2BridgeMessageVersionedMessageHash struct {
 type BridgeMessagesView interface {
 	L1BridgeMessage(common.Hash) (*L1BridgeMessage, error)
 	L1BridgeMessageWithFilter(BridgeMessage) (*L1BridgeMessage, error)
+	L1BridgeMessageWithTransactionSourceHash(common.Hash) (*L1BridgeMessage, error)
+	L1BridgeMessagesRelayedInTransaction(common.Hash) ([]L1BridgeMessage, error)
 
 	L2BridgeMessage(common.Hash) (*L2BridgeMessage, error)
 	L2BridgeMessageWithFilter(BridgeMessage) (*L2BridgeMessage, error)
+	L2BridgeMessageWithTransactionWithdrawalHash(common.Hash) (*L2BridgeMessage, error)
+	L2BridgeMessagesRelayedInTransaction(common.Hash) ([]L2BridgeMessage, error)
 }
 
 type BridgeMessagesDB interface {
@@ -108,6 +112,33 @@ func (db bridgeMessagesDB) L1BridgeMessageWithFilter(filter BridgeMessage) (*L1B
 	return &sentMessage, nil
 }
 
+// L1BridgeMessageWithTransactionSourceHash returns the message sent with the supplied transaction deposit, if any
+func (db bridgeMessagesDB) L1BridgeMessageWithTransactionSourceHash(txSourceHash common.Hash) (*L1BridgeMessage, error) {
+	var sentMessage L1BridgeMessage
+	result := db.gorm.Where(&L1BridgeMessage{TransactionSourceHash: txSourceHash}).Take(&sentMessage)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &sentMessage, nil
+}
+
+// L1BridgeMessagesRelayedInTransaction returns the messages that were relayed on L2 by the supplied transaction
+func (db bridgeMessagesDB) L1BridgeMessagesRelayedInTransaction(txHash common.Hash) ([]L1BridgeMessage, error) {
+	relayedEvents := db.gorm.Table("l2_contract_events").Select("guid").Where(&ContractEvent{TransactionHash: txHash})
+
+	var messages []L1BridgeMessage
+	result := db.gorm.Where("relayed_message_event_guid IN (?)", relayedEvents).Find(&messages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return messages, nil
+}
+
 func (db bridgeMessagesDB) MarkRelayedL1BridgeMessage(messageHash common.Hash, relayEvent uuid.UUID) error {
 	message, err := db.L1BridgeMessage(messageHash)
 	if err != nil {
@@ -183,6 +214,33 @@ func (db bridgeMessagesDB) L2BridgeMessageWithFilter(filter BridgeMessage) (*L2B
 	return &sentMessage, nil
 }
 
+// L2BridgeMessageWithTransactionWithdrawalHash returns the message sent with the supplied transaction withdrawal, if any
+func (db bridgeMessagesDB) L2BridgeMessageWithTransactionWithdrawalHash(txWithdrawalHash common.Hash) (*L2BridgeMessage, error) {
+	var sentMessage L2BridgeMessage
+	result := db.gorm.Where(&L2BridgeMessage{TransactionWithdrawalHash: txWithdrawalHash}).Take(&sentMessage)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &sentMessage, nil
+}
+
+// L2BridgeMessagesRelayedInTransaction returns the messages that were relayed on L1 by the supplied transaction
+func (db bridgeMessagesDB) L2BridgeMessagesRelayedInTransaction(txHash common.Hash) ([]L2BridgeMessage, error) {
+	relayedEvents := db.gorm.Table("l1_contract_events").Select("guid").Where(&ContractEvent{TransactionHash: txHash})
+
+	var messages []L2BridgeMessage
+	result := db.gorm.Where("relayed_message_event_guid IN (?)", relayedEvents).Find(&messages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return messages, nil
+}
+
 func (db bridgeMessagesDB) MarkRelayedL2BridgeMessage(messageHash common.Hash, relayEvent uuid.UUID) error {
 	message, err := db.L2BridgeMessage(messageHash)
 	if err != nil {
@@ -0,0 +1,209 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+/**
+ * Types
+ */
+
+type NFTBridgeTransfer struct {
+	CrossDomainMessageHash common.Hash `gorm:"serializer:bytes"`
+
+	FromAddress common.Address `gorm:"serializer:bytes"`
+	ToAddress   common.Address `gorm:"serializer:bytes"`
+	TokenPair   TokenPair      `gorm:"embedded"`
+	TokenID     *big.Int       `gorm:"serializer:u256"`
+	Data        Bytes          `gorm:"serializer:bytes"`
+	Timestamp   uint64
+}
+
+type L1BridgeNFTDeposit struct {
+	NFTBridgeTransfer     `gorm:"embedded"`
+	TransactionSourceHash common.Hash `gorm:"primaryKey;serializer:bytes"`
+}
+
+type L1BridgeNFTDepositWithTransactionHashes struct {
+	L1BridgeNFTDeposit L1BridgeNFTDeposit `gorm:"embedded"`
+
+	L1BlockHash       common.Hash `gorm:"serializer:bytes"`
+	L1TransactionHash common.Hash `gorm:"serializer:bytes"`
+	L2TransactionHash common.Hash `gorm:"serializer:bytes"`
+}
+
+type L1BridgeNFTDepositsResponse struct {
+	Deposits    []L1BridgeNFTDepositWithTransactionHashes
+	Cursor      string
+	HasNextPage bool
+}
+
+type L2BridgeNFTWithdrawal struct {
+	NFTBridgeTransfer         `gorm:"embedded"`
+	TransactionWithdrawalHash common.Hash `gorm:"primaryKey;serializer:bytes"`
+}
+
+type L2BridgeNFTWithdrawalWithTransactionHashes struct {
+	L2BridgeNFTWithdrawal L2BridgeNFTWithdrawal `gorm:"embedded"`
+	L2TransactionHash     common.Hash           `gorm:"serializer:bytes"`
+	L2BlockHash           common.Hash           `gorm:"serializer:bytes"`
+
+	ProvenL1TransactionHash    common.Hash `gorm:"serializer:bytes"`
+	FinalizedL1TransactionHash common.Hash `gorm:"serializer:bytes"`
+}
+
+type L2BridgeNFTWithdrawalsResponse struct {
+	Withdrawals []L2BridgeNFTWithdrawalWithTransactionHashes
+	Cursor      string
+	HasNextPage bool
+}
+
+/**
+ * NFTs Bridged (Deposited) from L1
+ */
+
+func (db *bridgeTransfersDB) StoreL1BridgeNFTDeposits(deposits []L1BridgeNFTDeposit) error {
+	deduped := db.gorm.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "transaction_source_hash"}}, DoNothing: true})
+	result := deduped.Create(&deposits)
+	if result.Error == nil && int(result.RowsAffected) < len(deposits) {
+		db.log.Warn("ignored L1 bridge nft transfer duplicates", "duplicates", len(deposits)-int(result.RowsAffected))
+	}
+
+	return result.Error
+}
+
+func (db *bridgeTransfersDB) L1BridgeNFTDeposit(txSourceHash common.Hash) (*L1BridgeNFTDeposit, error) {
+	var deposit L1BridgeNFTDeposit
+	result := db.gorm.Where(&L1BridgeNFTDeposit{TransactionSourceHash: txSourceHash}).Take(&deposit)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &deposit, nil
+}
+
+// L1BridgeNFTDepositsByAddress retrieves a list of nft deposits initiated by the specified address,
+// coupled with the L1/L2 transaction hashes that complete the bridge transaction.
+func (db *bridgeTransfersDB) L1BridgeNFTDepositsByAddress(address common.Address, cursor string, limit int) (*L1BridgeNFTDepositsResponse, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	depositsQuery := db.gorm.Model(&L1BridgeNFTDeposit{})
+	depositsQuery = depositsQuery.Where(&NFTBridgeTransfer{FromAddress: address})
+	depositsQuery = depositsQuery.Joins("INNER JOIN l1_transaction_deposits ON l1_transaction_deposits.source_hash = transaction_source_hash")
+	depositsQuery = depositsQuery.Joins("INNER JOIN l1_contract_events ON l1_contract_events.guid = l1_transaction_deposits.initiated_l1_event_guid")
+	depositsQuery = depositsQuery.Select(`
+l1_bridge_nft_deposits.*, l2_transaction_hash, l1_contract_events.transaction_hash AS l1_transaction_hash, l1_contract_events.block_hash as l1_block_hash`)
+	if cursor != "" {
+		sourceHash := common.HexToHash(cursor)
+		deposit, err := db.L1BridgeNFTDeposit(sourceHash)
+		if err != nil || deposit == nil {
+			return nil, fmt.Errorf("unable to find nft deposit with supplied cursor source hash %s: %w", sourceHash, err)
+		}
+		depositsQuery = depositsQuery.Where("l1_bridge_nft_deposits.timestamp <= ?", deposit.Timestamp)
+	}
+
+	deposits := []L1BridgeNFTDepositWithTransactionHashes{}
+	result := depositsQuery.Order("l1_bridge_nft_deposits.timestamp DESC").Limit(limit + 1).Find(&deposits)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	nextCursor := ""
+	hasNextPage := false
+	if len(deposits) > limit {
+		hasNextPage = true
+		nextCursor = deposits[limit].L1BridgeNFTDeposit.TransactionSourceHash.String()
+		deposits = deposits[:limit]
+	}
+
+	response := &L1BridgeNFTDepositsResponse{Deposits: deposits, Cursor: nextCursor, HasNextPage: hasNextPage}
+	return response, nil
+}
+
+/**
+ * NFTs Bridged (Withdrawn) from L2
+ */
+
+func (db *bridgeTransfersDB) StoreL2BridgeNFTWithdrawals(withdrawals []L2BridgeNFTWithdrawal) error {
+	deduped := db.gorm.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "transaction_withdrawal_hash"}}, DoNothing: true})
+	result := deduped.Create(&withdrawals)
+	if result.Error == nil && int(result.RowsAffected) < len(withdrawals) {
+		db.log.Warn("ignored L2 bridge nft transfer duplicates", "duplicates", len(withdrawals)-int(result.RowsAffected))
+	}
+
+	return result.Error
+}
+
+func (db *bridgeTransfersDB) L2BridgeNFTWithdrawal(txWithdrawalHash common.Hash) (*L2BridgeNFTWithdrawal, error) {
+	var withdrawal L2BridgeNFTWithdrawal
+	result := db.gorm.Where(&L2BridgeNFTWithdrawal{TransactionWithdrawalHash: txWithdrawalHash}).Take(&withdrawal)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &withdrawal, nil
+}
+
+// L2BridgeNFTWithdrawalsByAddress retrieves a list of nft withdrawals initiated by the specified address,
+// coupled with the L2/L1 transaction hashes that complete the bridge transaction.
+func (db *bridgeTransfersDB) L2BridgeNFTWithdrawalsByAddress(address common.Address, cursor string, limit int) (*L2BridgeNFTWithdrawalsResponse, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	withdrawalsQuery := db.gorm.Model(&L2BridgeNFTWithdrawal{})
+	withdrawalsQuery = withdrawalsQuery.Where(&NFTBridgeTransfer{FromAddress: address})
+	withdrawalsQuery = withdrawalsQuery.Joins("INNER JOIN l2_transaction_withdrawals ON withdrawal_hash = l2_bridge_nft_withdrawals.transaction_withdrawal_hash")
+	withdrawalsQuery = withdrawalsQuery.Joins("INNER JOIN l2_contract_events ON l2_contract_events.guid = l2_transaction_withdrawals.initiated_l2_event_guid")
+	withdrawalsQuery = withdrawalsQuery.Joins("LEFT JOIN l1_contract_events AS proven_l1_events ON proven_l1_events.guid = l2_transaction_withdrawals.proven_l1_event_guid")
+	withdrawalsQuery = withdrawalsQuery.Joins("LEFT JOIN l1_contract_events AS finalized_l1_events ON finalized_l1_events.guid = l2_transaction_withdrawals.finalized_l1_event_guid")
+	withdrawalsQuery = withdrawalsQuery.Select(`
+l2_bridge_nft_withdrawals.*, l2_contract_events.transaction_hash AS l2_transaction_hash, l2_contract_events.block_hash as l2_block_hash,
+proven_l1_events.transaction_hash AS proven_l1_transaction_hash, finalized_l1_events.transaction_hash AS finalized_l1_transaction_hash`)
+	if cursor != "" {
+		withdrawalHash := common.HexToHash(cursor)
+		withdrawal, err := db.L2BridgeNFTWithdrawal(withdrawalHash)
+		if err != nil || withdrawal == nil {
+			return nil, fmt.Errorf("unable to find nft withdrawal with supplied cursor withdrawal hash %s: %w", withdrawalHash, err)
+		}
+		withdrawalsQuery = withdrawalsQuery.Where("l2_bridge_nft_withdrawals.timestamp <= ?", withdrawal.Timestamp)
+	}
+
+	withdrawals := []L2BridgeNFTWithdrawalWithTransactionHashes{}
+	result := withdrawalsQuery.Order("l2_bridge_nft_withdrawals.timestamp DESC").Limit(limit + 1).Find(&withdrawals)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	nextCursor := ""
+	hasNextPage := false
+	if len(withdrawals) > limit {
+		hasNextPage = true
+		nextCursor = withdrawals[limit].L2BridgeNFTWithdrawal.TransactionWithdrawalHash.String()
+		withdrawals = withdrawals[:limit]
+	}
+
+	response := &L2BridgeNFTWithdrawalsResponse{Withdrawals: withdrawals, Cursor: nextCursor, HasNextPage: hasNextPage}
+	return response, nil
+}
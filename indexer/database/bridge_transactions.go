@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -43,16 +44,24 @@ type L2TransactionWithdrawal struct {
 	FinalizedL1EventGUID *uuid.UUID
 	Succeeded            *bool
 
+	// Only set for withdrawals proven against a dispute game. The withdrawal
+	// is finalizable once the game has resolved in favor of the defender
+	ProvenDisputeGameAddress *common.Address `gorm:"serializer:bytes"`
+	FinalizableAt            *uint64
+
 	Tx       Transaction `gorm:"embedded"`
 	GasLimit *big.Int    `gorm:"serializer:u256"`
 }
 
 type BridgeTransactionsView interface {
 	L1TransactionDeposit(common.Hash) (*L1TransactionDeposit, error)
+	L1TransactionDepositsWithTransactionHash(common.Hash) ([]L1TransactionDeposit, error)
 	L1LatestBlockHeader() (*L1BlockHeader, error)
 	L1LatestFinalizedBlockHeader() (*L1BlockHeader, error)
 
 	L2TransactionWithdrawal(common.Hash) (*L2TransactionWithdrawal, error)
+	L2TransactionWithdrawalsWithTransactionHash(common.Hash) ([]L2TransactionWithdrawal, error)
+	L2TransactionWithdrawalsWithoutDisputeGame(*common.Hash, int) ([]L2TransactionWithdrawal, error)
 	L2LatestBlockHeader() (*L2BlockHeader, error)
 	L2LatestFinalizedBlockHeader() (*L2BlockHeader, error)
 }
@@ -64,6 +73,8 @@ type BridgeTransactionsDB interface {
 
 	StoreL2TransactionWithdrawals([]L2TransactionWithdrawal) error
 	MarkL2TransactionWithdrawalProvenEvent(common.Hash, uuid.UUID) error
+	MarkL2TransactionWithdrawalProvenDisputeGame(common.Hash, common.Address) error
+	MarkL2TransactionWithdrawalsFinalizable(proofMaturityDelay, gameFinalityDelay uint64) error
 	MarkL2TransactionWithdrawalFinalizedEvent(common.Hash, uuid.UUID, bool) error
 }
 
@@ -107,6 +118,23 @@ func (db *bridgeTransactionsDB) L1TransactionDeposit(sourceHash common.Hash) (*L
 	return &deposit, nil
 }
 
+// L1TransactionDepositsWithTransactionHash returns the deposits that were either initiated
+// by the supplied L1 transaction or executed as the supplied L2 deposit transaction.
+func (db *bridgeTransactionsDB) L1TransactionDepositsWithTransactionHash(txHash common.Hash) ([]L1TransactionDeposit, error) {
+	initiatedEvents := db.gorm.Table("l1_contract_events").Select("guid").Where(&ContractEvent{TransactionHash: txHash})
+
+	query := db.gorm.Where("initiated_l1_event_guid IN (?)", initiatedEvents)
+	query = query.Or(&L1TransactionDeposit{L2TransactionHash: txHash})
+
+	var deposits []L1TransactionDeposit
+	result := query.Order("timestamp ASC").Find(&deposits)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return deposits, nil
+}
+
 func (db *bridgeTransactionsDB) L1LatestBlockHeader() (*L1BlockHeader, error) {
 	// L1: Latest Transaction Deposit
 	l1Query := db.gorm.Where("timestamp = (?)", db.gorm.Table("l1_transaction_deposits").Select("MAX(timestamp)"))
@@ -177,6 +205,24 @@ func (db *bridgeTransactionsDB) L2TransactionWithdrawal(withdrawalHash common.Ha
 	return &withdrawal, nil
 }
 
+// L2TransactionWithdrawalsWithTransactionHash returns the withdrawals that were either initiated by
+// the supplied L2 transaction or proven/finalized by the supplied L1 transaction.
+func (db *bridgeTransactionsDB) L2TransactionWithdrawalsWithTransactionHash(txHash common.Hash) ([]L2TransactionWithdrawal, error) {
+	l2Events := db.gorm.Table("l2_contract_events").Select("guid").Where(&ContractEvent{TransactionHash: txHash})
+	l1Events := db.gorm.Table("l1_contract_events").Select("guid").Where(&ContractEvent{TransactionHash: txHash})
+
+	query := db.gorm.Where("initiated_l2_event_guid IN (?)", l2Events)
+	query = query.Or("proven_l1_event_guid IN (?) OR finalized_l1_event_guid IN (?)", l1Events, l1Events)
+
+	var withdrawals []L2TransactionWithdrawal
+	result := query.Order("timestamp ASC").Find(&withdrawals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return withdrawals, nil
+}
+
 // MarkL2TransactionWithdrawalProvenEvent links a withdrawn transaction with associated Prove action on L1.
 func (db *bridgeTransactionsDB) MarkL2TransactionWithdrawalProvenEvent(withdrawalHash common.Hash, provenL1EventGuid uuid.UUID) error {
 	withdrawal, err := db.L2TransactionWithdrawal(withdrawalHash)
@@ -188,15 +234,77 @@ func (db *bridgeTransactionsDB) MarkL2TransactionWithdrawalProvenEvent(withdrawa
 
 	if withdrawal.ProvenL1EventGUID != nil && withdrawal.ProvenL1EventGUID.ID() == provenL1EventGuid.ID() {
 		return nil
-	} else if withdrawal.ProvenL1EventGUID != nil {
-		return fmt.Errorf("proven withdrawal %s re-proven with a different event %s", withdrawalHash, provenL1EventGuid)
 	}
 
+	// A withdrawal may be re-proven, i.e against a different dispute game when the original game
+	// is resolved in favor of the challenger. The latest proof takes precedence and the link to
+	// the dispute game is cleared until resolved for the latest proof.
 	withdrawal.ProvenL1EventGUID = &provenL1EventGuid
+	withdrawal.ProvenDisputeGameAddress = nil
+	withdrawal.FinalizableAt = nil
+	result := db.gorm.Save(&withdrawal)
+	return result.Error
+}
+
+// MarkL2TransactionWithdrawalProvenDisputeGame links a proven withdrawal with the dispute game it was proven against.
+// The zero address marks a withdrawal proven without a dispute game, so that it is no longer considered unlinked
+func (db *bridgeTransactionsDB) MarkL2TransactionWithdrawalProvenDisputeGame(withdrawalHash common.Hash, gameAddress common.Address) error {
+	withdrawal, err := db.L2TransactionWithdrawal(withdrawalHash)
+	if err != nil {
+		return err
+	} else if withdrawal == nil {
+		return fmt.Errorf("transaction withdrawal hash %s not found", withdrawalHash)
+	} else if withdrawal.ProvenL1EventGUID == nil {
+		return fmt.Errorf("cannot link unproven withdrawal hash %s to a dispute game", withdrawal.WithdrawalHash)
+	}
+
+	withdrawal.ProvenDisputeGameAddress = &gameAddress
 	result := db.gorm.Save(&withdrawal)
 	return result.Error
 }
 
+// MarkL2TransactionWithdrawalsFinalizable sets the finalization timestamp of the withdrawals proven against
+// a dispute game that resolved in favor of the defender. A withdrawal is finalizable once both the proof
+// has matured and the game resolution has passed the finality delay, as enforced by the OptimismPortal
+func (db *bridgeTransactionsDB) MarkL2TransactionWithdrawalsFinalizable(proofMaturityDelay, gameFinalityDelay uint64) error {
+	provenTimestamp := db.gorm.Table("l1_contract_events").Select("timestamp").Where("guid = l2_transaction_withdrawals.proven_l1_event_guid")
+	resolvedTimestamp := db.gorm.Table("l1_dispute_games").Select("resolved_at").Where("game_address = l2_transaction_withdrawals.proven_dispute_game_address")
+	defenderWins := db.gorm.Table("l1_dispute_games").Select("game_address").Where("status = ?", GameStatusDefenderWins)
+
+	query := db.gorm.Model(&L2TransactionWithdrawal{}).Where("finalizable_at IS NULL AND proven_dispute_game_address IN (?)", defenderWins)
+	result := query.Update("finalizable_at", gorm.Expr("GREATEST((?) + ?, (?) + ?)", provenTimestamp, proofMaturityDelay, resolvedTimestamp, gameFinalityDelay))
+	if result.Error == nil && result.RowsAffected > 0 {
+		db.log.Info("marked finalizable withdrawals", "size", result.RowsAffected)
+	}
+
+	return result.Error
+}
+
+// L2TransactionWithdrawalsWithoutDisputeGame returns the proven withdrawals that are not yet linked to a dispute game,
+// ordered by withdrawal hash and bounded by the supplied limit. When set, only withdrawals with a hash after the supplied
+// cursor are returned. Only withdrawals proven since the first indexed game are considered as withdrawals proven prior
+// were proven against the L2OutputOracle.
+func (db *bridgeTransactionsDB) L2TransactionWithdrawalsWithoutDisputeGame(after *common.Hash, limit int) ([]L2TransactionWithdrawal, error) {
+	firstGameTimestamp := db.gorm.Table("l1_dispute_games").Select("MIN(timestamp)")
+
+	query := db.gorm.Model(&L2TransactionWithdrawal{})
+	query = query.Joins("INNER JOIN l1_contract_events AS proven_l1_events ON proven_l1_events.guid = l2_transaction_withdrawals.proven_l1_event_guid")
+	query = query.Where("l2_transaction_withdrawals.proven_dispute_game_address IS NULL AND proven_l1_events.timestamp >= (?)", firstGameTimestamp)
+	if after != nil {
+		// hashes are stored as lowercase hex strings, which order as the hash bytes
+		query = query.Where("l2_transaction_withdrawals.withdrawal_hash > ?", hexutil.Encode(after.Bytes()))
+	}
+	query = query.Select("l2_transaction_withdrawals.*").Order("l2_transaction_withdrawals.withdrawal_hash ASC").Limit(limit)
+
+	var withdrawals []L2TransactionWithdrawal
+	result := query.Find(&withdrawals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return withdrawals, nil
+}
+
 // MarkL2TransactionWithdrawalProvenEvent links a withdrawn transaction in its finalized state
 func (db *bridgeTransactionsDB) MarkL2TransactionWithdrawalFinalizedEvent(withdrawalHash common.Hash, finalizedL1EventGuid uuid.UUID, succeeded bool) error {
 	withdrawal, err := db.L2TransactionWithdrawal(withdrawalHash)
@@ -58,6 +58,11 @@ type L2BridgeWithdrawalWithTransactionHashes struct {
 
 	ProvenL1TransactionHash    common.Hash `gorm:"serializer:bytes"`
 	FinalizedL1TransactionHash common.Hash `gorm:"serializer:bytes"`
+
+	// Only set for withdrawals proven against a dispute game
+	ProvenDisputeGameAddress *common.Address `gorm:"serializer:bytes"`
+	ProvenDisputeGameStatus  *GameStatus
+	FinalizableAt            *uint64
 }
 
 type BridgeTransfersView interface {
@@ -70,6 +75,12 @@ type BridgeTransfersView interface {
 	L2BridgeWithdrawalSum(filter WithdrawFilter) (float64, error)
 	L2BridgeWithdrawalWithFilter(BridgeTransfer) (*L2BridgeWithdrawal, error)
 	L2BridgeWithdrawalsByAddress(common.Address, string, int) (*L2BridgeWithdrawalsResponse, error)
+
+	L1BridgeNFTDeposit(common.Hash) (*L1BridgeNFTDeposit, error)
+	L1BridgeNFTDepositsByAddress(common.Address, string, int) (*L1BridgeNFTDepositsResponse, error)
+
+	L2BridgeNFTWithdrawal(common.Hash) (*L2BridgeNFTWithdrawal, error)
+	L2BridgeNFTWithdrawalsByAddress(common.Address, string, int) (*L2BridgeNFTWithdrawalsResponse, error)
 }
 
 type BridgeTransfersDB interface {
@@ -77,6 +88,9 @@ type BridgeTransfersDB interface {
 
 	StoreL1BridgeDeposits([]L1BridgeDeposit) error
 	StoreL2BridgeWithdrawals([]L2BridgeWithdrawal) error
+
+	StoreL1BridgeNFTDeposits([]L1BridgeNFTDeposit) error
+	StoreL2BridgeNFTWithdrawals([]L2BridgeNFTWithdrawal) error
 }
 
 /**
@@ -340,9 +354,11 @@ func (db *bridgeTransfersDB) L2BridgeWithdrawalsByAddress(address common.Address
 	ethTransactionWithdrawals = ethTransactionWithdrawals.Joins("INNER JOIN l2_contract_events ON l2_contract_events.guid = l2_transaction_withdrawals.initiated_l2_event_guid")
 	ethTransactionWithdrawals = ethTransactionWithdrawals.Joins("LEFT JOIN l1_contract_events AS proven_l1_events ON proven_l1_events.guid = l2_transaction_withdrawals.proven_l1_event_guid")
 	ethTransactionWithdrawals = ethTransactionWithdrawals.Joins("LEFT JOIN l1_contract_events AS finalized_l1_events ON finalized_l1_events.guid = l2_transaction_withdrawals.finalized_l1_event_guid")
+	ethTransactionWithdrawals = ethTransactionWithdrawals.Joins("LEFT JOIN l1_dispute_games AS proven_dispute_games ON proven_dispute_games.game_address = l2_transaction_withdrawals.proven_dispute_game_address")
 	ethTransactionWithdrawals = ethTransactionWithdrawals.Select(`
 from_address, to_address, amount, data, withdrawal_hash AS transaction_withdrawal_hash,
 l2_contract_events.transaction_hash AS l2_transaction_hash, l2_contract_events.block_hash as l2_block_hash, proven_l1_events.transaction_hash AS proven_l1_transaction_hash, finalized_l1_events.transaction_hash AS finalized_l1_transaction_hash,
+l2_transaction_withdrawals.proven_dispute_game_address, proven_dispute_games.status AS proven_dispute_game_status, l2_transaction_withdrawals.finalizable_at,
 l2_transaction_withdrawals.timestamp, NULL AS cross_domain_message_hash, ? AS local_token_address, ? AS remote_token_address`, ethAddressString, ethAddressString)
 	ethTransactionWithdrawals = ethTransactionWithdrawals.Order("timestamp DESC").Limit(limit + 1)
 	if cursorClause != "" {
@@ -355,9 +371,11 @@ l2_transaction_withdrawals.timestamp, NULL AS cross_domain_message_hash, ? AS lo
 	withdrawalsQuery = withdrawalsQuery.Joins("INNER JOIN l2_contract_events ON l2_contract_events.guid = l2_transaction_withdrawals.initiated_l2_event_guid")
 	withdrawalsQuery = withdrawalsQuery.Joins("LEFT JOIN l1_contract_events AS proven_l1_events ON proven_l1_events.guid = l2_transaction_withdrawals.proven_l1_event_guid")
 	withdrawalsQuery = withdrawalsQuery.Joins("LEFT JOIN l1_contract_events AS finalized_l1_events ON finalized_l1_events.guid = l2_transaction_withdrawals.finalized_l1_event_guid")
+	withdrawalsQuery = withdrawalsQuery.Joins("LEFT JOIN l1_dispute_games AS proven_dispute_games ON proven_dispute_games.game_address = l2_transaction_withdrawals.proven_dispute_game_address")
 	withdrawalsQuery = withdrawalsQuery.Select(`
 l2_bridge_withdrawals.from_address, l2_bridge_withdrawals.to_address, l2_bridge_withdrawals.amount, l2_bridge_withdrawals.data, transaction_withdrawal_hash,
 l2_contract_events.transaction_hash AS l2_transaction_hash, l2_contract_events.block_hash as l2_block_hash, proven_l1_events.transaction_hash AS proven_l1_transaction_hash, finalized_l1_events.transaction_hash AS finalized_l1_transaction_hash,
+l2_transaction_withdrawals.proven_dispute_game_address, proven_dispute_games.status AS proven_dispute_game_status, l2_transaction_withdrawals.finalizable_at,
 l2_bridge_withdrawals.timestamp, cross_domain_message_hash, local_token_address, remote_token_address`)
 	withdrawalsQuery = withdrawalsQuery.Order("timestamp DESC").Limit(limit + 1)
 	if cursorClause != "" {
@@ -0,0 +1,148 @@
+package database
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+/**
+ * Types
+ */
+
+// GameStatus mirrors the `GameStatus` enum of the dispute game contracts
+type GameStatus uint8
+
+const (
+	GameStatusInProgress GameStatus = iota
+	GameStatusChallengerWins
+	GameStatusDefenderWins
+)
+
+func (s GameStatus) String() string {
+	switch s {
+	case GameStatusInProgress:
+		return "IN_PROGRESS"
+	case GameStatusChallengerWins:
+		return "CHALLENGER_WINS"
+	case GameStatusDefenderWins:
+		return "DEFENDER_WINS"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+type L1DisputeGame struct {
+	GameAddress        common.Address `gorm:"primaryKey;serializer:bytes"`
+	CreatedL1EventGUID uuid.UUID
+
+	GameType      uint8
+	RootClaim     common.Hash `gorm:"serializer:bytes"`
+	L2BlockNumber *big.Int    `gorm:"serializer:u256"`
+
+	Status     GameStatus
+	ResolvedAt *uint64
+	Timestamp  uint64
+}
+
+type DisputeGamesView interface {
+	L1DisputeGame(common.Address) (*L1DisputeGame, error)
+	L1LatestDisputeGameBlockHeader() (*L1BlockHeader, error)
+	L1InProgressDisputeGames(*common.Address, int) ([]L1DisputeGame, error)
+}
+
+type DisputeGamesDB interface {
+	DisputeGamesView
+
+	StoreL1DisputeGames([]L1DisputeGame) error
+	MarkL1DisputeGameResolved(common.Address, GameStatus, uint64) error
+}
+
+/**
+ * Implementation
+ */
+
+type disputeGamesDB struct {
+	log  log.Logger
+	gorm *gorm.DB
+}
+
+func newDisputeGamesDB(log log.Logger, db *gorm.DB) DisputeGamesDB {
+	return &disputeGamesDB{log: log.New("table", "dispute_games"), gorm: db}
+}
+
+func (db *disputeGamesDB) StoreL1DisputeGames(games []L1DisputeGame) error {
+	deduped := db.gorm.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "game_address"}}, DoNothing: true})
+	result := deduped.Create(&games)
+	if result.Error == nil && int(result.RowsAffected) < len(games) {
+		db.log.Warn("ignored L1 dispute game duplicates", "duplicates", len(games)-int(result.RowsAffected))
+	}
+
+	return result.Error
+}
+
+func (db *disputeGamesDB) L1DisputeGame(gameAddress common.Address) (*L1DisputeGame, error) {
+	var game L1DisputeGame
+	result := db.gorm.Where(&L1DisputeGame{GameAddress: gameAddress}).Take(&game)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &game, nil
+}
+
+// L1LatestDisputeGameBlockHeader returns the L1 block header of the latest created dispute game
+func (db *disputeGamesDB) L1LatestDisputeGameBlockHeader() (*L1BlockHeader, error) {
+	latestGame := db.gorm.Table("l1_dispute_games").Order("timestamp DESC").Limit(1)
+	createdQuery := db.gorm.Table("l1_contract_events").Where("guid = (?)", latestGame.Select("created_l1_event_guid"))
+	l1Query := db.gorm.Where("hash = (?)", createdQuery.Select("block_hash"))
+
+	var l1Header L1BlockHeader
+	result := l1Query.Take(&l1Header)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &l1Header, nil
+}
+
+// L1InProgressDisputeGames returns the dispute games that are yet to be resolved, ordered by game address and
+// bounded by the supplied limit. When set, only games with an address after the supplied cursor are returned
+func (db *disputeGamesDB) L1InProgressDisputeGames(after *common.Address, limit int) ([]L1DisputeGame, error) {
+	query := db.gorm.Where("status = ?", GameStatusInProgress)
+	if after != nil {
+		// addresses are stored as lowercase hex strings, which order as the address bytes
+		query = query.Where("game_address > ?", hexutil.Encode(after.Bytes()))
+	}
+
+	var games []L1DisputeGame
+	result := query.Order("game_address ASC").Limit(limit).Find(&games)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return games, nil
+}
+
+func (db *disputeGamesDB) MarkL1DisputeGameResolved(gameAddress common.Address, status GameStatus, resolvedAt uint64) error {
+	result := db.gorm.Model(&L1DisputeGame{}).Where(&L1DisputeGame{GameAddress: gameAddress}).
+		Updates(map[string]interface{}{"status": status, "resolved_at": resolvedAt})
+	if result.Error == nil && result.RowsAffected == 0 {
+		return errors.New("dispute game not found")
+	}
+
+	return result.Error
+}
@@ -1,6 +1,8 @@
 package database
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"gorm.io/gorm"
 
@@ -76,6 +78,16 @@ func (m *MockBlocksDB) StoreL2BlockHeaders(headers []L2BlockHeader) error {
 	return args.Error(1)
 }
 
+func (m *MockBlocksDB) DeleteL1BlockHeadersAfter(height *big.Int) error {
+	args := m.Called(height)
+	return args.Error(0)
+}
+
+func (m *MockBlocksDB) DeleteL2BlockHeadersAfter(height *big.Int) error {
+	args := m.Called(height)
+	return args.Error(0)
+}
+
 // MockDB is a mock database that can be used for testing
 type MockDB struct {
 	MockBlocks *MockBlocksDB
@@ -0,0 +1,121 @@
+package e2e_tests
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/indexer/config"
+	"github.com/ethereum-optimism/optimism/indexer/database"
+)
+
+// setupDisputeGamesTestDB creates a migrated database with an L1 and an L2 block to reference
+func setupDisputeGamesTestDB(t *testing.T) (*database.DB, *types.Header, *types.Header) {
+	dbName := setupTestDatabase(t)
+	noopLog := log.New()
+	noopLog.SetHandler(log.DiscardHandler())
+	db, err := database.NewDB(context.Background(), noopLog, config.DBConfig{Host: "127.0.0.1", Port: 5432, Name: dbName, User: os.Getenv("DB_USER")})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	l1Header := &types.Header{Number: big.NewInt(1), Time: 1}
+	l2Header := &types.Header{Number: big.NewInt(1), Time: 1}
+	require.NoError(t, db.Blocks.StoreL1BlockHeaders([]database.L1BlockHeader{{BlockHeader: database.BlockHeaderFromHeader(l1Header)}}))
+	require.NoError(t, db.Blocks.StoreL2BlockHeaders([]database.L2BlockHeader{{BlockHeader: database.BlockHeaderFromHeader(l2Header)}}))
+	return db, l1Header, l2Header
+}
+
+func TestE2EDisputeGamesDBInProgressGamesCursor(t *testing.T) {
+	db, l1Header, _ := setupDisputeGamesTestDB(t)
+
+	// games with addresses [1..5], of which the 3rd is resolved
+	var games []database.L1DisputeGame
+	var events []database.L1ContractEvent
+	for i := 1; i <= 5; i++ {
+		event := database.ContractEventFromLog(&types.Log{BlockHash: l1Header.Hash(), Index: uint(i)}, l1Header.Time)
+		events = append(events, database.L1ContractEvent{ContractEvent: event})
+		games = append(games, database.L1DisputeGame{
+			GameAddress:        common.BigToAddress(big.NewInt(int64(i))),
+			CreatedL1EventGUID: event.GUID,
+			L2BlockNumber:      big.NewInt(int64(i)),
+			Status:             database.GameStatusInProgress,
+			Timestamp:          uint64(10 - i), // not in address order
+		})
+	}
+	require.NoError(t, db.ContractEvents.StoreL1ContractEvents(events))
+	require.NoError(t, db.DisputeGames.StoreL1DisputeGames(games))
+	require.NoError(t, db.DisputeGames.MarkL1DisputeGameResolved(games[2].GameAddress, database.GameStatusDefenderWins, 1))
+
+	page, err := db.DisputeGames.L1InProgressDisputeGames(nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, games[0].GameAddress, page[0].GameAddress)
+	require.Equal(t, games[1].GameAddress, page[1].GameAddress)
+
+	page, err = db.DisputeGames.L1InProgressDisputeGames(&page[1].GameAddress, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, games[3].GameAddress, page[0].GameAddress, "resolved games are skipped")
+	require.Equal(t, games[4].GameAddress, page[1].GameAddress)
+
+	page, err = db.DisputeGames.L1InProgressDisputeGames(&page[1].GameAddress, 2)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func TestE2EDisputeGamesDBWithdrawalsWithoutDisputeGameCursor(t *testing.T) {
+	db, l1Header, l2Header := setupDisputeGamesTestDB(t)
+
+	// the first game, from which proven withdrawals are linked to games
+	gameEvent := database.ContractEventFromLog(&types.Log{BlockHash: l1Header.Hash(), Index: 0}, l1Header.Time)
+	require.NoError(t, db.ContractEvents.StoreL1ContractEvents([]database.L1ContractEvent{{ContractEvent: gameEvent}}))
+	game := database.L1DisputeGame{GameAddress: common.HexToAddress("0x9a3e"), CreatedL1EventGUID: gameEvent.GUID, L2BlockNumber: big.NewInt(1), Timestamp: l1Header.Time}
+	require.NoError(t, db.DisputeGames.StoreL1DisputeGames([]database.L1DisputeGame{game}))
+
+	// proven withdrawals with hashes [1..5], of which the 3rd is linked to the game
+	var withdrawals []database.L2TransactionWithdrawal
+	var initiatedEvents []database.L2ContractEvent
+	var provenEvents []database.L1ContractEvent
+	for i := 1; i <= 5; i++ {
+		initiatedEvent := database.ContractEventFromLog(&types.Log{BlockHash: l2Header.Hash(), Index: uint(i)}, l2Header.Time)
+		initiatedEvents = append(initiatedEvents, database.L2ContractEvent{ContractEvent: initiatedEvent})
+		provenEvent := database.ContractEventFromLog(&types.Log{BlockHash: l1Header.Hash(), Index: uint(i)}, l1Header.Time)
+		provenEvents = append(provenEvents, database.L1ContractEvent{ContractEvent: provenEvent})
+		withdrawals = append(withdrawals, database.L2TransactionWithdrawal{
+			WithdrawalHash:       common.BigToHash(big.NewInt(int64(i))),
+			Nonce:                big.NewInt(int64(i)),
+			InitiatedL2EventGUID: initiatedEvent.GUID,
+			GasLimit:             big.NewInt(0),
+			Tx:                   database.Transaction{Amount: big.NewInt(0), Data: []byte{}, Timestamp: l2Header.Time},
+		})
+	}
+	require.NoError(t, db.ContractEvents.StoreL2ContractEvents(initiatedEvents))
+	require.NoError(t, db.ContractEvents.StoreL1ContractEvents(provenEvents))
+	require.NoError(t, db.BridgeTransactions.StoreL2TransactionWithdrawals(withdrawals))
+	for i := range withdrawals {
+		require.NoError(t, db.BridgeTransactions.MarkL2TransactionWithdrawalProvenEvent(withdrawals[i].WithdrawalHash, provenEvents[i].GUID))
+	}
+	require.NoError(t, db.BridgeTransactions.MarkL2TransactionWithdrawalProvenDisputeGame(withdrawals[2].WithdrawalHash, game.GameAddress))
+
+	page, err := db.BridgeTransactions.L2TransactionWithdrawalsWithoutDisputeGame(nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, withdrawals[0].WithdrawalHash, page[0].WithdrawalHash)
+	require.Equal(t, withdrawals[1].WithdrawalHash, page[1].WithdrawalHash)
+
+	page, err = db.BridgeTransactions.L2TransactionWithdrawalsWithoutDisputeGame(&page[1].WithdrawalHash, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, withdrawals[3].WithdrawalHash, page[0].WithdrawalHash, "linked withdrawals are skipped")
+	require.Equal(t, withdrawals[4].WithdrawalHash, page[1].WithdrawalHash)
+
+	page, err = db.BridgeTransactions.L2TransactionWithdrawalsWithoutDisputeGame(&page[1].WithdrawalHash, 2)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
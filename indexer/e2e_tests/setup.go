@@ -129,7 +129,12 @@ func createE2ETestSuite(t *testing.T) E2ETestSuite {
 	// API Configuration and Start
 	apiLog := testlog.Logger(t, log.LvlInfo).New("role", "indexer_api")
 	apiCfg := &api.Config{
-		DB:            &api.TestDBConnector{BridgeTransfers: ix.DB.BridgeTransfers}, // reuse the same DB
+		DB: &api.TestDBConnector{ // reuse the same DB
+			BridgeTransfers:    ix.DB.BridgeTransfers,
+			BridgeTransactions: ix.DB.BridgeTransactions,
+			BridgeMessages:     ix.DB.BridgeMessages,
+			ContractEvents:     ix.DB.ContractEvents,
+		},
 		HTTPServer:    config.ServerConfig{Host: "127.0.0.1", Port: 0},
 		MetricsServer: config.ServerConfig{Host: "127.0.0.1", Port: 0},
 	}
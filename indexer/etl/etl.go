@@ -12,8 +12,16 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/indexer/database"
 	"github.com/ethereum-optimism/optimism/indexer/node"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+)
+
+var (
+	// ErrBatchReorged is returned when the traversed headers of a batch are no longer
+	// part of the provider's canonical chain and the indexed state must be rolled back.
+	ErrBatchReorged = errors.New("batch headers have been reorged")
 )
 
 type Config struct {
@@ -52,6 +60,11 @@ type ETLBatch struct {
 
 	Logs           []types.Log
 	HeadersWithLog map[common.Hash]bool
+
+	// When set, this batch carries no data but signals a detected reorg. The consumer must
+	// roll back the indexed state that is no longer canonical and reply with the common
+	// ancestor to continue traversal from (nil for genesis). Closed without a reply on failure.
+	reorg chan *types.Header
 }
 
 // Start starts the ETL polling routine. The ETL work should be stopped with Close().
@@ -74,13 +87,17 @@ func (etl *ETL) Close() error {
 	return etl.worker.Close()
 }
 
-func (etl *ETL) tick(_ context.Context) {
+func (etl *ETL) tick(ctx context.Context) {
 	done := etl.metrics.RecordInterval()
 	if len(etl.headers) > 0 {
 		etl.log.Info("retrying previous batch")
 	} else {
 		newHeaders, err := etl.headerTraversal.NextHeaders(etl.headerBufferSize)
-		if err != nil {
+		if errors.Is(err, node.ErrHeaderTraversalAndProviderMismatchedState) {
+			etl.log.Warn("detected reorg of traversed headers")
+			done(etl.rollback(ctx))
+			return
+		} else if err != nil {
 			etl.log.Error("error querying for headers", "err", err)
 		} else if len(newHeaders) == 0 {
 			etl.log.Warn("no new headers. etl at head?")
@@ -98,11 +115,44 @@ func (etl *ETL) tick(_ context.Context) {
 	err := etl.processBatch(etl.headers)
 	if err == nil {
 		etl.headers = nil
+	} else if errors.Is(err, ErrBatchReorged) {
+		err = etl.rollback(ctx)
 	}
 
 	done(err)
 }
 
+// rollback discards any pending headers and signals the batch consumer that a reorg has been
+// detected. Once the consumer has rolled back the indexed state, the traversal is rewound to
+// the reported common ancestor and the reorged range is re-indexed on the following intervals.
+func (etl *ETL) rollback(ctx context.Context) error {
+	etl.headers = nil
+	etl.metrics.RecordReorg()
+
+	reorg := make(chan *types.Header, 1)
+	select {
+	case etl.etlBatches <- &ETLBatch{Logger: etl.log, reorg: reorg}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case header, ok := <-reorg:
+		if !ok {
+			return errors.New("failed to roll back reorged state")
+		}
+		if header != nil {
+			etl.log.Warn("rewinding header traversal", "number", header.Number, "hash", header.Hash())
+		} else {
+			etl.log.Warn("rewinding header traversal to genesis")
+		}
+		etl.headerTraversal.Rewind(header)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (etl *ETL) processBatch(headers []types.Header) error {
 	if len(headers) == 0 {
 		return nil
@@ -131,8 +181,8 @@ func (etl *ETL) processBatch(headers []types.Header) error {
 		batchLog.Warn("mismatch in FilterLog#ToBlock number", "queried_to_block_number", lastHeader.Number, "reported_to_block_number", logs.ToBlockHeader.Number)
 		return fmt.Errorf("mismatch in FilterLog#ToBlock number")
 	} else if logs.ToBlockHeader.Hash() != lastHeader.Hash() {
-		batchLog.Error("mismatch in FilterLog#ToBlock block hash!!!", "queried_to_block_hash", lastHeader.Hash().String(), "reported_to_block_hash", logs.ToBlockHeader.Hash().String())
-		return fmt.Errorf("mismatch in FilterLog#ToBlock block hash!!!")
+		batchLog.Warn("mismatch in FilterLog#ToBlock block hash", "queried_to_block_hash", lastHeader.Hash().String(), "reported_to_block_hash", logs.ToBlockHeader.Hash().String())
+		return fmt.Errorf("mismatch in FilterLog#ToBlock block hash: %w", ErrBatchReorged)
 	}
 
 	if len(logs.Logs) > 0 {
@@ -143,11 +193,9 @@ func (etl *ETL) processBatch(headers []types.Header) error {
 		log := logs.Logs[i]
 		headersWithLog[log.BlockHash] = true
 		if _, ok := headerMap[log.BlockHash]; !ok {
-			// NOTE. Definitely an error state if the none of the headers were re-orged out in between
-			// the blocks and logs retrieval operations. Unlikely as long as the confirmation depth has
-			// been appropriately set or when we get to natively handling reorgs.
-			batchLog.Error("log found with block hash not in the batch", "block_hash", logs.Logs[i].BlockHash, "log_index", logs.Logs[i].Index)
-			return errors.New("parsed log with a block hash not in the batch")
+			// The headers of this batch were reorged out in between the blocks and logs retrieval operations
+			batchLog.Warn("log found with block hash not in the batch", "block_hash", logs.Logs[i].BlockHash, "log_index", logs.Logs[i].Index)
+			return fmt.Errorf("parsed log with a block hash not in the batch: %w", ErrBatchReorged)
 		}
 	}
 
@@ -156,3 +204,84 @@ func (etl *ETL) processBatch(headers []types.Header) error {
 	etl.etlBatches <- &ETLBatch{Logger: batchLog, Headers: headersRef, HeaderMap: headerMap, Logs: logs.Logs, HeadersWithLog: headersWithLog}
 	return nil
 }
+
+// findCommonAncestor walks back the indexed headers, starting from the latest, until a header
+// is found that is still part of the provider's canonical chain. Nil is returned when none of
+// the indexed headers are canonical.
+func findCommonAncestor(client node.EthClient, prevIndexedHeader func(*big.Int) (*database.BlockHeader, error)) (*database.BlockHeader, error) {
+	var height *big.Int // nil to start from the latest indexed header
+	for {
+		indexedHeader, err := prevIndexedHeader(height)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query indexed header: %w", err)
+		} else if indexedHeader == nil {
+			return nil, nil
+		}
+
+		canonicalHeader, err := client.BlockHeaderByNumber(indexedHeader.Number)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query canonical header: %w", err)
+		} else if canonicalHeader != nil && canonicalHeader.Hash() == indexedHeader.Hash {
+			return indexedHeader, nil
+		}
+
+		height = indexedHeader.Number
+	}
+}
+
+// indexedHeaders are the indexed block headers of a chain, which are rolled back on a reorg
+type indexedHeaders interface {
+	// PrevIndexedHeader returns the latest indexed header below the height, or the
+	// latest indexed header if the height is nil. Nil is returned if there is none.
+	PrevIndexedHeader(height *big.Int) (*database.BlockHeader, error)
+
+	// DeleteHeadersAfter removes the headers above the height, with the indexed state
+	// that references them.
+	DeleteHeadersAfter(height *big.Int) error
+}
+
+// rollbackIndexedState removes the indexed headers that are no longer canonical, in a single transaction,
+// and returns the common ancestor to continue indexing from. If none of the indexed headers are canonical,
+// indexing restarts from the start height, or genesis (a nil ancestor) if no start height is configured.
+func (etl *ETL) rollbackIndexedState(ctx context.Context, batch *ETLBatch, transaction func(func(indexedHeaders) error) error, startHeight *big.Int) (*types.Header, error) {
+	retryStrategy := &retry.ExponentialStrategy{Min: 1000, Max: 20_000, MaxJitter: 250}
+	ancestor, err := retry.Do[*types.Header](ctx, 10, retryStrategy, func() (*types.Header, error) {
+		var ancestor *types.Header
+		if err := transaction(func(headers indexedHeaders) error {
+			indexedAncestor, err := findCommonAncestor(etl.EthClient, headers.PrevIndexedHeader)
+			if err != nil {
+				return err
+			} else if indexedAncestor != nil {
+				ancestor = indexedAncestor.RLPHeader.Header()
+				return headers.DeleteHeadersAfter(ancestor.Number)
+			}
+
+			if startHeight != nil && startHeight.BitLen() > 0 {
+				// none of the indexed state is canonical, restart from the configured starting height
+				startHeader, err := etl.EthClient.BlockHeaderByNumber(startHeight)
+				if err != nil {
+					return fmt.Errorf("could not fetch starting block header: %w", err)
+				}
+				ancestor = startHeader
+			}
+			// none of the indexed headers is canonical, including any at or below the start height
+			return headers.DeleteHeadersAfter(big.NewInt(-1))
+		}); err != nil {
+			batch.Logger.Error("unable to roll back reorged state", "err", err)
+			return nil, fmt.Errorf("unable to roll back reorged state: %w", err)
+		}
+
+		return ancestor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ancestor != nil {
+		batch.Logger.Warn("rolled back reorged state", "common_ancestor_number", ancestor.Number, "common_ancestor_hash", ancestor.Hash())
+		etl.metrics.RecordEtlLatestHeight(ancestor.Number)
+	} else {
+		batch.Logger.Warn("rolled back all reorged state")
+	}
+	return ancestor, nil
+}
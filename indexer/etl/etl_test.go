@@ -0,0 +1,163 @@
+package etl
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/indexer/bigint"
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestFindCommonAncestor(t *testing.T) {
+	// indexed blocks [0..4], with blocks [3..4] reorged out by the provider
+	indexed := make([]types.Header, 5)
+	for i := range indexed {
+		indexed[i] = types.Header{Number: big.NewInt(int64(i))}
+		if i > 0 {
+			indexed[i].ParentHash = indexed[i-1].Hash()
+		}
+	}
+	canonical := make([]types.Header, 5)
+	copy(canonical, indexed)
+	canonical[3].Extra = []byte("reorged")
+	canonical[4].ParentHash = canonical[3].Hash()
+
+	client := new(node.MockEthClient)
+	for i := range canonical {
+		client.On("BlockHeaderByNumber", mock.MatchedBy(bigint.Matcher(int64(i)))).Return(&canonical[i], nil)
+	}
+
+	prevIndexedHeader := func(height *big.Int) (*database.BlockHeader, error) {
+		for i := len(indexed) - 1; i >= 0; i-- {
+			if height == nil || indexed[i].Number.Cmp(height) < 0 {
+				header := database.BlockHeaderFromHeader(&indexed[i])
+				return &header, nil
+			}
+		}
+		return nil, nil
+	}
+
+	ancestor, err := findCommonAncestor(client, prevIndexedHeader)
+	require.NoError(t, err)
+	require.NotNil(t, ancestor)
+	require.Equal(t, indexed[2].Hash(), ancestor.Hash)
+
+	// no indexed state remains canonical
+	canonical[0].Extra = []byte("reorged")
+	canonical[1].ParentHash = canonical[0].Hash()
+	canonical[2].ParentHash = canonical[1].Hash()
+	ancestor, err = findCommonAncestor(client, prevIndexedHeader)
+	require.NoError(t, err)
+	require.Nil(t, ancestor)
+}
+
+// memIndexedHeaders are indexed headers kept in memory, ordered by number
+type memIndexedHeaders struct {
+	headers []database.BlockHeader
+}
+
+func (m *memIndexedHeaders) PrevIndexedHeader(height *big.Int) (*database.BlockHeader, error) {
+	for i := len(m.headers) - 1; i >= 0; i-- {
+		if height == nil || m.headers[i].Number.Cmp(height) < 0 {
+			header := m.headers[i]
+			return &header, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memIndexedHeaders) DeleteHeadersAfter(height *big.Int) error {
+	for i := range m.headers {
+		if m.headers[i].Number.Cmp(height) > 0 {
+			m.headers = m.headers[:i]
+			break
+		}
+	}
+	return nil
+}
+
+func TestRollbackIndexedState(t *testing.T) {
+	// canonical chain of blocks [0..9], of which the indexed blocks [6..9] are reorged out
+	chain := func(reorgFrom int) []types.Header {
+		headers := make([]types.Header, 10)
+		for i := range headers {
+			headers[i] = types.Header{Number: big.NewInt(int64(i))}
+			if i >= reorgFrom {
+				headers[i].Extra = []byte("reorged")
+			}
+			if i > 0 {
+				headers[i].ParentHash = headers[i-1].Hash()
+			}
+		}
+		return headers
+	}
+	indexed := chain(10) // as indexed, before the reorg
+
+	tests := []struct {
+		name        string
+		reorgFrom   int
+		startHeight *big.Int
+		indexedFrom uint64   // the first indexed height
+		ancestor    *big.Int // nil for genesis
+		remaining   int
+	}{
+		{name: "rolls back to common ancestor", reorgFrom: 6, ancestor: big.NewInt(5), remaining: 6},
+		// indexing starts after the header at the start height
+		{name: "rolls back to start height", reorgFrom: 0, startHeight: big.NewInt(3), indexedFrom: 4, ancestor: big.NewInt(3), remaining: 0},
+		// e.g. indexed with a lower start height before
+		{name: "removes reorged header at start height", reorgFrom: 0, startHeight: big.NewInt(3), indexedFrom: 3, ancestor: big.NewInt(3), remaining: 0},
+		{name: "rolls back everything", reorgFrom: 0, remaining: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			canonical := chain(test.reorgFrom)
+			client := new(node.MockEthClient)
+			for i := range canonical {
+				client.On("BlockHeaderByNumber", mock.MatchedBy(bigint.Matcher(int64(i)))).Return(&canonical[i], nil)
+			}
+
+			// rows are only kept if the transaction succeeds
+			rows := &memIndexedHeaders{}
+			for i := range indexed {
+				if indexed[i].Number.Uint64() < test.indexedFrom {
+					continue
+				}
+				rows.headers = append(rows.headers, database.BlockHeaderFromHeader(&indexed[i]))
+			}
+			transaction := func(fn func(indexedHeaders) error) error {
+				tx := &memIndexedHeaders{headers: append([]database.BlockHeader(nil), rows.headers...)}
+				if err := fn(tx); err != nil {
+					return err
+				}
+				rows.headers = tx.headers
+				return nil
+			}
+
+			logger := testlog.Logger(t, log.LvlInfo)
+			etl := ETL{log: logger, metrics: NewMetrics(metrics.NewRegistry(), "l1"), EthClient: client}
+			ancestor, err := etl.rollbackIndexedState(context.Background(), &ETLBatch{Logger: logger}, transaction, test.startHeight)
+			require.NoError(t, err)
+			if test.ancestor == nil {
+				require.Nil(t, ancestor)
+			} else {
+				require.NotNil(t, ancestor)
+				require.Equal(t, canonical[test.ancestor.Uint64()].Hash(), ancestor.Hash())
+			}
+
+			require.Len(t, rows.headers, test.remaining)
+			for i := range rows.headers {
+				require.Equal(t, canonical[i].Hash(), rows.headers[i].Hash, "remaining rows are canonical")
+			}
+		})
+	}
+}
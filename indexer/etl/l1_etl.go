@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
+	"gorm.io/gorm"
+
 	"github.com/ethereum-optimism/optimism/indexer/config"
 	"github.com/ethereum-optimism/optimism/indexer/database"
 	"github.com/ethereum-optimism/optimism/indexer/node"
@@ -23,6 +26,10 @@ type L1ETL struct {
 	ETL
 	LatestHeader *types.Header
 
+	// configured starting height, used as the fallback when
+	// none of the indexed state is canonical after a reorg
+	startHeight *big.Int
+
 	// the batch handler may do work that we can interrupt on shutdown
 	resourceCtx    context.Context
 	resourceCancel context.CancelFunc
@@ -47,11 +54,17 @@ func NewL1ETL(cfg Config, log log.Logger, db *database.DB, metrics Metricer, cli
 		// Since we dont have backfill support yet, we want to make sure all expected
 		// contracts are specified to ensure consistent behavior. Once backfill support
 		// is ready, we can relax this requirement.
-		if addr == zeroAddr && !strings.HasPrefix(name, "Legacy") {
+		if addr == zeroAddr && !strings.HasPrefix(name, "Legacy") && name != "DisputeGameFactoryProxy" {
 			log.Error("address not configured", "name", name)
 			return errors.New("all L1Contracts must be configured")
 		}
 
+		// Fault proof contracts are optional and left out of the log filter when not configured
+		if addr == zeroAddr && name == "DisputeGameFactoryProxy" {
+			log.Info("optional contract not configured", "name", name)
+			return nil
+		}
+
 		log.Info("configured contract", "name", name, "addr", addr)
 		l1Contracts = append(l1Contracts, addr)
 		return nil
@@ -103,6 +116,7 @@ func NewL1ETL(cfg Config, log log.Logger, db *database.DB, metrics Metricer, cli
 	return &L1ETL{
 		ETL:          etl,
 		LatestHeader: fromHeader,
+		startHeight:  cfg.StartHeight,
 
 		db:             db,
 		resourceCtx:    resCtx,
@@ -153,6 +167,10 @@ func (l1Etl *L1ETL) Start() error {
 }
 
 func (l1Etl *L1ETL) handleBatch(batch *ETLBatch) error {
+	if batch.reorg != nil {
+		return l1Etl.handleReorg(batch)
+	}
+
 	// Index incoming batches (only L1 blocks that have an emitted log)
 	l1BlockHeaders := make([]database.L1BlockHeader, 0, len(batch.Headers))
 	for i := range batch.Headers {
@@ -222,6 +240,48 @@ func (l1Etl *L1ETL) handleBatch(batch *ETLBatch) error {
 	return nil
 }
 
+// handleReorg rolls back all indexed L1 state that is no longer canonical. Contract events
+// and bridge data are removed with the block headers they reference in a single transaction.
+func (l1Etl *L1ETL) handleReorg(batch *ETLBatch) error {
+	defer close(batch.reorg)
+
+	transaction := func(fn func(indexedHeaders) error) error {
+		return l1Etl.db.Transaction(func(tx *database.DB) error {
+			return fn(l1IndexedHeaders{tx.Blocks})
+		})
+	}
+	ancestor, err := l1Etl.ETL.rollbackIndexedState(l1Etl.resourceCtx, batch, transaction, l1Etl.startHeight)
+	if err != nil {
+		return err
+	}
+
+	l1Etl.LatestHeader = ancestor
+	batch.reorg <- ancestor
+	return nil
+}
+
+// l1IndexedHeaders are the indexed L1 block headers
+type l1IndexedHeaders struct {
+	blocks database.BlocksDB
+}
+
+func (h l1IndexedHeaders) PrevIndexedHeader(height *big.Int) (*database.BlockHeader, error) {
+	header, err := h.blocks.L1BlockHeaderWithScope(func(db *gorm.DB) *gorm.DB {
+		if height != nil {
+			db = db.Where("number < ?", height)
+		}
+		return db.Order("number DESC")
+	})
+	if err != nil || header == nil {
+		return nil, err
+	}
+	return &header.BlockHeader, nil
+}
+
+func (h l1IndexedHeaders) DeleteHeadersAfter(height *big.Int) error {
+	return h.blocks.DeleteL1BlockHeadersAfter(height)
+}
+
 // Notify returns a channel that'll receive a value every time new data has
 // been persisted by the L1ETL
 func (l1Etl *L1ETL) Notify() <-chan interface{} {
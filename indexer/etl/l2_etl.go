@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
+	"gorm.io/gorm"
+
 	"github.com/ethereum-optimism/optimism/indexer/config"
 	"github.com/ethereum-optimism/optimism/indexer/database"
 	"github.com/ethereum-optimism/optimism/indexer/node"
@@ -138,6 +141,10 @@ func (l2Etl *L2ETL) Start() error {
 }
 
 func (l2Etl *L2ETL) handleBatch(batch *ETLBatch) error {
+	if batch.reorg != nil {
+		return l2Etl.handleReorg(batch)
+	}
+
 	l2BlockHeaders := make([]database.L2BlockHeader, len(batch.Headers))
 	for i := range batch.Headers {
 		l2BlockHeaders[i] = database.L2BlockHeader{BlockHeader: database.BlockHeaderFromHeader(&batch.Headers[i])}
@@ -196,6 +203,48 @@ func (l2Etl *L2ETL) handleBatch(batch *ETLBatch) error {
 	return nil
 }
 
+// handleReorg rolls back all indexed L2 state that is no longer canonical. Contract events
+// and bridge data are removed with the block headers they reference in a single transaction.
+func (l2Etl *L2ETL) handleReorg(batch *ETLBatch) error {
+	defer close(batch.reorg)
+
+	transaction := func(fn func(indexedHeaders) error) error {
+		return l2Etl.db.Transaction(func(tx *database.DB) error {
+			return fn(l2IndexedHeaders{tx.Blocks})
+		})
+	}
+	ancestor, err := l2Etl.ETL.rollbackIndexedState(l2Etl.resourceCtx, batch, transaction, nil)
+	if err != nil {
+		return err
+	}
+
+	l2Etl.LatestHeader = ancestor
+	batch.reorg <- ancestor
+	return nil
+}
+
+// l2IndexedHeaders are the indexed L2 block headers
+type l2IndexedHeaders struct {
+	blocks database.BlocksDB
+}
+
+func (h l2IndexedHeaders) PrevIndexedHeader(height *big.Int) (*database.BlockHeader, error) {
+	header, err := h.blocks.L2BlockHeaderWithScope(func(db *gorm.DB) *gorm.DB {
+		if height != nil {
+			db = db.Where("number < ?", height)
+		}
+		return db.Order("number DESC")
+	})
+	if err != nil || header == nil {
+		return nil, err
+	}
+	return &header.BlockHeader, nil
+}
+
+func (h l2IndexedHeaders) DeleteHeadersAfter(height *big.Int) error {
+	return h.blocks.DeleteL2BlockHeadersAfter(height)
+}
+
 // Notify returns a channel that'll receive a value every time new data has
 // been persisted by the L2ETL
 func (l2Etl *L2ETL) Notify() <-chan interface{} {
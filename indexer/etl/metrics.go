@@ -15,6 +15,7 @@ var (
 type Metricer interface {
 	RecordInterval() (done func(err error))
 	RecordLatestHeight(height *big.Int)
+	RecordReorg()
 
 	RecordEtlLatestHeight(height *big.Int)
 	RecordIndexedHeaders(size int)
@@ -26,6 +27,7 @@ type etlMetrics struct {
 	intervalDuration prometheus.Histogram
 	intervalFailures prometheus.Counter
 	latestHeight     prometheus.Gauge
+	reorgs           prometheus.Counter
 
 	etlLatestHeight prometheus.Gauge
 	indexedHeaders  prometheus.Counter
@@ -59,6 +61,12 @@ func NewMetrics(registry *prometheus.Registry, subsystem string) Metricer {
 			Name:      "latest_height",
 			Help:      "the latest height reported by the connected client",
 		}),
+		reorgs: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: subsystem,
+			Name:      "reorgs_total",
+			Help:      "number of reorgs detected by the etl",
+		}),
 		etlLatestHeight: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Subsystem: subsystem,
@@ -97,6 +105,10 @@ func (m *etlMetrics) RecordLatestHeight(height *big.Int) {
 	m.latestHeight.Set(float64(height.Uint64()))
 }
 
+func (m *etlMetrics) RecordReorg() {
+	m.reorgs.Inc()
+}
+
 func (m *etlMetrics) RecordEtlLatestHeight(height *big.Int) {
 	m.etlLatestHeight.Set(float64(height.Uint64()))
 }
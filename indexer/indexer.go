@@ -44,6 +44,8 @@ type Indexer struct {
 	L2ETL           *etl.L2ETL
 	BridgeProcessor *processors.BridgeProcessor
 
+	DisputeGameProcessor *processors.DisputeGameProcessor
+
 	// shutdown requests the service that maintains the indexer to shut down,
 	// and provides the error-cause of the critical failure (if any).
 	shutdown context.CancelCauseFunc
@@ -76,6 +78,9 @@ func (ix *Indexer) Start(ctx context.Context) error {
 	if err := ix.BridgeProcessor.Start(); err != nil {
 		return fmt.Errorf("failed to start bridge processor: %w", err)
 	}
+	if err := ix.DisputeGameProcessor.Start(); err != nil {
+		return fmt.Errorf("failed to start dispute game processor: %w", err)
+	}
 	return nil
 }
 
@@ -100,6 +105,12 @@ func (ix *Indexer) Stop(ctx context.Context) error {
 		}
 	}
 
+	if ix.DisputeGameProcessor != nil {
+		if err := ix.DisputeGameProcessor.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close dispute game processor: %w", err))
+		}
+	}
+
 	// Now that the ETLs are closed, we can stop the RPC clients
 	if ix.l1Client != nil {
 		ix.l1Client.Close()
@@ -154,6 +165,9 @@ func (ix *Indexer) initFromConfig(ctx context.Context, cfg *config.Config) error
 	if err := ix.initBridgeProcessor(cfg.Chain); err != nil {
 		return fmt.Errorf("failed to init Bridge-Processor: %w", err)
 	}
+	if err := ix.initDisputeGameProcessor(cfg.Chain); err != nil {
+		return fmt.Errorf("failed to init DisputeGame-Processor: %w", err)
+	}
 	if err := ix.startHttpServer(ctx, cfg.HTTPServer); err != nil {
 		return fmt.Errorf("failed to start HTTP server: %w", err)
 	}
@@ -229,6 +243,16 @@ func (ix *Indexer) initBridgeProcessor(chainConfig config.ChainConfig) error {
 	return nil
 }
 
+func (ix *Indexer) initDisputeGameProcessor(chainConfig config.ChainConfig) error {
+	disputeGameProcessor, err := processors.NewDisputeGameProcessor(
+		ix.log, ix.DB, processors.NewDisputeGameMetrics(ix.metricsRegistry), ix.l1Client, ix.L1ETL, chainConfig, ix.shutdown)
+	if err != nil {
+		return err
+	}
+	ix.DisputeGameProcessor = disputeGameProcessor
+	return nil
+}
+
 func (ix *Indexer) startHttpServer(ctx context.Context, cfg config.ServerConfig) error {
 	ix.log.Debug("starting http server...", "port", cfg.Port)
 
@@ -0,0 +1,11 @@
+/**
+ * REORG HANDLING
+ *
+ * Reorged block headers are deleted by the ETL and all dependent rows are expected
+ * to be removed via cascade. Versioned message hashes were the only reference without it.
+ */
+
+ALTER TABLE l2_bridge_message_versioned_message_hashes
+    DROP CONSTRAINT IF EXISTS l2_bridge_message_versioned_message_hashes_message_hash_fkey,
+    ADD CONSTRAINT l2_bridge_message_versioned_message_hashes_message_hash_fkey
+        FOREIGN KEY (message_hash) REFERENCES l2_bridge_messages(message_hash) ON DELETE CASCADE;
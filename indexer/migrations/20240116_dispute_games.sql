@@ -0,0 +1,30 @@
+/**
+ * FAULT PROOFS
+ */
+
+-- DisputeGameFactory
+CREATE TABLE IF NOT EXISTS l1_dispute_games (
+    game_address          VARCHAR PRIMARY KEY,
+    created_l1_event_guid VARCHAR NOT NULL UNIQUE REFERENCES l1_contract_events(guid) ON DELETE CASCADE,
+
+    -- game information
+    game_type       INTEGER NOT NULL,
+    root_claim      VARCHAR NOT NULL,
+    l2_block_number UINT256 NOT NULL,
+
+    -- 0: in progress, 1: challenger wins, 2: defender wins. NULL `resolved_at` while in progress
+    status      INTEGER NOT NULL,
+    resolved_at INTEGER,
+    timestamp   INTEGER NOT NULL CHECK (timestamp > 0)
+);
+CREATE INDEX IF NOT EXISTS l1_dispute_games_timestamp ON l1_dispute_games(timestamp);
+CREATE INDEX IF NOT EXISTS l1_dispute_games_created_l1_event_guid ON l1_dispute_games(created_l1_event_guid);
+CREATE INDEX IF NOT EXISTS l1_dispute_games_status ON l1_dispute_games(status);
+
+-- The game a withdrawal has been proven against. NULL for withdrawals proven against the L2OutputOracle
+ALTER TABLE l2_transaction_withdrawals
+    ADD COLUMN IF NOT EXISTS proven_dispute_game_address VARCHAR REFERENCES l1_dispute_games(game_address) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS l2_transaction_withdrawals_proven_dispute_game_address ON l2_transaction_withdrawals(proven_dispute_game_address);
+
+-- Set once the linked game has resolved in favor of the defender
+ALTER TABLE l2_transaction_withdrawals ADD COLUMN IF NOT EXISTS finalizable_at INTEGER;
@@ -41,6 +41,8 @@ type EthClient interface {
 	StorageHash(common.Address, *big.Int) (common.Hash, error)
 	FilterLogs(ethereum.FilterQuery) (Logs, error)
 
+	CallContract(ethereum.CallMsg, *big.Int) ([]byte, error)
+
 	// Close closes the underlying RPC connection.
 	// RPC close does not return any errors, but does shut down e.g. a websocket connection.
 	Close()
@@ -196,6 +198,21 @@ func (c *clnt) StorageHash(address common.Address, blockNumber *big.Int) (common
 	return proof.StorageHash, nil
 }
 
+// CallContract executes the message call against the state of the specified block, latest if nil
+func (c *clnt) CallContract(msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ctxwt, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	arg := map[string]interface{}{"from": msg.From, "to": msg.To, "data": hexutil.Bytes(msg.Data)}
+	var result hexutil.Bytes
+	err := c.rpc.CallContext(ctxwt, &result, "eth_call", arg, toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (c *clnt) Close() {
 	c.rpc.Close()
 }
@@ -47,6 +47,13 @@ func (f *HeaderTraversal) LastTraversedHeader() *types.Header {
 	return f.lastTraversedHeader
 }
 
+// Rewind resets the traversal to continue from the supplied header, i.e the
+// common ancestor between the traversed state and the provider after a reorg.
+// A nil header indicates that traversal restarts from genesis.
+func (f *HeaderTraversal) Rewind(header *types.Header) {
+	f.lastTraversedHeader = header
+}
+
 // NextHeaders retrieves the next set of headers that have been
 // marked as finalized by the connected client, bounded by the supplied size
 func (f *HeaderTraversal) NextHeaders(maxSize uint64) ([]types.Header, error) {
@@ -90,8 +97,8 @@ func (f *HeaderTraversal) NextHeaders(maxSize uint64) ([]types.Header, error) {
 	if numHeaders == 0 {
 		return nil, nil
 	} else if f.lastTraversedHeader != nil && headers[0].ParentHash != f.lastTraversedHeader.Hash() {
-		// The indexer's state is in an irrecoverable state relative to the provider. This
-		// should never happen since the indexer is dealing with only finalized blocks.
+		// The provider's chain has reorged relative to the traversed state. The caller
+		// is expected to find the common ancestor and `Rewind` the traversal.
 		return nil, ErrHeaderTraversalAndProviderMismatchedState
 	}
 
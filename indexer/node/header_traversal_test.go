@@ -133,3 +133,33 @@ func TestHeaderTraversalMismatchedProviderStateError(t *testing.T) {
 	require.Nil(t, headers)
 	require.Equal(t, ErrHeaderTraversalAndProviderMismatchedState, err)
 }
+
+func TestHeaderTraversalRewind(t *testing.T) {
+	client := new(MockEthClient)
+
+	// start from genesis
+	headerTraversal := NewHeaderTraversal(client, nil, bigint.Zero)
+
+	// blocks [0..4]
+	headers := makeHeaders(5, nil)
+	client.On("BlockHeaderByNumber", (*big.Int)(nil)).Return(&headers[4], nil).Times(1) // Times so that we can override next
+	client.On("BlockHeadersByRange", mock.MatchedBy(bigint.Matcher(0)), mock.MatchedBy(bigint.Matcher(4))).Return(headers, nil).Times(1)
+	_, err := headerTraversal.NextHeaders(5)
+	require.NoError(t, err)
+
+	// reorg of blocks [3..4]
+	reorgedHeaders := makeHeaders(2, &headers[2])
+	reorgedHeaders[0].Extra = []byte("reorged")
+	reorgedHeaders[1].ParentHash = reorgedHeaders[0].Hash()
+
+	// rewind to the common ancestor, block 2
+	headerTraversal.Rewind(&headers[2])
+	require.Equal(t, headers[2].Hash(), headerTraversal.LastTraversedHeader().Hash())
+
+	client.On("BlockHeaderByNumber", (*big.Int)(nil)).Return(&reorgedHeaders[1], nil)
+	client.On("BlockHeadersByRange", mock.MatchedBy(bigint.Matcher(3)), mock.MatchedBy(bigint.Matcher(4))).Return(reorgedHeaders, nil)
+	newHeaders, err := headerTraversal.NextHeaders(5)
+	require.NoError(t, err)
+	require.Len(t, newHeaders, 2)
+	require.Equal(t, reorgedHeaders[1].Hash(), headerTraversal.LastTraversedHeader().Hash())
+}
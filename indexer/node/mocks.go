@@ -46,5 +46,10 @@ func (m *MockEthClient) FilterLogs(query ethereum.FilterQuery) (Logs, error) {
 	return args.Get(0).(Logs), args.Error(1)
 }
 
+func (m *MockEthClient) CallContract(msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	args := m.Called(msg, blockNumber)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 func (m *MockEthClient) Close() {
 }
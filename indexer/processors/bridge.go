@@ -94,6 +94,10 @@ func (b *BridgeProcessor) Start() error {
 		l1EtlUpdates := b.l1Etl.Notify()
 		for range l1EtlUpdates {
 			b.log.Info("notified of traversed L1 state", "l1_etl_block_number", b.l1Etl.LatestHeader.Number)
+			if err := b.reloadReorgedL1State(); err != nil {
+				b.log.Error("failed to reload reorged l1 bridge state", "err", err)
+				continue
+			}
 			if err := b.onL1Data(b.l1Etl.LatestHeader); err != nil {
 				b.log.Error("failed l1 bridge processing interval", "err", err)
 			}
@@ -106,6 +110,10 @@ func (b *BridgeProcessor) Start() error {
 		l2EtlUpdates := b.l2Etl.Notify()
 		for range l2EtlUpdates {
 			b.log.Info("notified of traversed L2 state", "l2_etl_block_number", b.l2Etl.LatestHeader.Number)
+			if err := b.reloadReorgedL2State(); err != nil {
+				b.log.Error("failed to reload reorged l2 bridge state", "err", err)
+				continue
+			}
 			if err := b.onL2Data(b.l2Etl.LatestHeader); err != nil {
 				b.log.Error("failed l2 bridge processing interval", "err", err)
 			}
@@ -123,6 +131,74 @@ func (b *BridgeProcessor) Close() error {
 	return b.tasks.Wait()
 }
 
+// reloadReorgedL1State re-derives the processed state owned by the L1 worker from the database
+// when the last processed headers have been rolled back by the ETLs due to a reorg.
+func (b *BridgeProcessor) reloadReorgedL1State() error {
+	if b.LastL1Header != nil {
+		header, err := b.db.Blocks.L1BlockHeader(b.LastL1Header.Hash)
+		if err != nil {
+			return err
+		} else if header == nil {
+			latestL1Header, err := b.db.BridgeTransactions.L1LatestBlockHeader()
+			if err != nil {
+				return err
+			}
+			b.log.Warn("detected reorged l1 state", "reorged_l1_block", b.LastL1Header, "l1_block", latestL1Header)
+			b.LastL1Header = latestL1Header
+		}
+	}
+
+	if b.LastFinalizedL2Header != nil {
+		header, err := b.db.Blocks.L2BlockHeader(b.LastFinalizedL2Header.Hash)
+		if err != nil {
+			return err
+		} else if header == nil {
+			latestFinalizedL2Header, err := b.db.BridgeTransactions.L2LatestFinalizedBlockHeader()
+			if err != nil {
+				return err
+			}
+			b.log.Warn("detected reorged finalized l2 state", "reorged_l2_block", b.LastFinalizedL2Header, "l2_block", latestFinalizedL2Header)
+			b.LastFinalizedL2Header = latestFinalizedL2Header
+		}
+	}
+
+	return nil
+}
+
+// reloadReorgedL2State re-derives the processed state owned by the L2 worker from the database
+// when the last processed headers have been rolled back by the ETLs due to a reorg.
+func (b *BridgeProcessor) reloadReorgedL2State() error {
+	if b.LastL2Header != nil {
+		header, err := b.db.Blocks.L2BlockHeader(b.LastL2Header.Hash)
+		if err != nil {
+			return err
+		} else if header == nil {
+			latestL2Header, err := b.db.BridgeTransactions.L2LatestBlockHeader()
+			if err != nil {
+				return err
+			}
+			b.log.Warn("detected reorged l2 state", "reorged_l2_block", b.LastL2Header, "l2_block", latestL2Header)
+			b.LastL2Header = latestL2Header
+		}
+	}
+
+	if b.LastFinalizedL1Header != nil {
+		header, err := b.db.Blocks.L1BlockHeader(b.LastFinalizedL1Header.Hash)
+		if err != nil {
+			return err
+		} else if header == nil {
+			latestFinalizedL1Header, err := b.db.BridgeTransactions.L1LatestFinalizedBlockHeader()
+			if err != nil {
+				return err
+			}
+			b.log.Warn("detected reorged finalized l1 state", "reorged_l1_block", b.LastFinalizedL1Header, "l1_block", latestFinalizedL1Header)
+			b.LastFinalizedL1Header = latestFinalizedL1Header
+		}
+	}
+
+	return nil
+}
+
 // onL1Data will index new bridge events for the unvisited L1 state. As new L1 bridge events
 // are processed, bridge finalization events can be processed on L2 in this same window.
 func (b *BridgeProcessor) onL1Data(latestL1Header *types.Header) (errs error) {
@@ -20,6 +20,7 @@ import (
 //  1. OptimismPortal
 //  2. L1CrossDomainMessenger
 //  3. L1StandardBridge
+//  4. L1ERC721Bridge
 func L1ProcessInitiatedBridgeEvents(log log.Logger, db *database.DB, metrics L1Metricer, l1Contracts config.L1Contracts, fromHeight, toHeight *big.Int) error {
 	// (1) OptimismPortal
 	optimismPortalTxDeposits, err := contracts.OptimismPortalTransactionDepositEvents(l1Contracts.OptimismPortalProxy, db, fromHeight, toHeight)
@@ -138,6 +139,53 @@ func L1ProcessInitiatedBridgeEvents(log log.Logger, db *database.DB, metrics L1M
 		}
 	}
 
+	// (4) L1ERC721Bridge
+	initiatedNFTBridges, err := contracts.ERC721BridgeInitiatedEvents("l1", l1Contracts.L1ERC721BridgeProxy, db, fromHeight, toHeight)
+	if err != nil {
+		return err
+	}
+	if len(initiatedNFTBridges) > 0 {
+		log.Info("detected nft bridge deposits", "size", len(initiatedNFTBridges))
+	}
+
+	bridgedNFTs := make(map[common.Address]int)
+	nftBridgeDeposits := make([]database.L1BridgeNFTDeposit, len(initiatedNFTBridges))
+	for i := range initiatedNFTBridges {
+		initiatedNFTBridge := initiatedNFTBridges[i]
+
+		// extract the cross domain message hash & deposit source hash from the preceding events. The
+		// SentMessageExtension1 event is emitted between the SentMessage & ERC721BridgeInitiated events
+		portalDeposit, ok := portalDeposits[logKey{initiatedNFTBridge.Event.BlockHash, initiatedNFTBridge.Event.LogIndex - 3}]
+		if !ok {
+			return fmt.Errorf("expected TransactionDeposit preceding ERC721BridgeInitiated event. tx_hash = %s", initiatedNFTBridge.Event.TransactionHash)
+		} else if portalDeposit.Event.TransactionHash != initiatedNFTBridge.Event.TransactionHash {
+			return fmt.Errorf("correlated events tx hash mismatch, bridge_tx_hash = %s, deposit_tx_hash = %s", initiatedNFTBridge.Event.TransactionHash, portalDeposit.Event.TransactionHash)
+		}
+
+		sentMessage, ok := sentMessages[logKey{initiatedNFTBridge.Event.BlockHash, initiatedNFTBridge.Event.LogIndex - 2}]
+		if !ok {
+			return fmt.Errorf("expected SentMessage preceding ERC721BridgeInitiated event. tx_hash = %s", initiatedNFTBridge.Event.TransactionHash)
+		} else if sentMessage.Event.TransactionHash != initiatedNFTBridge.Event.TransactionHash {
+			return fmt.Errorf("correlated events tx hash mismatch. bridge_tx_hash = %s, message_tx_hash = %s", initiatedNFTBridge.Event.TransactionHash, sentMessage.Event.TransactionHash)
+		}
+
+		bridgedNFTs[initiatedNFTBridge.NFTBridgeTransfer.TokenPair.LocalTokenAddress]++
+
+		initiatedNFTBridge.NFTBridgeTransfer.CrossDomainMessageHash = sentMessage.BridgeMessage.MessageHash
+		nftBridgeDeposits[i] = database.L1BridgeNFTDeposit{
+			TransactionSourceHash: portalDeposit.DepositTx.SourceHash,
+			NFTBridgeTransfer:     initiatedNFTBridge.NFTBridgeTransfer,
+		}
+	}
+	if len(nftBridgeDeposits) > 0 {
+		if err := db.BridgeTransfers.StoreL1BridgeNFTDeposits(nftBridgeDeposits); err != nil {
+			return err
+		}
+		for tokenAddr, size := range bridgedNFTs {
+			metrics.RecordL1InitiatedNFTBridgeTransfers(tokenAddr, size)
+		}
+	}
+
 	return nil
 }
 
@@ -146,6 +194,7 @@ func L1ProcessInitiatedBridgeEvents(log log.Logger, db *database.DB, metrics L1M
 //  1. OptimismPortal (Bedrock prove & finalize steps)
 //  2. L1CrossDomainMessenger (relayMessage marker)
 //  3. L1StandardBridge (no-op, since this is simply a wrapper over the L1CrossDomainMessenger)
+//  4. L1ERC721Bridge (no-op, since this is simply a wrapper over the L1CrossDomainMessenger)
 func L1ProcessFinalizedBridgeEvents(log log.Logger, db *database.DB, metrics L1Metricer, l1Contracts config.L1Contracts, fromHeight, toHeight *big.Int) error {
 	// (1) OptimismPortal (proven withdrawals)
 	provenWithdrawals, err := contracts.OptimismPortalWithdrawalProvenEvents(l1Contracts.OptimismPortalProxy, db, fromHeight, toHeight)
@@ -273,6 +322,25 @@ func L1ProcessFinalizedBridgeEvents(log log.Logger, db *database.DB, metrics L1M
 		}
 	}
 
+	// (5) L1ERC721Bridge
+	// - Nothing actionable on the database. Same as the StandardBridge, finalization is tracked by the relayed message
+	finalizedNFTBridges, err := contracts.ERC721BridgeFinalizedEvents("l1", l1Contracts.L1ERC721BridgeProxy, db, fromHeight, toHeight)
+	if err != nil {
+		return err
+	}
+
+	finalizedNFTs := make(map[common.Address]int)
+	for i := range finalizedNFTBridges {
+		finalizedNFTBridge := finalizedNFTBridges[i]
+		finalizedNFTs[finalizedNFTBridge.NFTBridgeTransfer.TokenPair.LocalTokenAddress]++
+	}
+	if len(finalizedNFTBridges) > 0 {
+		log.Info("detected finalized nft bridge withdrawals", "size", len(finalizedNFTBridges))
+		for tokenAddr, size := range finalizedNFTs {
+			metrics.RecordL1FinalizedNFTBridgeTransfers(tokenAddr, size)
+		}
+	}
+
 	// a-ok!
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 //  1. OptimismPortal
 //  2. L2CrossDomainMessenger
 //  3. L2StandardBridge
+//  4. L2ERC721Bridge
 func L2ProcessInitiatedBridgeEvents(log log.Logger, db *database.DB, metrics L2Metricer, l2Contracts config.L2Contracts, fromHeight, toHeight *big.Int) error {
 	// (1) L2ToL1MessagePasser
 	l2ToL1MPMessagesPassed, err := contracts.L2ToL1MessagePasserMessagePassedEvents(l2Contracts.L2ToL1MessagePasser, db, fromHeight, toHeight)
@@ -132,6 +133,53 @@ func L2ProcessInitiatedBridgeEvents(log log.Logger, db *database.DB, metrics L2M
 		}
 	}
 
+	// (4) L2ERC721Bridge
+	initiatedNFTBridges, err := contracts.ERC721BridgeInitiatedEvents("l2", l2Contracts.L2ERC721Bridge, db, fromHeight, toHeight)
+	if err != nil {
+		return err
+	}
+	if len(initiatedNFTBridges) > 0 {
+		log.Info("detected nft bridge withdrawals", "size", len(initiatedNFTBridges))
+	}
+
+	bridgedNFTs := make(map[common.Address]int)
+	nftBridgeWithdrawals := make([]database.L2BridgeNFTWithdrawal, len(initiatedNFTBridges))
+	for i := range initiatedNFTBridges {
+		initiatedNFTBridge := initiatedNFTBridges[i]
+
+		// extract the cross domain message hash & withdraw hash from the preceding events. The
+		// SentMessageExtension1 event is emitted between the SentMessage & ERC721BridgeInitiated events
+		messagePassed, ok := messagesPassed[logKey{initiatedNFTBridge.Event.BlockHash, initiatedNFTBridge.Event.LogIndex - 3}]
+		if !ok {
+			return fmt.Errorf("expected MessagePassed preceding ERC721BridgeInitiated event. tx_hash = %s", initiatedNFTBridge.Event.TransactionHash)
+		} else if messagePassed.Event.TransactionHash != initiatedNFTBridge.Event.TransactionHash {
+			return fmt.Errorf("correlated events tx hash mismatch. bridge_tx_hash = %s, withdraw_tx_hash = %s", initiatedNFTBridge.Event.TransactionHash, messagePassed.Event.TransactionHash)
+		}
+
+		sentMessage, ok := sentMessages[logKey{initiatedNFTBridge.Event.BlockHash, initiatedNFTBridge.Event.LogIndex - 2}]
+		if !ok {
+			return fmt.Errorf("expected SentMessage preceding ERC721BridgeInitiated event. tx_hash = %s", initiatedNFTBridge.Event.TransactionHash)
+		} else if sentMessage.Event.TransactionHash != initiatedNFTBridge.Event.TransactionHash {
+			return fmt.Errorf("correlated events tx hash mismatch. bridge_tx_hash = %s, message_tx_hash = %s", initiatedNFTBridge.Event.TransactionHash, sentMessage.Event.TransactionHash)
+		}
+
+		bridgedNFTs[initiatedNFTBridge.NFTBridgeTransfer.TokenPair.LocalTokenAddress]++
+
+		initiatedNFTBridge.NFTBridgeTransfer.CrossDomainMessageHash = sentMessage.BridgeMessage.MessageHash
+		nftBridgeWithdrawals[i] = database.L2BridgeNFTWithdrawal{
+			TransactionWithdrawalHash: messagePassed.WithdrawalHash,
+			NFTBridgeTransfer:         initiatedNFTBridge.NFTBridgeTransfer,
+		}
+	}
+	if len(nftBridgeWithdrawals) > 0 {
+		if err := db.BridgeTransfers.StoreL2BridgeNFTWithdrawals(nftBridgeWithdrawals); err != nil {
+			return err
+		}
+		for tokenAddr, size := range bridgedNFTs {
+			metrics.RecordL2InitiatedNFTBridgeTransfers(tokenAddr, size)
+		}
+	}
+
 	// a-ok!
 	return nil
 }
@@ -140,6 +188,7 @@ func L2ProcessInitiatedBridgeEvents(log log.Logger, db *database.DB, metrics L2M
 // bridge events. This covers every part of the multi-layered stack:
 //  1. L2CrossDomainMessenger (relayMessage marker)
 //  2. L2StandardBridge (no-op, since this is simply a wrapper over the L2CrossDomainMEssenger)
+//  3. L2ERC721Bridge (no-op, since this is simply a wrapper over the L2CrossDomainMEssenger)
 //
 // NOTE: Unlike L1, there's no L2ToL1MessagePasser stage since transaction deposits are apart of the block derivation process.
 func L2ProcessFinalizedBridgeEvents(log log.Logger, db *database.DB, metrics L2Metricer, l2Contracts config.L2Contracts, fromHeight, toHeight *big.Int) error {
@@ -190,6 +239,25 @@ func L2ProcessFinalizedBridgeEvents(log log.Logger, db *database.DB, metrics L2M
 		}
 	}
 
+	// (3) L2ERC721Bridge
+	// - Nothing actionable on the database. Same as the StandardBridge, finalization is tracked by the relayed message
+	finalizedNFTBridges, err := contracts.ERC721BridgeFinalizedEvents("l2", l2Contracts.L2ERC721Bridge, db, fromHeight, toHeight)
+	if err != nil {
+		return err
+	}
+
+	finalizedNFTs := make(map[common.Address]int)
+	for i := range finalizedNFTBridges {
+		finalizedNFTBridge := finalizedNFTBridges[i]
+		finalizedNFTs[finalizedNFTBridge.NFTBridgeTransfer.TokenPair.LocalTokenAddress]++
+	}
+	if len(finalizedNFTBridges) > 0 {
+		log.Info("detected finalized nft bridge deposits", "size", len(finalizedNFTBridges))
+		for tokenAddr, size := range finalizedNFTs {
+			metrics.RecordL2FinalizedNFTBridgeTransfers(tokenAddr, size)
+		}
+	}
+
 	// a-ok!
 	return nil
 }
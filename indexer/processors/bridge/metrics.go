@@ -31,6 +31,9 @@ type L1Metricer interface {
 
 	RecordL1InitiatedBridgeTransfers(token common.Address, size int)
 	RecordL1FinalizedBridgeTransfers(token common.Address, size int)
+
+	RecordL1InitiatedNFTBridgeTransfers(token common.Address, size int)
+	RecordL1FinalizedNFTBridgeTransfers(token common.Address, size int)
 }
 
 type L2Metricer interface {
@@ -45,6 +48,9 @@ type L2Metricer interface {
 
 	RecordL2InitiatedBridgeTransfers(token common.Address, size int)
 	RecordL2FinalizedBridgeTransfers(token common.Address, size int)
+
+	RecordL2InitiatedNFTBridgeTransfers(token common.Address, size int)
+	RecordL2FinalizedNFTBridgeTransfers(token common.Address, size int)
 }
 
 type Metricer interface {
@@ -75,6 +81,9 @@ type bridgeMetrics struct {
 
 	initiatedBridgeTransfers *prometheus.CounterVec
 	finalizedBridgeTransfers *prometheus.CounterVec
+
+	initiatedNFTBridgeTransfers *prometheus.CounterVec
+	finalizedNFTBridgeTransfers *prometheus.CounterVec
 }
 
 func NewMetrics(registry *prometheus.Registry) Metricer {
@@ -181,6 +190,22 @@ func NewMetrics(registry *prometheus.Registry) Metricer {
 			"chain",
 			"token_address",
 		}),
+		initiatedNFTBridgeTransfers: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "initiated_nft_transfers",
+			Help:      "number of bridged nfts between l1 and l2",
+		}, []string{
+			"chain",
+			"token_address",
+		}),
+		finalizedNFTBridgeTransfers: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "finalized_nft_transfers",
+			Help:      "number of finalized nft transfers between l1 and l2",
+		}, []string{
+			"chain",
+			"token_address",
+		}),
 	}
 }
 
@@ -246,6 +271,14 @@ func (m *bridgeMetrics) RecordL1FinalizedBridgeTransfers(tokenAddr common.Addres
 	m.finalizedBridgeTransfers.WithLabelValues("l1", tokenAddr.String()).Add(float64(size))
 }
 
+func (m *bridgeMetrics) RecordL1InitiatedNFTBridgeTransfers(tokenAddr common.Address, size int) {
+	m.initiatedNFTBridgeTransfers.WithLabelValues("l1", tokenAddr.String()).Add(float64(size))
+}
+
+func (m *bridgeMetrics) RecordL1FinalizedNFTBridgeTransfers(tokenAddr common.Address, size int) {
+	m.finalizedNFTBridgeTransfers.WithLabelValues("l1", tokenAddr.String()).Add(float64(size))
+}
+
 // L2Metricer
 
 func (m *bridgeMetrics) RecordL2Interval() func(error) {
@@ -287,3 +320,11 @@ func (m *bridgeMetrics) RecordL2InitiatedBridgeTransfers(tokenAddr common.Addres
 func (m *bridgeMetrics) RecordL2FinalizedBridgeTransfers(tokenAddr common.Address, size int) {
 	m.finalizedBridgeTransfers.WithLabelValues("l2", tokenAddr.String()).Add(float64(size))
 }
+
+func (m *bridgeMetrics) RecordL2InitiatedNFTBridgeTransfers(tokenAddr common.Address, size int) {
+	m.initiatedNFTBridgeTransfers.WithLabelValues("l2", tokenAddr.String()).Add(float64(size))
+}
+
+func (m *bridgeMetrics) RecordL2FinalizedNFTBridgeTransfers(tokenAddr common.Address, size int) {
+	m.finalizedNFTBridgeTransfers.WithLabelValues("l2", tokenAddr.String()).Add(float64(size))
+}
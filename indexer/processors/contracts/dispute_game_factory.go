@@ -0,0 +1,45 @@
+package contracts
+
+import (
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type DisputeGameFactoryDisputeGameCreatedEvent struct {
+	*bindings.DisputeGameFactoryDisputeGameCreated
+	Event *database.ContractEvent
+}
+
+func DisputeGameFactoryDisputeGameCreatedEvents(contractAddress common.Address, db *database.DB, fromHeight, toHeight *big.Int) ([]DisputeGameFactoryDisputeGameCreatedEvent, error) {
+	disputeGameFactoryAbi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	disputeGameCreatedEventAbi := disputeGameFactoryAbi.Events["DisputeGameCreated"]
+	contractEventFilter := database.ContractEvent{ContractAddress: contractAddress, EventSignature: disputeGameCreatedEventAbi.ID}
+	disputeGameCreatedEvents, err := db.ContractEvents.L1ContractEventsWithFilter(contractEventFilter, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	createdGames := make([]DisputeGameFactoryDisputeGameCreatedEvent, len(disputeGameCreatedEvents))
+	for i := range disputeGameCreatedEvents {
+		disputeGameCreated := bindings.DisputeGameFactoryDisputeGameCreated{Raw: *disputeGameCreatedEvents[i].RLPLog}
+		err := UnpackLog(&disputeGameCreated, disputeGameCreatedEvents[i].RLPLog, disputeGameCreatedEventAbi.Name, disputeGameFactoryAbi)
+		if err != nil {
+			return nil, err
+		}
+
+		createdGames[i] = DisputeGameFactoryDisputeGameCreatedEvent{
+			DisputeGameFactoryDisputeGameCreated: &disputeGameCreated,
+			Event:                                &disputeGameCreatedEvents[i].ContractEvent,
+		}
+	}
+
+	return createdGames, nil
+}
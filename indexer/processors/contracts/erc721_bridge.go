@@ -0,0 +1,99 @@
+package contracts
+
+import (
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type ERC721BridgeInitiatedEvent struct {
+	Event             *database.ContractEvent
+	NFTBridgeTransfer database.NFTBridgeTransfer
+}
+
+type ERC721BridgeFinalizedEvent struct {
+	Event             *database.ContractEvent
+	NFTBridgeTransfer database.NFTBridgeTransfer
+}
+
+// ERC721BridgeInitiatedEvents extracts all initiated nft bridge events from the contracts that follow the ERC721Bridge ABI.
+// Unlike the StandardBridge, the ERC721Bridge emits this event after the message has been sent through the CrossDomainMessenger.
+func ERC721BridgeInitiatedEvents(chainSelector string, contractAddress common.Address, db *database.DB, fromHeight, toHeight *big.Int) ([]ERC721BridgeInitiatedEvent, error) {
+	// The L1ERC721Bridge & L2ERC721Bridge share the same event definitions
+	erc721BridgeAbi, err := bindings.L1ERC721BridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	initiatedBridgeEventAbi := erc721BridgeAbi.Events["ERC721BridgeInitiated"]
+	contractEventFilter := database.ContractEvent{ContractAddress: contractAddress, EventSignature: initiatedBridgeEventAbi.ID}
+	initiatedBridgeEvents, err := db.ContractEvents.ContractEventsWithFilter(contractEventFilter, chainSelector, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	erc721BridgeInitiatedEvents := make([]ERC721BridgeInitiatedEvent, len(initiatedBridgeEvents))
+	for i := range initiatedBridgeEvents {
+		erc721Bridge := bindings.L1ERC721BridgeERC721BridgeInitiated{Raw: *initiatedBridgeEvents[i].RLPLog}
+		err := UnpackLog(&erc721Bridge, initiatedBridgeEvents[i].RLPLog, initiatedBridgeEventAbi.Name, erc721BridgeAbi)
+		if err != nil {
+			return nil, err
+		}
+
+		erc721BridgeInitiatedEvents[i] = ERC721BridgeInitiatedEvent{
+			Event: &initiatedBridgeEvents[i],
+			NFTBridgeTransfer: database.NFTBridgeTransfer{
+				FromAddress: erc721Bridge.From,
+				ToAddress:   erc721Bridge.To,
+				TokenPair:   database.TokenPair{LocalTokenAddress: erc721Bridge.LocalToken, RemoteTokenAddress: erc721Bridge.RemoteToken},
+				TokenID:     erc721Bridge.TokenId,
+				Data:        erc721Bridge.ExtraData,
+				Timestamp:   initiatedBridgeEvents[i].Timestamp,
+			},
+		}
+	}
+
+	return erc721BridgeInitiatedEvents, nil
+}
+
+// ERC721BridgeFinalizedEvents extracts all finalization nft bridge events from the contracts that follow the ERC721Bridge ABI.
+func ERC721BridgeFinalizedEvents(chainSelector string, contractAddress common.Address, db *database.DB, fromHeight, toHeight *big.Int) ([]ERC721BridgeFinalizedEvent, error) {
+	// The L1ERC721Bridge & L2ERC721Bridge share the same event definitions
+	erc721BridgeAbi, err := bindings.L1ERC721BridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	finalizedBridgeEventAbi := erc721BridgeAbi.Events["ERC721BridgeFinalized"]
+	contractEventFilter := database.ContractEvent{ContractAddress: contractAddress, EventSignature: finalizedBridgeEventAbi.ID}
+	finalizedBridgeEvents, err := db.ContractEvents.ContractEventsWithFilter(contractEventFilter, chainSelector, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	erc721BridgeFinalizedEvents := make([]ERC721BridgeFinalizedEvent, len(finalizedBridgeEvents))
+	for i := range finalizedBridgeEvents {
+		erc721Bridge := bindings.L1ERC721BridgeERC721BridgeFinalized{Raw: *finalizedBridgeEvents[i].RLPLog}
+		err := UnpackLog(&erc721Bridge, finalizedBridgeEvents[i].RLPLog, finalizedBridgeEventAbi.Name, erc721BridgeAbi)
+		if err != nil {
+			return nil, err
+		}
+
+		erc721BridgeFinalizedEvents[i] = ERC721BridgeFinalizedEvent{
+			Event: &finalizedBridgeEvents[i],
+			NFTBridgeTransfer: database.NFTBridgeTransfer{
+				FromAddress: erc721Bridge.From,
+				ToAddress:   erc721Bridge.To,
+				TokenPair:   database.TokenPair{LocalTokenAddress: erc721Bridge.LocalToken, RemoteTokenAddress: erc721Bridge.RemoteToken},
+				TokenID:     erc721Bridge.TokenId,
+				Data:        erc721Bridge.ExtraData,
+				Timestamp:   finalizedBridgeEvents[i].Timestamp,
+			},
+		}
+	}
+
+	return erc721BridgeFinalizedEvents, nil
+}
@@ -0,0 +1,406 @@
+package processors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/indexer/bigint"
+	"github.com/ethereum-optimism/optimism/indexer/config"
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/indexer/etl"
+	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum-optimism/optimism/indexer/processors/contracts"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/tasks"
+)
+
+// Bounds on the number of games and withdrawals that are queried via RPC per interval
+var disputeGamesLimit = 100
+
+// The first major version of the OptimismPortal that proves withdrawals against dispute games. Prior
+// versions prove against the L2OutputOracle and lack the getters below
+const faultProofsPortalMajorVersion = 3
+
+// The fault proof OptimismPortal getters, not available in the bindings of the L2OutputOracle based portal
+const optimismPortalFaultProofsAbi = `[
+	{"type":"function","name":"provenWithdrawals","inputs":[{"name":"","type":"bytes32"}],"outputs":[{"name":"disputeGameProxy","type":"address"},{"name":"timestamp","type":"uint64"}],"stateMutability":"view"},
+	{"type":"function","name":"proofMaturityDelaySeconds","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"disputeGameFinalityDelaySeconds","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}
+]`
+
+// DisputeGameProcessor indexes the dispute games created by the DisputeGameFactory, tracks their
+// resolution and links proven withdrawals to the game they were proven against. It is a no-op for
+// chains without a configured DisputeGameFactory.
+type DisputeGameProcessor struct {
+	log     log.Logger
+	db      *database.DB
+	metrics DisputeGameMetricer
+
+	resourceCtx    context.Context
+	resourceCancel context.CancelFunc
+	tasks          tasks.Group
+
+	l1Client    node.EthClient
+	l1Etl       *etl.L1ETL
+	chainConfig config.ChainConfig
+
+	portalAbi *abi.ABI
+	gameAbi   *abi.ABI
+
+	// Set on start when the portal proves withdrawals against dispute games. Otherwise only
+	// the games are indexed, with withdrawals remaining proven against the L2OutputOracle
+	faultProofsPortal bool
+
+	LastL1Header *database.L1BlockHeader
+
+	// Cursors of the in progress games and the unlinked proven withdrawals to continue from on the next
+	// interval. A single page is processed per interval, wrapping around once the last page is reached
+	resolvedGamesCursor     *common.Address
+	provenWithdrawalsCursor *common.Hash
+}
+
+func NewDisputeGameProcessor(log log.Logger, db *database.DB, metrics DisputeGameMetricer, l1Client node.EthClient, l1Etl *etl.L1ETL,
+	chainConfig config.ChainConfig, shutdown context.CancelCauseFunc) (*DisputeGameProcessor, error) {
+	log = log.New("processor", "dispute_games")
+
+	portalAbi, err := abi.JSON(strings.NewReader(optimismPortalFaultProofsAbi))
+	if err != nil {
+		return nil, err
+	}
+	gameAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	latestL1Header, err := db.DisputeGames.L1LatestDisputeGameBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	log.Info("detected indexed dispute game state", "l1_block", latestL1Header)
+
+	resCtx, resCancel := context.WithCancel(context.Background())
+	return &DisputeGameProcessor{
+		log:            log,
+		db:             db,
+		metrics:        metrics,
+		resourceCtx:    resCtx,
+		resourceCancel: resCancel,
+		l1Client:       l1Client,
+		l1Etl:          l1Etl,
+		chainConfig:    chainConfig,
+		portalAbi:      &portalAbi,
+		gameAbi:        gameAbi,
+		LastL1Header:   latestL1Header,
+		tasks: tasks.Group{HandleCrit: func(err error) {
+			shutdown(fmt.Errorf("critical error in dispute game processor: %w", err))
+		}},
+	}, nil
+}
+
+func (p *DisputeGameProcessor) Start() error {
+	if p.chainConfig.L1Contracts.DisputeGameFactoryProxy == (common.Address{}) {
+		p.log.Info("no dispute game factory configured, skipping dispute game processing")
+		return nil
+	}
+
+	faultProofsPortal, err := p.isFaultProofsPortal()
+	if err != nil {
+		return fmt.Errorf("failed to query portal version: %w", err)
+	} else if !faultProofsPortal {
+		p.log.Warn("portal does not support fault proofs, skipping proven withdrawal processing", "portal", p.chainConfig.L1Contracts.OptimismPortalProxy)
+	}
+	p.faultProofsPortal = faultProofsPortal
+
+	p.log.Info("starting dispute game processor...")
+	p.tasks.Go(func() error {
+		l1EtlUpdates := p.l1Etl.Notify()
+		for range l1EtlUpdates {
+			p.log.Info("notified of traversed L1 state", "l1_etl_block_number", p.l1Etl.LatestHeader.Number)
+			if err := p.reloadReorgedL1State(); err != nil {
+				p.log.Error("failed to reload reorged l1 dispute game state", "err", err)
+				continue
+			}
+			if err := p.onL1Data(p.l1Etl.LatestHeader); err != nil {
+				p.log.Error("failed dispute game processing interval", "err", err)
+			}
+		}
+		p.log.Info("no more l1 etl updates. shutting down dispute game task")
+		return nil
+	})
+	return nil
+}
+
+func (p *DisputeGameProcessor) Close() error {
+	// signal that we can stop any ongoing work
+	p.resourceCancel()
+	// await the work to stop
+	return p.tasks.Wait()
+}
+
+// reloadReorgedL1State resets the processed L1 state if it has been rolled back by the L1ETL
+func (p *DisputeGameProcessor) reloadReorgedL1State() error {
+	if p.LastL1Header == nil {
+		return nil
+	}
+
+	header, err := p.db.Blocks.L1BlockHeader(p.LastL1Header.Hash)
+	if err != nil {
+		return err
+	} else if header != nil {
+		return nil
+	}
+
+	latestL1Header, err := p.db.DisputeGames.L1LatestDisputeGameBlockHeader()
+	if err != nil {
+		return err
+	}
+
+	p.log.Warn("detected reorged l1 state", "reorged_l1_block", p.LastL1Header, "l1_block", latestL1Header)
+	p.LastL1Header = latestL1Header
+	return nil
+}
+
+// onL1Data indexes newly created games, and then updates the state of in progress
+// games and the proven withdrawals that are yet to be linked to a game.
+func (p *DisputeGameProcessor) onL1Data(latestL1Header *types.Header) (errs error) {
+	done := p.metrics.RecordInterval()
+	defer func() { done(errs) }()
+
+	// Continue while unvisited state is available to process
+	for {
+		lastL1Header := p.LastL1Header
+		if err := p.processCreatedGames(latestL1Header); err != nil {
+			return fmt.Errorf("failed processing created dispute games: %w", err)
+		} else if lastL1Header == p.LastL1Header {
+			break
+		}
+	}
+
+	if err := p.processResolvedGames(); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed processing resolved dispute games: %w", err))
+	}
+	if !p.faultProofsPortal {
+		return errs
+	}
+	if err := p.processProvenWithdrawals(); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed processing proven withdrawals: %w", err))
+	}
+	if err := p.processFinalizableWithdrawals(); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed processing finalizable withdrawals: %w", err))
+	}
+	return errs
+}
+
+func (p *DisputeGameProcessor) processCreatedGames(latestL1Header *types.Header) error {
+	lastL1BlockNumber := big.NewInt(int64(p.chainConfig.L1StartingHeight - 1))
+	if p.LastL1Header != nil {
+		lastL1BlockNumber = p.LastL1Header.Number
+	}
+
+	// Latest unobserved L1 state bounded by `blockLimits` blocks. Since
+	// not every L1 block is indexed, we may have nothing to process.
+	toL1HeaderScope := func(db *gorm.DB) *gorm.DB {
+		newQuery := db.Session(&gorm.Session{NewDB: true}) // fresh subquery
+		headers := newQuery.Model(database.L1BlockHeader{}).Where("number > ? AND number <= ?", lastL1BlockNumber, latestL1Header.Number)
+		return db.Where("number = (?)", newQuery.Table("(?) AS block_numbers", headers.Order("number ASC").Limit(blocksLimit)).Select("MAX(number)"))
+	}
+	toL1Header, err := p.db.Blocks.L1BlockHeaderWithScope(toL1HeaderScope)
+	if err != nil {
+		return fmt.Errorf("failed to query new L1 state: %w", err)
+	} else if toL1Header == nil {
+		p.log.Debug("no new L1 state found")
+		return nil
+	}
+
+	fromL1Height, toL1Height := new(big.Int).Add(lastL1BlockNumber, bigint.One), toL1Header.Number
+	createdGames, err := contracts.DisputeGameFactoryDisputeGameCreatedEvents(p.chainConfig.L1Contracts.DisputeGameFactoryProxy, p.db, fromL1Height, toL1Height)
+	if err != nil {
+		return err
+	}
+
+	games := make([]database.L1DisputeGame, len(createdGames))
+	for i := range createdGames {
+		createdGame := createdGames[i]
+		l2BlockNumber, err := p.callGame(createdGame.DisputeProxy, "l2BlockNumber")
+		if err != nil {
+			return fmt.Errorf("failed to query l2 block number of game %s: %w", createdGame.DisputeProxy, err)
+		}
+
+		games[i] = database.L1DisputeGame{
+			GameAddress:        createdGame.DisputeProxy,
+			CreatedL1EventGUID: createdGame.Event.GUID,
+			GameType:           createdGame.GameType,
+			RootClaim:          createdGame.RootClaim,
+			L2BlockNumber:      l2BlockNumber.(*big.Int),
+			Status:             database.GameStatusInProgress,
+			Timestamp:          createdGame.Event.Timestamp,
+		}
+	}
+
+	if len(games) > 0 {
+		p.log.Info("detected created dispute games", "size", len(games), "from_block_number", fromL1Height, "to_block_number", toL1Height)
+		if err := p.db.DisputeGames.StoreL1DisputeGames(games); err != nil {
+			return err
+		}
+		p.metrics.RecordCreatedGames(len(games))
+	}
+
+	p.LastL1Header = toL1Header
+	p.metrics.RecordLatestHeight(toL1Header.Number)
+	return nil
+}
+
+func (p *DisputeGameProcessor) processResolvedGames() error {
+	games, err := p.db.DisputeGames.L1InProgressDisputeGames(p.resolvedGamesCursor, disputeGamesLimit)
+	if err != nil {
+		return err
+	}
+
+	for i := range games {
+		game := games[i]
+		status, err := p.callGame(game.GameAddress, "status")
+		if err != nil {
+			return fmt.Errorf("failed to query status of game %s: %w", game.GameAddress, err)
+		} else if database.GameStatus(status.(uint8)) == database.GameStatusInProgress {
+			continue
+		}
+
+		resolvedAt, err := p.callGame(game.GameAddress, "resolvedAt")
+		if err != nil {
+			return fmt.Errorf("failed to query resolution timestamp of game %s: %w", game.GameAddress, err)
+		}
+
+		gameStatus := database.GameStatus(status.(uint8))
+		p.log.Info("detected resolved dispute game", "game", game.GameAddress, "status", gameStatus)
+		if err := p.db.DisputeGames.MarkL1DisputeGameResolved(game.GameAddress, gameStatus, resolvedAt.(uint64)); err != nil {
+			return err
+		}
+		p.metrics.RecordResolvedGame(gameStatus)
+	}
+
+	p.resolvedGamesCursor = nil
+	if len(games) == disputeGamesLimit {
+		p.resolvedGamesCursor = &games[len(games)-1].GameAddress
+	}
+	return nil
+}
+
+func (p *DisputeGameProcessor) processProvenWithdrawals() error {
+	withdrawals, err := p.db.BridgeTransactions.L2TransactionWithdrawalsWithoutDisputeGame(p.provenWithdrawalsCursor, disputeGamesLimit)
+	if err != nil {
+		return err
+	}
+
+	for i := range withdrawals {
+		withdrawal := withdrawals[i]
+		outputs, err := p.callPortal("provenWithdrawals", withdrawal.WithdrawalHash)
+		if err != nil {
+			return fmt.Errorf("failed to query proven withdrawal %s: %w", withdrawal.WithdrawalHash, err)
+		}
+
+		// Proofs submitted prior to the portal upgrade are not tracked by the fault proof portal. The
+		// zero address is stored as the terminal state so that the withdrawal is not checked again
+		gameAddress := outputs[0].(common.Address)
+		if gameAddress == (common.Address{}) {
+			p.log.Debug("proven withdrawal without a dispute game", "withdrawal_hash", withdrawal.WithdrawalHash)
+			if err := p.db.BridgeTransactions.MarkL2TransactionWithdrawalProvenDisputeGame(withdrawal.WithdrawalHash, gameAddress); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// The portal only accepts games created by the factory, which are indexed prior to the proof
+		game, err := p.db.DisputeGames.L1DisputeGame(gameAddress)
+		if err != nil {
+			return err
+		} else if game == nil {
+			p.log.Debug("proven withdrawal with a dispute game yet to be indexed", "withdrawal_hash", withdrawal.WithdrawalHash, "game", gameAddress)
+			continue
+		}
+
+		if err := p.db.BridgeTransactions.MarkL2TransactionWithdrawalProvenDisputeGame(withdrawal.WithdrawalHash, gameAddress); err != nil {
+			return err
+		}
+		p.metrics.RecordLinkedWithdrawals(1)
+	}
+
+	p.provenWithdrawalsCursor = nil
+	if len(withdrawals) == disputeGamesLimit {
+		p.provenWithdrawalsCursor = &withdrawals[len(withdrawals)-1].WithdrawalHash
+	}
+	return nil
+}
+
+func (p *DisputeGameProcessor) processFinalizableWithdrawals() error {
+	// The delays are read on every interval as they may change with upgrades of the portal
+	proofMaturityDelay, err := p.callPortal("proofMaturityDelaySeconds")
+	if err != nil {
+		return fmt.Errorf("failed to query proof maturity delay: %w", err)
+	}
+	gameFinalityDelay, err := p.callPortal("disputeGameFinalityDelaySeconds")
+	if err != nil {
+		return fmt.Errorf("failed to query dispute game finality delay: %w", err)
+	}
+
+	return p.db.BridgeTransactions.MarkL2TransactionWithdrawalsFinalizable(proofMaturityDelay[0].(*big.Int).Uint64(), gameFinalityDelay[0].(*big.Int).Uint64())
+}
+
+// isFaultProofsPortal checks the version of the portal, as the L2OutputOracle based portal
+// does not expose the dispute game a withdrawal is proven against
+func (p *DisputeGameProcessor) isFaultProofsPortal() (bool, error) {
+	portalAbi, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return false, err
+	}
+	outputs, err := p.call(portalAbi, p.chainConfig.L1Contracts.OptimismPortalProxy, "version")
+	if err != nil {
+		return false, err
+	}
+
+	version := outputs[0].(string)
+	major, _, _ := strings.Cut(version, ".")
+	majorVersion, err := strconv.Atoi(major)
+	if err != nil {
+		return false, fmt.Errorf("invalid portal version %q: %w", version, err)
+	}
+	return majorVersion >= faultProofsPortalMajorVersion, nil
+}
+
+// callGame calls a single-output getter on the supplied dispute game
+func (p *DisputeGameProcessor) callGame(game common.Address, method string) (interface{}, error) {
+	outputs, err := p.call(p.gameAbi, game, method)
+	if err != nil {
+		return nil, err
+	}
+	return outputs[0], nil
+}
+
+func (p *DisputeGameProcessor) callPortal(method string, args ...interface{}) ([]interface{}, error) {
+	return p.call(p.portalAbi, p.chainConfig.L1Contracts.OptimismPortalProxy, method, args...)
+}
+
+func (p *DisputeGameProcessor) call(contractAbi *abi.ABI, to common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := p.l1Client.CallContract(ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractAbi.Unpack(method, result)
+}
@@ -0,0 +1,208 @@
+package processors
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/indexer/config"
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var portalAddr = common.HexToAddress("0x0de")
+
+// memDisputeGamesDB keeps the dispute games in memory, ordered by address
+type memDisputeGamesDB struct {
+	database.DisputeGamesDB
+	games []database.L1DisputeGame
+}
+
+func (db *memDisputeGamesDB) L1DisputeGame(gameAddress common.Address) (*database.L1DisputeGame, error) {
+	for i := range db.games {
+		if db.games[i].GameAddress == gameAddress {
+			return &db.games[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (db *memDisputeGamesDB) L1InProgressDisputeGames(after *common.Address, limit int) ([]database.L1DisputeGame, error) {
+	var games []database.L1DisputeGame
+	for _, game := range db.games {
+		if game.Status == database.GameStatusInProgress && (after == nil || bytes.Compare(game.GameAddress[:], after[:]) > 0) && len(games) < limit {
+			games = append(games, game)
+		}
+	}
+	return games, nil
+}
+
+func (db *memDisputeGamesDB) MarkL1DisputeGameResolved(gameAddress common.Address, status database.GameStatus, resolvedAt uint64) error {
+	game, _ := db.L1DisputeGame(gameAddress)
+	game.Status = status
+	game.ResolvedAt = &resolvedAt
+	return nil
+}
+
+// memBridgeTransactionsDB keeps the proven withdrawals in memory, ordered by hash
+type memBridgeTransactionsDB struct {
+	database.BridgeTransactionsDB
+	withdrawals []database.L2TransactionWithdrawal
+}
+
+func (db *memBridgeTransactionsDB) L2TransactionWithdrawalsWithoutDisputeGame(after *common.Hash, limit int) ([]database.L2TransactionWithdrawal, error) {
+	var withdrawals []database.L2TransactionWithdrawal
+	for _, withdrawal := range db.withdrawals {
+		if withdrawal.ProvenDisputeGameAddress == nil && (after == nil || bytes.Compare(withdrawal.WithdrawalHash[:], after[:]) > 0) && len(withdrawals) < limit {
+			withdrawals = append(withdrawals, withdrawal)
+		}
+	}
+	return withdrawals, nil
+}
+
+func (db *memBridgeTransactionsDB) MarkL2TransactionWithdrawalProvenDisputeGame(withdrawalHash common.Hash, gameAddress common.Address) error {
+	for i := range db.withdrawals {
+		if db.withdrawals[i].WithdrawalHash == withdrawalHash {
+			db.withdrawals[i].ProvenDisputeGameAddress = &gameAddress
+		}
+	}
+	return nil
+}
+
+func newTestDisputeGameProcessor(t *testing.T, client node.EthClient, games *memDisputeGamesDB, withdrawals *memBridgeTransactionsDB) *DisputeGameProcessor {
+	portalAbi, err := abi.JSON(strings.NewReader(optimismPortalFaultProofsAbi))
+	require.NoError(t, err)
+	gameAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(t, err)
+
+	chainConfig := config.ChainConfig{}
+	chainConfig.L1Contracts.OptimismPortalProxy = portalAddr
+	return &DisputeGameProcessor{
+		log:         testlog.Logger(t, log.LvlInfo),
+		db:          &database.DB{DisputeGames: games, BridgeTransactions: withdrawals},
+		metrics:     NewDisputeGameMetrics(metrics.NewRegistry()),
+		l1Client:    client,
+		chainConfig: chainConfig,
+		portalAbi:   &portalAbi,
+		gameAbi:     gameAbi,
+	}
+}
+
+// expectCall mocks the call of a method, returning the packed outputs
+func expectCall(t *testing.T, client *node.MockEthClient, contractAbi *abi.ABI, to common.Address, method string, args []interface{}, outputs ...interface{}) {
+	data, err := contractAbi.Pack(method, args...)
+	require.NoError(t, err)
+	result, err := contractAbi.Methods[method].Outputs.Pack(outputs...)
+	require.NoError(t, err)
+
+	matchesCall := func(msg ethereum.CallMsg) bool { return *msg.To == to && bytes.Equal(msg.Data, data) }
+	client.On("CallContract", mock.MatchedBy(matchesCall), mock.Anything).Return(result, nil)
+}
+
+func TestProcessResolvedGamesPagesThroughInProgressGames(t *testing.T) {
+	defer func(limit int) { disputeGamesLimit = limit }(disputeGamesLimit)
+	disputeGamesLimit = 2
+
+	games := &memDisputeGamesDB{}
+	for i := 1; i <= 3; i++ {
+		games.games = append(games.games, database.L1DisputeGame{GameAddress: common.BigToAddress(big.NewInt(int64(i))), Status: database.GameStatusInProgress})
+	}
+
+	client := new(node.MockEthClient)
+	proc := newTestDisputeGameProcessor(t, client, games, &memBridgeTransactionsDB{})
+	gameAbi := proc.gameAbi
+
+	// only the last game, beyond the first page, is resolved
+	expectCall(t, client, gameAbi, games.games[0].GameAddress, "status", nil, uint8(database.GameStatusInProgress))
+	expectCall(t, client, gameAbi, games.games[1].GameAddress, "status", nil, uint8(database.GameStatusInProgress))
+	expectCall(t, client, gameAbi, games.games[2].GameAddress, "status", nil, uint8(database.GameStatusDefenderWins))
+	expectCall(t, client, gameAbi, games.games[2].GameAddress, "resolvedAt", nil, uint64(100))
+
+	require.NoError(t, proc.processResolvedGames())
+	require.Equal(t, database.GameStatusInProgress, games.games[2].Status, "only the first page is processed")
+	require.Equal(t, games.games[1].GameAddress, *proc.resolvedGamesCursor)
+
+	require.NoError(t, proc.processResolvedGames())
+	require.Equal(t, database.GameStatusDefenderWins, games.games[2].Status)
+	require.Equal(t, uint64(100), *games.games[2].ResolvedAt)
+	require.Nil(t, proc.resolvedGamesCursor, "wraps around after the last page")
+
+	// the in progress games are checked again
+	require.NoError(t, proc.processResolvedGames())
+	client.AssertNumberOfCalls(t, "CallContract", 6)
+}
+
+func TestProcessProvenWithdrawalsPagesThroughUnlinkedWithdrawals(t *testing.T) {
+	defer func(limit int) { disputeGamesLimit = limit }(disputeGamesLimit)
+	disputeGamesLimit = 2
+
+	indexedGame := common.HexToAddress("0x9a3e")
+	games := &memDisputeGamesDB{games: []database.L1DisputeGame{{GameAddress: indexedGame, Status: database.GameStatusInProgress}}}
+	withdrawals := &memBridgeTransactionsDB{}
+	for i := 1; i <= 3; i++ {
+		withdrawals.withdrawals = append(withdrawals.withdrawals, database.L2TransactionWithdrawal{WithdrawalHash: common.BigToHash(big.NewInt(int64(i)))})
+	}
+
+	client := new(node.MockEthClient)
+	proc := newTestDisputeGameProcessor(t, client, games, withdrawals)
+
+	// the first withdrawals are proven against a game that is yet to be indexed
+	for i, game := range []common.Address{common.HexToAddress("0xdead"), common.HexToAddress("0xdead"), indexedGame} {
+		withdrawalHash := withdrawals.withdrawals[i].WithdrawalHash
+		expectCall(t, client, proc.portalAbi, portalAddr, "provenWithdrawals", []interface{}{withdrawalHash}, game, uint64(0))
+	}
+
+	require.NoError(t, proc.processProvenWithdrawals())
+	require.Nil(t, withdrawals.withdrawals[2].ProvenDisputeGameAddress, "only the first page is processed")
+	require.Equal(t, withdrawals.withdrawals[1].WithdrawalHash, *proc.provenWithdrawalsCursor)
+
+	require.NoError(t, proc.processProvenWithdrawals())
+	require.Equal(t, indexedGame, *withdrawals.withdrawals[2].ProvenDisputeGameAddress)
+	require.Nil(t, proc.provenWithdrawalsCursor, "wraps around after the last page")
+
+	require.NoError(t, proc.processProvenWithdrawals())
+	require.Nil(t, withdrawals.withdrawals[0].ProvenDisputeGameAddress)
+	client.AssertNumberOfCalls(t, "CallContract", 5)
+}
+
+func TestProcessProvenWithdrawalsMarksWithdrawalsWithoutGame(t *testing.T) {
+	withdrawals := &memBridgeTransactionsDB{withdrawals: []database.L2TransactionWithdrawal{{WithdrawalHash: common.HexToHash("0x01")}}}
+
+	client := new(node.MockEthClient)
+	proc := newTestDisputeGameProcessor(t, client, &memDisputeGamesDB{}, withdrawals)
+	expectCall(t, client, proc.portalAbi, portalAddr, "provenWithdrawals", []interface{}{withdrawals.withdrawals[0].WithdrawalHash}, common.Address{}, uint64(0))
+
+	require.NoError(t, proc.processProvenWithdrawals())
+	require.Equal(t, common.Address{}, *withdrawals.withdrawals[0].ProvenDisputeGameAddress)
+
+	// the withdrawal is not checked again
+	require.NoError(t, proc.processProvenWithdrawals())
+	client.AssertNumberOfCalls(t, "CallContract", 1)
+}
+
+func TestIsFaultProofsPortal(t *testing.T) {
+	portalAbi, err := bindings.OptimismPortalMetaData.GetAbi()
+	require.NoError(t, err)
+
+	for version, faultProofs := range map[string]bool{"1.10.0": false, "2.5.0": false, "3.0.0": true, "3.8.0-beta.1": true} {
+		client := new(node.MockEthClient)
+		proc := newTestDisputeGameProcessor(t, client, &memDisputeGamesDB{}, &memBridgeTransactionsDB{})
+		expectCall(t, client, portalAbi, portalAddr, "version", nil, version)
+
+		isFaultProofs, err := proc.isFaultProofsPortal()
+		require.NoError(t, err)
+		require.Equal(t, faultProofs, isFaultProofs, version)
+	}
+}
@@ -0,0 +1,104 @@
+package processors
+
+import (
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	MetricsNamespace string = "op_indexer_dispute_games"
+)
+
+type DisputeGameMetricer interface {
+	RecordInterval() (done func(err error))
+	RecordLatestHeight(height *big.Int)
+
+	RecordCreatedGames(size int)
+	RecordResolvedGame(status database.GameStatus)
+	RecordLinkedWithdrawals(size int)
+}
+
+type disputeGameMetrics struct {
+	intervalTick     prometheus.Counter
+	intervalDuration prometheus.Histogram
+	intervalFailures prometheus.Counter
+	latestHeight     prometheus.Gauge
+
+	createdGames      prometheus.Counter
+	resolvedGames     *prometheus.CounterVec
+	linkedWithdrawals prometheus.Counter
+}
+
+func NewDisputeGameMetrics(registry *prometheus.Registry) DisputeGameMetricer {
+	factory := metrics.With(registry)
+	return &disputeGameMetrics{
+		intervalTick: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "intervals_total",
+			Help:      "number of times processing loop has run",
+		}),
+		intervalDuration: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "interval_seconds",
+			Help:      "duration elapsed in the processing loop",
+		}),
+		intervalFailures: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "interval_failures_total",
+			Help:      "number of failures encountered",
+		}),
+		latestHeight: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "latest_height",
+			Help:      "the latest processed l1 block height",
+		}),
+		createdGames: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "created_games",
+			Help:      "number of indexed dispute games",
+		}),
+		resolvedGames: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "resolved_games",
+			Help:      "number of indexed dispute games that have resolved",
+		}, []string{
+			"status",
+		}),
+		linkedWithdrawals: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "linked_withdrawals",
+			Help:      "number of proven withdrawals linked to a dispute game",
+		}),
+	}
+}
+
+func (m *disputeGameMetrics) RecordInterval() func(error) {
+	m.intervalTick.Inc()
+	timer := prometheus.NewTimer(m.intervalDuration)
+	return func(err error) {
+		timer.ObserveDuration()
+		if err != nil {
+			m.intervalFailures.Inc()
+		}
+	}
+}
+
+func (m *disputeGameMetrics) RecordLatestHeight(height *big.Int) {
+	m.latestHeight.Set(float64(height.Uint64()))
+}
+
+func (m *disputeGameMetrics) RecordCreatedGames(size int) {
+	m.createdGames.Add(float64(size))
+}
+
+func (m *disputeGameMetrics) RecordResolvedGame(status database.GameStatus) {
+	m.resolvedGames.WithLabelValues(status.String()).Inc()
+}
+
+func (m *disputeGameMetrics) RecordLinkedWithdrawals(size int) {
+	m.linkedWithdrawals.Add(float64(size))
+}
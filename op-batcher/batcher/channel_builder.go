@@ -8,11 +8,18 @@ import (
 	"math"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
+// MaxBlobsPerBlobTx is the maximum number of blobs a single blob transaction
+// can carry. Since every frame is posted in its own blob, it also bounds the
+// number of frames per blob transaction.
+const MaxBlobsPerBlobTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+
 var (
 	ErrInvalidChannelTimeout = errors.New("channel timeout is less than the safety margin")
 	ErrMaxFrameIndex         = errors.New("max frame index reached (uint16)")
@@ -62,6 +69,33 @@ type ChannelConfig struct {
 
 	// BatchType indicates whether the channel uses SingularBatch or SpanBatch.
 	BatchType uint
+
+	// UseBlobs indicates that this channel should be posted to L1 in blob
+	// transactions instead of calldata transactions.
+	UseBlobs bool
+
+	// MultiFrameTxs controls whether to put all frames of a channel inside a
+	// single tx, up to the compressor's TargetNumFrames. Should only be used
+	// for blob transactions, where each frame is posted in its own blob.
+	MultiFrameTxs bool
+}
+
+// DAType returns the data availability type the channel is posted with, as
+// one of the data availability types defined in op-batcher/flags/flags.go.
+func (cc *ChannelConfig) DAType() string {
+	if cc.UseBlobs {
+		return flags.BlobsType
+	}
+	return flags.CalldataType
+}
+
+// MaxFramesPerTx returns the maximum number of frames that are put into a
+// single tx.
+func (cc *ChannelConfig) MaxFramesPerTx() int {
+	if !cc.MultiFrameTxs || cc.CompressorConfig.TargetNumFrames < 1 {
+		return 1
+	}
+	return cc.CompressorConfig.TargetNumFrames
 }
 
 // Check validates the [ChannelConfig] parameters.
@@ -91,6 +125,14 @@ func (cc *ChannelConfig) Check() error {
 		return fmt.Errorf("unrecognized batch type: %d", cc.BatchType)
 	}
 
+	if cc.MultiFrameTxs && !cc.UseBlobs {
+		return errors.New("multi-frame txs are only supported for blob transactions")
+	}
+
+	if nf := cc.CompressorConfig.TargetNumFrames; cc.MultiFrameTxs && nf > MaxBlobsPerBlobTx {
+		return fmt.Errorf("too many frames per blob transaction: %d, max %d", nf, MaxBlobsPerBlobTx)
+	}
+
 	return nil
 }
 
@@ -449,11 +491,13 @@ func (c *channelBuilder) NextFrame() frameData {
 	return f
 }
 
-// PushFrame adds the frame back to the internal frames queue. Panics if not of
-// the same channel.
-func (c *channelBuilder) PushFrame(frame frameData) {
-	if frame.id.chID != c.ID() {
-		panic("wrong channel")
+// PushFrames adds the frames back to the front of the internal frames queue,
+// preserving their order. Panics if not of the same channel.
+func (c *channelBuilder) PushFrames(frames ...frameData) {
+	for _, f := range frames {
+		if f.id.chID != c.ID() {
+			panic("wrong channel")
+		}
 	}
-	c.frames = append(c.frames, frame)
+	c.frames = append(append(make([]frameData, 0, len(frames)+len(c.frames)), frames...), c.frames...)
 }
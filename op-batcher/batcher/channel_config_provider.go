@@ -0,0 +1,139 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ChannelConfigProvider provides the [ChannelConfig] to use for a new channel.
+// It is queried by the channel manager every time it opens a new channel.
+type ChannelConfigProvider interface {
+	ChannelConfig() ChannelConfig
+}
+
+// ChannelConfigUpdater is implemented by a [ChannelConfigProvider] whose config depends on L1 state.
+// The driver updates it on every new L1 tip. ChannelConfig is called with the channel manager lock
+// held, so it must not query L1 itself.
+type ChannelConfigUpdater interface {
+	UpdateChannelConfig(ctx context.Context)
+}
+
+// ChannelConfig implements [ChannelConfigProvider] by always returning itself.
+func (cc ChannelConfig) ChannelConfig() ChannelConfig {
+	return cc
+}
+
+// GasPricer is the set of L1 methods used to determine the current L1 fees.
+type GasPricer interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// DynamicEthChannelConfig chooses between a blob and a calldata [ChannelConfig],
+// depending on which data availability type is currently cheaper on L1.
+type DynamicEthChannelConfig struct {
+	log       log.Logger
+	timeout   time.Duration // L1 query timeout
+	gasPricer GasPricer
+
+	blobConfig     ChannelConfig
+	calldataConfig ChannelConfig
+
+	mu         sync.Mutex
+	lastConfig *ChannelConfig
+}
+
+func NewDynamicEthChannelConfig(lgr log.Logger, reqTimeout time.Duration, gasPricer GasPricer,
+	blobConfig ChannelConfig, calldataConfig ChannelConfig,
+) *DynamicEthChannelConfig {
+	dec := &DynamicEthChannelConfig{
+		log:            lgr,
+		timeout:        reqTimeout,
+		gasPricer:      gasPricer,
+		blobConfig:     blobConfig,
+		calldataConfig: calldataConfig,
+	}
+	// start with blob config
+	dec.lastConfig = &dec.blobConfig
+	return dec
+}
+
+// ChannelConfig returns the channel config of the data availability type that
+// was cheaper per byte of batch data at the last update.
+func (dec *DynamicEthChannelConfig) ChannelConfig() ChannelConfig {
+	dec.mu.Lock()
+	defer dec.mu.Unlock()
+	return *dec.lastConfig
+}
+
+// UpdateChannelConfig queries the current L1 fees and selects the channel config of
+// the cheaper data availability type. If the L1 fees cannot be queried, the last
+// config is kept.
+func (dec *DynamicEthChannelConfig) UpdateChannelConfig(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, dec.timeout)
+	defer cancel()
+	tipCap, err := dec.gasPricer.SuggestGasTipCap(ctx)
+	if err != nil {
+		dec.log.Warn("Error querying gas tip cap, keeping last config", "err", err)
+		return
+	}
+	head, err := dec.gasPricer.HeaderByNumber(ctx, nil)
+	if err != nil {
+		dec.log.Warn("Error querying L1 head, keeping last config", "err", err)
+		return
+	}
+	if head.BaseFee == nil || head.ExcessBlobGas == nil {
+		dec.log.Warn("L1 head has no base fee or blob base fee, using calldata config")
+		dec.setLastConfig(&dec.calldataConfig)
+		return
+	}
+	blobBaseFee := eip4844.CalcBlobFee(*head.ExcessBlobGas)
+
+	// We estimate the gas costs of a calldata and blob tx under the assumption
+	// that frames are filled fully and that compressed channel data has few
+	// zeros, so the calldata zero-byte discount can be ignored. A calldata tx
+	// contains a single frame while a blob tx contains MaxFramesPerTx blobs.
+	calldataBytes := dec.calldataConfig.MaxFrameSize + 1 // + 1 version byte
+	calldataGas := new(big.Int).SetUint64(calldataBytes*params.TxDataNonZeroGasEIP2028 + params.TxGas)
+	calldataPrice := new(big.Int).Add(head.BaseFee, tipCap)
+	calldataCost := new(big.Int).Mul(calldataGas, calldataPrice)
+
+	numBlobs := int64(dec.blobConfig.MaxFramesPerTx())
+	blobGas := big.NewInt(params.BlobTxBlobGasPerBlob * numBlobs)
+	blobCost := new(big.Int).Mul(blobGas, blobBaseFee)
+	// blob txs still pay for the intrinsic execution gas
+	blobCost.Add(blobCost, new(big.Int).Mul(big.NewInt(int64(params.TxGas)), calldataPrice))
+	blobDataBytes := big.NewInt(eth.MaxBlobDataSize * numBlobs)
+
+	// Compare the costs per byte: calldataCost/calldataBytes > blobCost/blobDataBytes
+	lhs := new(big.Int).Mul(calldataCost, blobDataBytes)
+	rhs := new(big.Int).Mul(blobCost, new(big.Int).SetUint64(calldataBytes))
+
+	useBlobs := lhs.Cmp(rhs) > 0
+	dec.log.Info("Computed data availability costs",
+		"use_blobs", useBlobs,
+		"base_fee", head.BaseFee, "blob_base_fee", blobBaseFee, "tip_cap", tipCap,
+		"calldata_bytes", calldataBytes, "calldata_cost", calldataCost,
+		"blob_data_bytes", blobDataBytes, "blob_cost", blobCost)
+
+	if useBlobs {
+		dec.setLastConfig(&dec.blobConfig)
+	} else {
+		dec.setLastConfig(&dec.calldataConfig)
+	}
+}
+
+func (dec *DynamicEthChannelConfig) setLastConfig(cfg *ChannelConfig) {
+	dec.mu.Lock()
+	defer dec.mu.Unlock()
+	dec.lastConfig = cfg
+}
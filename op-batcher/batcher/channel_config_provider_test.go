@@ -0,0 +1,116 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type mockGasPricer struct {
+	err           error
+	tipCap        int64
+	baseFee       int64
+	excessBlobGas *uint64
+
+	calls int
+}
+
+func (gp *mockGasPricer) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	gp.calls++
+	if gp.err != nil {
+		return nil, gp.err
+	}
+	return &types.Header{BaseFee: big.NewInt(gp.baseFee), ExcessBlobGas: gp.excessBlobGas}, nil
+}
+
+func (gp *mockGasPricer) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	gp.calls++
+	if gp.err != nil {
+		return nil, gp.err
+	}
+	return big.NewInt(gp.tipCap), nil
+}
+
+func TestDynamicEthChannelConfig_ChannelConfig(t *testing.T) {
+	calldataCfg := ChannelConfig{
+		MaxFrameSize: 120_000 - 1,
+	}
+	blobCfg := ChannelConfig{
+		MaxFrameSize:  131_072 - 1,
+		UseBlobs:      true,
+		MultiFrameTxs: true,
+	}
+	blobCfg.CompressorConfig.TargetNumFrames = 3
+
+	// blob gas price of 1 wei at zero excess blob gas
+	zeroExcess := uint64(0)
+	// high excess blob gas, resulting in a blob gas price of ~130k wei
+	highExcess := uint64(params.BlobTxTargetBlobGasPerBlock * 100)
+
+	tests := []struct {
+		name        string
+		gasPricer   *mockGasPricer
+		expUseBlobs bool
+	}{
+		{
+			name:        "much-cheaper-blobs",
+			gasPricer:   &mockGasPricer{tipCap: 1e3, baseFee: 1e6, excessBlobGas: &zeroExcess},
+			expUseBlobs: true,
+		},
+		{
+			name:        "much-cheaper-calldata",
+			gasPricer:   &mockGasPricer{tipCap: 1e3, baseFee: 1, excessBlobGas: &highExcess},
+			expUseBlobs: false,
+		},
+		{
+			name:        "pre-cancun",
+			gasPricer:   &mockGasPricer{tipCap: 1e3, baseFee: 1e6},
+			expUseBlobs: false,
+		},
+		{
+			name:        "error-keeps-last-config",
+			gasPricer:   &mockGasPricer{err: errors.New("gp-error")},
+			expUseBlobs: true, // blob config is the initial config
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lgr := testlog.Logger(t, log.LvlCrit)
+			dec := NewDynamicEthChannelConfig(lgr, time.Second, tt.gasPricer, blobCfg, calldataCfg)
+			dec.UpdateChannelConfig(context.Background())
+			cc := dec.ChannelConfig()
+			if tt.expUseBlobs {
+				require.Equal(t, blobCfg, cc)
+			} else {
+				require.Equal(t, calldataCfg, cc)
+			}
+		})
+	}
+}
+
+func TestDynamicEthChannelConfig_OnlyUpdateQueriesL1(t *testing.T) {
+	calldataCfg := ChannelConfig{MaxFrameSize: 120_000 - 1}
+	blobCfg := ChannelConfig{MaxFrameSize: 131_072 - 1, UseBlobs: true, MultiFrameTxs: true}
+	highExcess := uint64(params.BlobTxTargetBlobGasPerBlock * 100)
+	gasPricer := &mockGasPricer{tipCap: 1e3, baseFee: 1, excessBlobGas: &highExcess}
+
+	lgr := testlog.Logger(t, log.LvlCrit)
+	dec := NewDynamicEthChannelConfig(lgr, time.Second, gasPricer, blobCfg, calldataCfg)
+	require.Equal(t, blobCfg, dec.ChannelConfig(), "starts with the blob config")
+	require.Zero(t, gasPricer.calls, "channel config is queried under the channel manager lock")
+
+	dec.UpdateChannelConfig(context.Background())
+	require.Equal(t, 2, gasPricer.calls)
+	require.Equal(t, calldataCfg, dec.ChannelConfig())
+	require.Equal(t, calldataCfg, dec.ChannelConfig())
+	require.Equal(t, 2, gasPricer.calls)
+}
@@ -25,14 +25,16 @@ var ErrReorg = errors.New("block does not extend existing chain")
 // channel.
 // Public functions on channelManager are safe for concurrent access.
 type channelManager struct {
-	mu        sync.Mutex
-	log       log.Logger
-	metr      metrics.Metricer
-	cfg       ChannelConfig
-	rollupCfg *rollup.Config
+	mu          sync.Mutex
+	log         log.Logger
+	metr        metrics.Metricer
+	cfgProvider ChannelConfigProvider
+	rollupCfg   *rollup.Config
 
 	// All blocks since the last request for new tx data.
 	blocks []*types.Block
+	// Estimated batch size of all blocks not yet added to a channel. Used for DA throttling.
+	pendingBytes uint64
 	// last block hash - for reorg detection
 	tip common.Hash
 
@@ -41,19 +43,19 @@ type channelManager struct {
 	// channels to read frame data from, for writing batches onchain
 	channelQueue []*channel
 	// used to lookup channels by tx ID upon tx success / failure
-	txChannels map[txID]*channel
+	txChannels map[string]*channel
 
 	// if set to true, prevents production of any new channel frames
 	closed bool
 }
 
-func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, rollupCfg *rollup.Config) *channelManager {
+func NewChannelManager(log log.Logger, metr metrics.Metricer, cfgProvider ChannelConfigProvider, rollupCfg *rollup.Config) *channelManager {
 	return &channelManager{
-		log:        log,
-		metr:       metr,
-		cfg:        cfg,
-		rollupCfg:  rollupCfg,
-		txChannels: make(map[txID]*channel),
+		log:         log,
+		metr:        metr,
+		cfgProvider: cfgProvider,
+		rollupCfg:   rollupCfg,
+		txChannels:  make(map[string]*channel),
 	}
 }
 
@@ -64,11 +66,12 @@ func (s *channelManager) Clear() {
 	defer s.mu.Unlock()
 	s.log.Trace("clearing channel manager state")
 	s.blocks = s.blocks[:0]
+	s.pendingBytes = 0
 	s.tip = common.Hash{}
 	s.closed = false
 	s.currentChannel = nil
 	s.channelQueue = nil
-	s.txChannels = make(map[txID]*channel)
+	s.txChannels = make(map[string]*channel)
 }
 
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
@@ -76,8 +79,8 @@ func (s *channelManager) Clear() {
 func (s *channelManager) TxFailed(id txID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if channel, ok := s.txChannels[id]; ok {
-		delete(s.txChannels, id)
+	if channel, ok := s.txChannels[id.String()]; ok {
+		delete(s.txChannels, id.String())
 		channel.TxFailed(id)
 		if s.closed && channel.NoneSubmitted() {
 			s.log.Info("Channel has no submitted transactions, clearing for shutdown", "chID", channel.ID())
@@ -95,9 +98,12 @@ func (s *channelManager) TxFailed(id txID) {
 func (s *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if channel, ok := s.txChannels[id]; ok {
-		delete(s.txChannels, id)
+	if channel, ok := s.txChannels[id.String()]; ok {
+		delete(s.txChannels, id.String())
 		done, blocks := channel.TxConfirmed(id, inclusionBlock)
+		for _, block := range blocks {
+			s.pendingBytes += metrics.EstimateBatchSize(block)
+		}
 		s.blocks = append(blocks, s.blocks...)
 		if done {
 			s.removePendingChannel(channel)
@@ -130,37 +136,38 @@ func (s *channelManager) removePendingChannel(channel *channel) {
 
 // nextTxData pops off s.datas & handles updating the internal state
 func (s *channelManager) nextTxData(channel *channel) (txData, error) {
-	if channel == nil || !channel.HasFrame() {
+	if channel == nil || !channel.HasTxData() {
 		s.log.Trace("no next tx data")
 		return txData{}, io.EOF // TODO: not enough data error instead
 	}
 	tx := channel.NextTxData()
-	s.txChannels[tx.ID()] = channel
+	s.txChannels[tx.ID().String()] = channel
 	return tx, nil
 }
 
 // TxData returns the next tx data that should be submitted to L1.
 //
-// It currently only uses one frame per transaction. If the pending channel is
-// full, it only returns the remaining frames of this channel until it got
-// successfully fully sent to L1. It returns io.EOF if there's no pending frame.
+// It uses up to [ChannelConfig.MaxFramesPerTx] frames per transaction. If the
+// pending channel is full, it only returns the remaining frames of this channel
+// until it got successfully fully sent to L1. It returns io.EOF if there's no
+// pending tx data.
 func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	var firstWithFrame *channel
+	var firstWithTxData *channel
 	for _, ch := range s.channelQueue {
-		if ch.HasFrame() {
-			firstWithFrame = ch
+		if ch.HasTxData() {
+			firstWithTxData = ch
 			break
 		}
 	}
 
-	dataPending := firstWithFrame != nil && firstWithFrame.HasFrame()
+	dataPending := firstWithTxData != nil && firstWithTxData.HasTxData()
 	s.log.Debug("Requested tx data", "l1Head", l1Head, "data_pending", dataPending, "blocks_pending", len(s.blocks))
 
 	// Short circuit if there is a pending frame or the channel manager is closed.
 	if dataPending || s.closed {
-		return s.nextTxData(firstWithFrame)
+		return s.nextTxData(firstWithTxData)
 	}
 
 	// No pending frame, so we have to add new blocks to the channel
@@ -192,13 +199,16 @@ func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 
 // ensureChannelWithSpace ensures currentChannel is populated with a channel that has
 // space for more data (i.e. channel.IsFull returns false). If currentChannel is nil
-// or full, a new channel is created.
+// or full, a new channel is created. The config of a new channel, including its
+// data availability type, is queried from the config provider at this point,
+// i.e. after the previous channel got sealed.
 func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 	if s.currentChannel != nil && !s.currentChannel.IsFull() {
 		return nil
 	}
 
-	pc, err := newChannel(s.log, s.metr, s.cfg, s.rollupCfg)
+	cfg := s.cfgProvider.ChannelConfig()
+	pc, err := newChannel(s.log, s.metr, cfg, s.rollupCfg)
 	if err != nil {
 		return fmt.Errorf("creating new channel: %w", err)
 	}
@@ -208,10 +218,12 @@ func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 		"id", pc.ID(),
 		"l1Head", l1Head,
 		"blocks_pending", len(s.blocks),
-		"batch_type", s.cfg.BatchType,
-		"max_frame_size", s.cfg.MaxFrameSize,
+		"batch_type", cfg.BatchType,
+		"max_frame_size", cfg.MaxFrameSize,
+		"da_type", cfg.DAType(),
 	)
 	s.metr.RecordChannelOpened(pc.ID(), len(s.blocks))
+	s.metr.RecordChannelDAType(cfg.DAType())
 
 	return nil
 }
@@ -245,6 +257,7 @@ func (s *channelManager) processBlocks() error {
 		s.log.Debug("Added block to channel", "id", s.currentChannel.ID(), "block", eth.ToBlockID(block))
 
 		blocksAdded += 1
+		s.pendingBytes -= metrics.EstimateBatchSize(block)
 		latestL2ref = l2BlockRefFromBlockAndL1Info(block, l1info)
 		s.metr.RecordL2BlockInChannel(block)
 		// current block got added but channel is now full
@@ -322,11 +335,20 @@ func (s *channelManager) AddL2Block(block *types.Block) error {
 
 	s.metr.RecordL2BlockInPendingQueue(block)
 	s.blocks = append(s.blocks, block)
+	s.pendingBytes += metrics.EstimateBatchSize(block)
 	s.tip = block.Hash()
 
 	return nil
 }
 
+// PendingBytes returns the estimated batch size of all blocks that have not
+// been added to a channel yet.
+func (s *channelManager) PendingBytes() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pendingBytes
+}
+
 func l2BlockRefFromBlockAndL1Info(block *types.Block, l1info *derive.L1BlockInfo) eth.L2BlockRef {
 	return eth.L2BlockRef{
 		Hash:           block.Hash(),
@@ -385,7 +407,7 @@ func (s *channelManager) Close() error {
 		}
 	}
 
-	if s.currentChannel.HasFrame() {
+	if s.currentChannel.HasTxData() {
 		// Make it clear to the caller that there is remaining pending work.
 		return ErrPendingAfterClose
 	}
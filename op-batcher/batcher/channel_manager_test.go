@@ -217,7 +217,7 @@ func ChannelManager_TxResend(t *testing.T, batchType uint) {
 
 	txdata0, err := m.TxData(eth.BlockID{})
 	require.NoError(err)
-	txdata0bytes := txdata0.Bytes()
+	txdata0bytes := txdata0.CallData()
 	data0 := make([]byte, len(txdata0bytes))
 	// make sure we have a clone for later comparison
 	copy(data0, txdata0bytes)
@@ -232,7 +232,7 @@ func ChannelManager_TxResend(t *testing.T, batchType uint) {
 	txdata1, err := m.TxData(eth.BlockID{})
 	require.NoError(err)
 
-	data1 := txdata1.Bytes()
+	data1 := txdata1.CallData()
 	require.Equal(data1, data0)
 	fs, err := derive.ParseFrames(data1)
 	require.NoError(err)
@@ -478,3 +478,38 @@ func ChannelManagerCloseAllTxsFailed(t *testing.T, batchType uint) {
 	_, err = m.TxData(eth.BlockID{})
 	require.ErrorIs(err, io.EOF, "Expected closed channel manager to produce no more tx data")
 }
+
+// TestChannelManager_PendingBytes tests that the pending bytes only account
+// for blocks that haven't been added to a channel yet.
+func TestChannelManager_PendingBytes(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			MaxFrameSize: 120_000,
+			CompressorConfig: compressor.Config{
+				TargetFrameSize:  100_000,
+				TargetNumFrames:  1,
+				ApproxComprRatio: 1.0,
+			},
+		},
+		&defaultTestRollupConfig,
+	)
+	m.Clear()
+	require.Zero(t, m.PendingBytes())
+
+	a := newMiniL2Block(2)
+	b := newMiniL2BlockWithNumberParent(2, big.NewInt(1), a.Hash())
+	require.NoError(t, m.AddL2Block(a))
+	require.NoError(t, m.AddL2Block(b))
+	require.Equal(t, metrics.EstimateBatchSize(a)+metrics.EstimateBatchSize(b), m.PendingBytes())
+
+	// blocks added to a channel are no longer pending, even if the channel isn't full yet
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(t, err, io.EOF)
+	require.Zero(t, m.PendingBytes())
+
+	require.NoError(t, m.AddL2Block(newMiniL2BlockWithNumberParent(2, big.NewInt(2), b.Hash())))
+	require.NotZero(t, m.PendingBytes())
+	m.Clear()
+	require.Zero(t, m.PendingBytes())
+}
@@ -39,8 +39,8 @@ func TestChannelTimeout(t *testing.T) {
 
 	// Manually set a confirmed transactions
 	// To avoid other methods clearing state
-	channel.confirmedTransactions[frameID{frameNumber: 0}] = eth.BlockID{Number: 0}
-	channel.confirmedTransactions[frameID{frameNumber: 1}] = eth.BlockID{Number: 99}
+	channel.confirmedTransactions[txID{frameID{frameNumber: 0}}.String()] = eth.BlockID{Number: 0}
+	channel.confirmedTransactions[txID{frameID{frameNumber: 1}}.String()] = eth.BlockID{Number: 99}
 	channel.confirmedTxUpdated = true
 
 	// Since the ChannelTimeout is 100, the
@@ -50,9 +50,9 @@ func TestChannelTimeout(t *testing.T) {
 
 	// Add a confirmed transaction with a higher number
 	// than the ChannelTimeout
-	channel.confirmedTransactions[frameID{
+	channel.confirmedTransactions[txID{frameID{
 		frameNumber: 2,
-	}] = eth.BlockID{
+	}}.String()] = eth.BlockID{
 		Number: 101,
 	}
 	channel.confirmedTxUpdated = true
@@ -92,17 +92,17 @@ func TestChannelNextTxData(t *testing.T) {
 			frameNumber: uint16(0),
 		},
 	}
-	channel.channelBuilder.PushFrame(frame)
+	channel.channelBuilder.PushFrames(frame)
 	require.Equal(t, 1, channel.PendingFrames())
 
 	// Now the nextTxData function should return the frame
 	returnedTxData, err = m.nextTxData(channel)
-	expectedTxData := txData{frame}
+	expectedTxData := singleFrameTxData(frame)
 	expectedChannelID := expectedTxData.ID()
 	require.NoError(t, err)
 	require.Equal(t, expectedTxData, returnedTxData)
 	require.Equal(t, 0, channel.PendingFrames())
-	require.Equal(t, expectedTxData, channel.pendingTransactions[expectedChannelID])
+	require.Equal(t, expectedTxData, channel.pendingTransactions[expectedChannelID.String()])
 }
 
 // TestChannelTxConfirmed checks the [ChannelManager.TxConfirmed] function.
@@ -128,15 +128,15 @@ func TestChannelTxConfirmed(t *testing.T) {
 			frameNumber: uint16(0),
 		},
 	}
-	m.currentChannel.channelBuilder.PushFrame(frame)
+	m.currentChannel.channelBuilder.PushFrames(frame)
 	require.Equal(t, 1, m.currentChannel.PendingFrames())
 	returnedTxData, err := m.nextTxData(m.currentChannel)
-	expectedTxData := txData{frame}
+	expectedTxData := singleFrameTxData(frame)
 	expectedChannelID := expectedTxData.ID()
 	require.NoError(t, err)
 	require.Equal(t, expectedTxData, returnedTxData)
 	require.Equal(t, 0, m.currentChannel.PendingFrames())
-	require.Equal(t, expectedTxData, m.currentChannel.pendingTransactions[expectedChannelID])
+	require.Equal(t, expectedTxData, m.currentChannel.pendingTransactions[expectedChannelID.String()])
 	require.Len(t, m.currentChannel.pendingTransactions, 1)
 
 	// An unknown pending transaction should not be marked as confirmed
@@ -144,7 +144,7 @@ func TestChannelTxConfirmed(t *testing.T) {
 	actualChannelID := m.currentChannel.ID()
 	unknownChannelID := derive.ChannelID([derive.ChannelIDLength]byte{0x69})
 	require.NotEqual(t, actualChannelID, unknownChannelID)
-	unknownTxID := frameID{chID: unknownChannelID, frameNumber: 0}
+	unknownTxID := txID{frameID{chID: unknownChannelID, frameNumber: 0}}
 	blockID := eth.BlockID{Number: 0, Hash: common.Hash{0x69}}
 	m.TxConfirmed(unknownTxID, blockID)
 	require.Empty(t, m.currentChannel.confirmedTransactions)
@@ -156,7 +156,7 @@ func TestChannelTxConfirmed(t *testing.T) {
 	m.TxConfirmed(expectedChannelID, blockID)
 	require.Empty(t, m.currentChannel.pendingTransactions)
 	require.Len(t, m.currentChannel.confirmedTransactions, 1)
-	require.Equal(t, blockID, m.currentChannel.confirmedTransactions[expectedChannelID])
+	require.Equal(t, blockID, m.currentChannel.confirmedTransactions[expectedChannelID.String()])
 }
 
 // TestChannelTxFailed checks the [ChannelManager.TxFailed] function.
@@ -177,22 +177,22 @@ func TestChannelTxFailed(t *testing.T) {
 			frameNumber: uint16(0),
 		},
 	}
-	m.currentChannel.channelBuilder.PushFrame(frame)
+	m.currentChannel.channelBuilder.PushFrames(frame)
 	require.Equal(t, 1, m.currentChannel.PendingFrames())
 	returnedTxData, err := m.nextTxData(m.currentChannel)
-	expectedTxData := txData{frame}
+	expectedTxData := singleFrameTxData(frame)
 	expectedChannelID := expectedTxData.ID()
 	require.NoError(t, err)
 	require.Equal(t, expectedTxData, returnedTxData)
 	require.Equal(t, 0, m.currentChannel.PendingFrames())
-	require.Equal(t, expectedTxData, m.currentChannel.pendingTransactions[expectedChannelID])
+	require.Equal(t, expectedTxData, m.currentChannel.pendingTransactions[expectedChannelID.String()])
 	require.Len(t, m.currentChannel.pendingTransactions, 1)
 
 	// Trying to mark an unknown pending transaction as failed
 	// shouldn't modify state
-	m.TxFailed(frameID{})
+	m.TxFailed(txID{frameID{}})
 	require.Equal(t, 0, m.currentChannel.PendingFrames())
-	require.Equal(t, expectedTxData, m.currentChannel.pendingTransactions[expectedChannelID])
+	require.Equal(t, expectedTxData, m.currentChannel.pendingTransactions[expectedChannelID.String()])
 
 	// Now we still have a pending transaction
 	// Let's mark it as failed
@@ -201,3 +201,61 @@ func TestChannelTxFailed(t *testing.T) {
 	// There should be a frame in the pending channel now
 	require.Equal(t, 1, m.currentChannel.PendingFrames())
 }
+
+// TestChannelNextTxData_multiFrameTx checks that multi-frame txs only get
+// built once enough frames are available, or the channel is full.
+func TestChannelNextTxData_multiFrameTx(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := ChannelConfig{MultiFrameTxs: true}
+	cfg.CompressorConfig.TargetNumFrames = 3
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg, &rollup.Config{})
+	m.Clear()
+
+	require.NoError(t, m.ensureChannelWithSpace(eth.BlockID{}))
+	channel := m.currentChannel
+	require.NotNil(t, channel)
+
+	frames := make([]frameData, 5)
+	for i := range frames {
+		frames[i] = frameData{
+			data: []byte{byte(i)},
+			id:   frameID{chID: channel.ID(), frameNumber: uint16(i)},
+		}
+	}
+
+	// Not enough frames for a full tx yet
+	channel.channelBuilder.PushFrames(frames[3:]...)
+	require.False(t, channel.HasTxData())
+	_, err := m.nextTxData(channel)
+	require.ErrorIs(t, err, io.EOF)
+
+	// Now the first tx can be built. Frames are pushed to the front of the queue
+	channel.channelBuilder.PushFrames(frames[:3]...)
+	require.True(t, channel.HasTxData())
+	txdata, err := m.nextTxData(channel)
+	require.NoError(t, err)
+	require.Equal(t, frames[:3], txdata.Frames())
+	require.Equal(t, 2, channel.PendingFrames())
+
+	// The remaining frames only get sent once the channel is full
+	require.False(t, channel.HasTxData())
+	channel.Close()
+	require.True(t, channel.HasTxData())
+	lastTxdata, err := m.nextTxData(channel)
+	require.NoError(t, err)
+	require.Equal(t, frames[3:], lastTxdata.Frames())
+
+	// Failing the first tx re-queues all of its frames in order
+	m.TxFailed(txdata.ID())
+	require.Equal(t, 3, channel.PendingFrames())
+	txdata, err = m.nextTxData(channel)
+	require.NoError(t, err)
+	require.Equal(t, frames[:3], txdata.Frames())
+
+	// Confirming the txs marks all frames as submitted
+	m.TxConfirmed(txdata.ID(), eth.BlockID{Number: 1})
+	m.TxConfirmed(lastTxdata.ID(), eth.BlockID{Number: 1})
+	require.Empty(t, channel.pendingTransactions)
+	require.Len(t, channel.confirmedTransactions, 2)
+	require.True(t, channel.isFullySubmitted())
+}
@@ -14,6 +14,7 @@ import (
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -58,13 +59,27 @@ type CLIConfig struct {
 	BatchType uint
 
 	// DataAvailabilityType is one of the values defined in op-batcher/flags/flags.go and dictates
-	// the data availability type to use for poting batches, e.g. blobs vs calldata.
+	// the data availability type to use for poting batches, e.g. blobs vs calldata, or auto
+	// to switch between them depending on L1 fees.
 	DataAvailabilityType string
 
+	// ThrottleThreshold is the number of pending batch bytes, not yet added to
+	// a channel, above which the batcher throttles the sequencer's DA usage by
+	// capping the DA size of transactions and blocks in the execution engine.
+	// The cap is lifted once the pending bytes fall back below the threshold.
+	//
+	// If 0, throttling is disabled.
+	ThrottleThreshold uint64
+	// ThrottleTxSize is the DA size limit per transaction, applied while throttling.
+	ThrottleTxSize uint64
+	// ThrottleBlockSize is the DA size limit per block, applied while throttling.
+	ThrottleBlockSize uint64
+
 	TxMgrConfig      txmgr.CLIConfig
 	LogConfig        oplog.CLIConfig
 	MetricsConfig    opmetrics.CLIConfig
 	PprofConfig      oppprof.CLIConfig
+	TracingConfig    tracing.CLIConfig
 	CompressorConfig compressor.CLIConfig
 	RPC              oprpc.CLIConfig
 }
@@ -93,16 +108,25 @@ func (c *CLIConfig) Check() error {
 	}
 	switch c.DataAvailabilityType {
 	case flags.CalldataType:
-	case flags.BlobsType:
+	case flags.BlobsType, flags.AutoType:
+		if c.CompressorConfig.TargetNumFrames > MaxBlobsPerBlobTx {
+			return fmt.Errorf("too many frames for blob transactions, max %d", MaxBlobsPerBlobTx)
+		}
 	default:
 		return fmt.Errorf("unknown data availability type: %v", c.DataAvailabilityType)
 	}
+	if c.ThrottleThreshold > 0 && (c.ThrottleTxSize == 0 || c.ThrottleBlockSize == 0) {
+		return errors.New("throttle tx and block size must be set when throttling is enabled")
+	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return err
 	}
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.TracingConfig.Check(); err != nil {
+		return err
+	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
@@ -129,10 +153,14 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		Stopped:                ctx.Bool(flags.StoppedFlag.Name),
 		BatchType:              ctx.Uint(flags.BatchTypeFlag.Name),
 		DataAvailabilityType:   ctx.String(flags.DataAvailabilityTypeFlag.Name),
+		ThrottleThreshold:      ctx.Uint64(flags.ThrottleThresholdFlag.Name),
+		ThrottleTxSize:         ctx.Uint64(flags.ThrottleTxSizeFlag.Name),
+		ThrottleBlockSize:      ctx.Uint64(flags.ThrottleBlockSizeFlag.Name),
 		TxMgrConfig:            txmgr.ReadCLIConfig(ctx),
 		LogConfig:              oplog.ReadCLIConfig(ctx),
 		MetricsConfig:          opmetrics.ReadCLIConfig(ctx),
 		PprofConfig:            oppprof.ReadCLIConfig(ctx),
+		TracingConfig:          tracing.ReadCLIConfig(ctx),
 		CompressorConfig:       compressor.ReadCLIConfig(ctx),
 		RPC:                    oprpc.ReadCLIConfig(ctx),
 	}
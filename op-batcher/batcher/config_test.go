@@ -87,6 +87,22 @@ func TestBatcherConfig(t *testing.T) {
 			override:  func(c *batcher.CLIConfig) { c.DataAvailabilityType = "foo" },
 			errString: "unknown data availability type: foo",
 		},
+		{
+			name: "too many frames for blob transactions",
+			override: func(c *batcher.CLIConfig) {
+				c.DataAvailabilityType = flags.BlobsType
+				c.CompressorConfig.TargetNumFrames = 7
+			},
+			errString: "too many frames for blob transactions, max 6",
+		},
+		{
+			name: "throttling without size limits",
+			override: func(c *batcher.CLIConfig) {
+				c.ThrottleThreshold = 1_000_000
+				c.ThrottleTxSize = 0
+			},
+			errString: "throttle tx and block size must be set when throttling is enabled",
+		},
 	}
 
 	for _, test := range tests {
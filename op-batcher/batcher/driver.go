@@ -8,6 +8,7 @@ import (
 	"math/big"
 	_ "net/http/pprof"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -45,7 +46,7 @@ type DriverSetup struct {
 	Txmgr            txmgr.TxManager
 	L1Client         L1Client
 	EndpointProvider dial.L2EndpointProvider
-	ChannelConfig    ChannelConfig
+	ChannelConfig    ChannelConfigProvider
 }
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -67,6 +68,11 @@ type BatchSubmitter struct {
 	lastStoredBlock eth.BlockID
 	lastL1Tip       eth.L1BlockRef
 
+	// throttleActive is true while DA size limits are applied in the execution engine
+	throttleActive atomic.Bool
+	// throttleUnsupported is set once the execution engine rejects the DA size limits as an unknown method
+	throttleUnsupported atomic.Bool
+
 	state *channelManager
 }
 
@@ -257,6 +263,8 @@ func (l *BatchSubmitter) loop() {
 
 	receiptsCh := make(chan txmgr.TxReceipt[txData])
 	queue := txmgr.NewQueue[txData](l.killCtx, l.Txmgr, l.Config.MaxPendingTransactions)
+	// don't leave the sequencer throttled once the batcher stops
+	defer l.resetThrottle(l.killCtx)
 
 	for {
 		select {
@@ -272,9 +280,11 @@ func (l *BatchSubmitter) loop() {
 				}
 				l.publishStateToL1(queue, receiptsCh, true)
 				l.state.Clear()
+				l.updateThrottle(l.shutdownCtx)
 				continue
 			}
 			l.publishStateToL1(queue, receiptsCh, false)
+			l.updateThrottle(l.shutdownCtx)
 		case r := <-receiptsCh:
 			l.handleReceipt(r)
 		case <-l.shutdownCtx.Done():
@@ -337,7 +347,7 @@ func (l *BatchSubmitter) publishTxToL1(ctx context.Context, queue *txmgr.Queue[t
 		l.Log.Error("Failed to query L1 tip", "err", err)
 		return err
 	}
-	l.recordL1Tip(l1tip)
+	l.recordL1Tip(ctx, l1tip)
 
 	// Collect next transaction data
 	txdata, err := l.state.TxData(l1tip.ID())
@@ -360,12 +370,11 @@ func (l *BatchSubmitter) publishTxToL1(ctx context.Context, queue *txmgr.Queue[t
 // This is a blocking method. It should not be called concurrently.
 func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) error {
 	// Do the gas estimation offline. A value of 0 will cause the [txmgr] to estimate the gas limit.
-	data := txdata.Bytes()
 
 	var candidate *txmgr.TxCandidate
-	if l.Config.UseBlobs {
+	if txdata.asBlob {
 		var err error
-		if candidate, err = l.blobTxCandidate(data); err != nil {
+		if candidate, err = l.blobTxCandidate(txdata); err != nil {
 			// We could potentially fall through and try a calldata tx instead, but this would
 			// likely result in the chain spending more in gas fees than it is tuned for, so best
 			// to just fail. We do not expect this error to trigger unless there is a serious bug
@@ -373,7 +382,11 @@ func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txDat
 			return fmt.Errorf("could not create blob tx candidate: %w", err)
 		}
 	} else {
-		candidate = l.calldataTxCandidate(data)
+		// sanity check
+		if nf := len(txdata.frames); nf != 1 {
+			l.Log.Crit("unexpected number of frames in calldata tx", "num_frames", nf)
+		}
+		candidate = l.calldataTxCandidate(txdata.CallData())
 	}
 
 	intrinsicGas, err := core.IntrinsicGas(candidate.TxData, nil, false, true, true, false)
@@ -388,14 +401,16 @@ func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txDat
 	return nil
 }
 
-func (l *BatchSubmitter) blobTxCandidate(data []byte) (*txmgr.TxCandidate, error) {
-	var b eth.Blob
-	if err := b.FromData(data); err != nil {
-		return nil, fmt.Errorf("data could not be converted to blob: %w", err)
+// blobTxCandidate creates a blob tx candidate, posting each frame of the tx data in its own blob.
+func (l *BatchSubmitter) blobTxCandidate(data txData) (*txmgr.TxCandidate, error) {
+	blobs, err := data.Blobs()
+	if err != nil {
+		return nil, fmt.Errorf("generating blobs for tx data: %w", err)
 	}
+	l.Log.Debug("building blob transaction candidate", "size", data.Len(), "num_blobs", len(blobs))
 	return &txmgr.TxCandidate{
 		To:    &l.RollupConfig.BatchInboxAddress,
-		Blobs: []*eth.Blob{&b},
+		Blobs: blobs,
 	}, nil
 }
 
@@ -415,12 +430,18 @@ func (l *BatchSubmitter) handleReceipt(r txmgr.TxReceipt[txData]) {
 	}
 }
 
-func (l *BatchSubmitter) recordL1Tip(l1tip eth.L1BlockRef) {
+func (l *BatchSubmitter) recordL1Tip(ctx context.Context, l1tip eth.L1BlockRef) {
 	if l.lastL1Tip == l1tip {
 		return
 	}
 	l.lastL1Tip = l1tip
 	l.Metr.RecordLatestL1Block(l1tip)
+	// L1 fees only change with new L1 blocks. The channel config is updated here,
+	// rather than by the channel manager when it opens a channel, to not query L1
+	// while holding the channel manager lock.
+	if updater, ok := l.ChannelConfig.(ChannelConfigUpdater); ok {
+		updater.UpdateChannelConfig(ctx)
+	}
 }
 
 func (l *BatchSubmitter) recordFailedTx(txd txData, err error) {
@@ -450,7 +471,7 @@ func logFields(xs ...any) (fs []any) {
 	for _, x := range xs {
 		switch v := x.(type) {
 		case txData:
-			fs = append(fs, "frame_id", v.ID(), "data_len", v.Len())
+			fs = append(fs, "tx_id", v.ID(), "data_len", v.Len(), "num_frames", len(v.frames))
 		case *types.Receipt:
 			fs = append(fs, "tx", v.TxHash, "block", eth.ReceiptBlockID(v))
 		case error:
@@ -24,6 +24,7 @@ import (
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -36,8 +37,10 @@ type BatcherConfig struct {
 	PollInterval           time.Duration
 	MaxPendingTransactions uint64
 
-	// UseBlobs is true if the batcher should use blobs instead of calldata for posting blobs
-	UseBlobs bool
+	// DA throttling parameters, see the CLIConfig for details
+	ThrottleThreshold uint64
+	ThrottleTxSize    uint64
+	ThrottleBlockSize uint64
 }
 
 // BatcherService represents a full batch-submitter instance and its resources,
@@ -53,16 +56,18 @@ type BatcherService struct {
 
 	RollupConfig *rollup.Config
 
-	// Channel builder parameters
-	ChannelConfig ChannelConfig
+	// Channel builder parameters. May switch between calldata and blob
+	// parameters if the data availability type is chosen dynamically.
+	ChannelConfig ChannelConfigProvider
 
 	driver *BatchSubmitter
 
 	Version string
 
-	pprofService *oppprof.Service
-	metricsSrv   *httputil.HTTPServer
-	rpcServer    *oprpc.Server
+	pprofService   *oppprof.Service
+	tracingService *tracing.Service
+	metricsSrv     *httputil.HTTPServer
+	rpcServer      *oprpc.Server
 
 	balanceMetricer io.Closer
 	stopped         atomic.Bool
@@ -87,10 +92,16 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	bs.NotSubmittingOnStart = cfg.Stopped
 
 	bs.initMetrics(cfg)
+	if err := bs.initTracing(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
 
 	bs.PollInterval = cfg.PollInterval
 	bs.MaxPendingTransactions = cfg.MaxPendingTransactions
 	bs.NetworkTimeout = cfg.TxMgrConfig.NetworkTimeout
+	bs.ThrottleThreshold = cfg.ThrottleThreshold
+	bs.ThrottleTxSize = cfg.ThrottleTxSize
+	bs.ThrottleBlockSize = cfg.ThrottleBlockSize
 	if err := bs.initRPCClients(ctx, cfg); err != nil {
 		return err
 	}
@@ -176,7 +187,7 @@ func (bs *BatcherService) initRollupConfig(ctx context.Context) error {
 }
 
 func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
-	bs.ChannelConfig = ChannelConfig{
+	cc := ChannelConfig{
 		SeqWindowSize:      bs.RollupConfig.SeqWindowSize,
 		ChannelTimeout:     bs.RollupConfig.ChannelTimeout,
 		MaxChannelDuration: cfg.MaxChannelDuration,
@@ -185,21 +196,41 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 		BatchType:          cfg.BatchType,
 	}
 
+	calldataCC := cc
+	calldataCC.MaxFrameSize = cfg.MaxL1TxSize - 1 // subtract 1 byte for version
+
+	blobCC := cc
+	blobCC.MaxFrameSize = eth.MaxBlobDataSize - 1 // subtract 1 byte for version
+	// frames fill up whole blobs, independent of the calldata target tx size
+	if cfg.DataAvailabilityType != flags.CalldataType && cc.CompressorConfig.TargetFrameSize != blobCC.MaxFrameSize {
+		bs.Log.Info("Overriding target frame size for blob transactions", "target_l1_tx_size_bytes", cfg.CompressorConfig.TargetL1TxSizeBytes, "blob_target_frame_size", blobCC.MaxFrameSize)
+	}
+	blobCC.CompressorConfig.TargetFrameSize = blobCC.MaxFrameSize
+	blobCC.MultiFrameTxs = true
+	blobCC.UseBlobs = true
+
 	switch cfg.DataAvailabilityType {
 	case flags.BlobsType:
-		bs.ChannelConfig.MaxFrameSize = eth.MaxBlobDataSize
-		bs.UseBlobs = true
+		if err := blobCC.Check(); err != nil {
+			return fmt.Errorf("invalid channel configuration: %w", err)
+		}
+		bs.ChannelConfig = blobCC
 	case flags.CalldataType:
-		bs.ChannelConfig.MaxFrameSize = cfg.MaxL1TxSize
-		bs.UseBlobs = false
+		if err := calldataCC.Check(); err != nil {
+			return fmt.Errorf("invalid channel configuration: %w", err)
+		}
+		bs.ChannelConfig = calldataCC
+	case flags.AutoType:
+		if err := blobCC.Check(); err != nil {
+			return fmt.Errorf("invalid blob channel configuration: %w", err)
+		}
+		if err := calldataCC.Check(); err != nil {
+			return fmt.Errorf("invalid calldata channel configuration: %w", err)
+		}
+		bs.ChannelConfig = NewDynamicEthChannelConfig(bs.Log, bs.NetworkTimeout, bs.L1Client, blobCC, calldataCC)
 	default:
 		return fmt.Errorf("unknown data availability type: %v", cfg.DataAvailabilityType)
 	}
-	bs.ChannelConfig.MaxFrameSize-- // subtract 1 byte for version
-
-	if err := bs.ChannelConfig.Check(); err != nil {
-		return fmt.Errorf("invalid channel configuration: %w", err)
-	}
 	return nil
 }
 
@@ -212,6 +243,14 @@ func (bs *BatcherService) initTxManager(cfg *CLIConfig) error {
 	return nil
 }
 
+func (bs *BatcherService) initTracing(ctx context.Context, cfg *CLIConfig) error {
+	bs.tracingService = tracing.New(bs.Log, cfg.TracingConfig, "op-batcher", bs.Version)
+	if err := bs.tracingService.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start tracing service: %w", err)
+	}
+	return nil
+}
+
 func (bs *BatcherService) initPProf(cfg *CLIConfig) error {
 	bs.pprofService = oppprof.New(
 		cfg.PprofConfig.ListenEnabled,
@@ -353,6 +392,12 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 		bs.EndpointProvider.Close()
 	}
 
+	if bs.tracingService != nil {
+		if err := bs.tracingService.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop tracing: %w", err))
+		}
+	}
+
 	if result == nil {
 		bs.stopped.Store(true)
 		bs.Log.Info("Batch Submitter stopped")
@@ -0,0 +1,111 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/rpc"
+)
+
+// SetMaxDASizeMethod is the RPC method of the L2 execution engine to limit the
+// DA size of transactions & blocks the sequencer includes. Limits of 0 lift the caps.
+// It is not available in op-geth releases prior to the DA throttling support.
+const SetMaxDASizeMethod = "miner_setMaxDASize"
+
+// methodNotFoundErrorCode is the JSON-RPC error code of calls to unavailable methods
+const methodNotFoundErrorCode = -32601
+
+// updateThrottle applies the DA size limits in the execution engine if the
+// pending bytes exceed the throttle threshold, and lifts them once the backlog
+// falls back below it. While throttling is active, the limits are re-sent on every
+// update, so that they are restored after a restart of the engine. If the call
+// fails, it is retried on the next update. Throttling is disabled if the engine
+// doesn't support it.
+func (l *BatchSubmitter) updateThrottle(ctx context.Context) {
+	if !l.throttleEnabled() {
+		return
+	}
+
+	pendingBytes := l.state.PendingBytes()
+	active := pendingBytes > l.Config.ThrottleThreshold
+	defer func() { l.Metr.RecordThrottle(l.throttleActive.Load(), pendingBytes) }()
+	changed := active != l.throttleActive.Load()
+	if !changed && !active {
+		return
+	}
+
+	if err := l.setThrottle(ctx, active); isMethodNotFound(err) {
+		l.throttleUnsupported.Store(true)
+		l.Log.Warn("Execution engine does not support DA throttling, disabling it", "method", SetMaxDASizeMethod, "err", err)
+		return
+	} else if err != nil {
+		l.Log.Error("Failed to update DA throttling", "active", active, "pending_bytes", pendingBytes, "err", err)
+		return
+	}
+	if changed {
+		l.Log.Info("Updated DA throttling", "active", active, "pending_bytes", pendingBytes, "threshold", l.Config.ThrottleThreshold)
+	}
+}
+
+// throttleEnabled returns whether a throttle threshold is configured and supported by the execution engine.
+func (l *BatchSubmitter) throttleEnabled() bool {
+	return l.Config.ThrottleThreshold > 0 && !l.throttleUnsupported.Load()
+}
+
+func isMethodNotFound(err error) bool {
+	var rpcErr gethrpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundErrorCode
+}
+
+// resetThrottle lifts any applied DA size limits, e.g. when the batcher stops.
+func (l *BatchSubmitter) resetThrottle(ctx context.Context) {
+	if !l.throttleActive.Load() {
+		return
+	}
+	if err := l.setThrottle(ctx, false); err != nil {
+		l.Log.Error("Failed to lift DA throttling", "err", err)
+		return
+	}
+	l.Metr.RecordThrottle(false, l.state.PendingBytes())
+	l.Log.Info("Lifted DA throttling")
+}
+
+func (l *BatchSubmitter) setThrottle(ctx context.Context, active bool) error {
+	var maxTxSize, maxBlockSize uint64
+	if active {
+		maxTxSize, maxBlockSize = l.Config.ThrottleTxSize, l.Config.ThrottleBlockSize
+	}
+
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	defer cancel()
+	client, err := l.EndpointProvider.EthClient(cCtx)
+	if err != nil {
+		return fmt.Errorf("getting L2 eth client: %w", err)
+	}
+
+	var success bool
+	if err := client.Client().CallContext(cCtx, &success, SetMaxDASizeMethod, hexutil.Uint64(maxTxSize), hexutil.Uint64(maxBlockSize)); err != nil {
+		return fmt.Errorf("calling %s: %w", SetMaxDASizeMethod, err)
+	} else if !success {
+		return fmt.Errorf("%s was not successful", SetMaxDASizeMethod)
+	}
+
+	l.throttleActive.Store(active)
+	return nil
+}
+
+// ThrottleStatus returns the current DA throttling state.
+func (l *BatchSubmitter) ThrottleStatus() rpc.ThrottleStatus {
+	return rpc.ThrottleStatus{
+		Enabled:      l.throttleEnabled(),
+		Active:       l.throttleActive.Load(),
+		PendingBytes: hexutil.Uint64(l.state.PendingBytes()),
+		Threshold:    hexutil.Uint64(l.Config.ThrottleThreshold),
+		MaxTxSize:    hexutil.Uint64(l.Config.ThrottleTxSize),
+		MaxBlockSize: hexutil.Uint64(l.Config.ThrottleBlockSize),
+	}
+}
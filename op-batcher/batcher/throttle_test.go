@@ -0,0 +1,139 @@
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// fakeMinerAPI records the DA size limits set through miner_setMaxDASize
+type fakeMinerAPI struct {
+	calls        int
+	maxTxSize    hexutil.Uint64
+	maxBlockSize hexutil.Uint64
+}
+
+func (api *fakeMinerAPI) SetMaxDASize(maxTxSize hexutil.Uint64, maxBlockSize hexutil.Uint64) bool {
+	api.calls++
+	api.maxTxSize, api.maxBlockSize = maxTxSize, maxBlockSize
+	return true
+}
+
+type fakeEndpointProvider struct {
+	dial.RollupProvider
+	ethClient *ethclient.Client
+}
+
+func (p *fakeEndpointProvider) EthClient(context.Context) (dial.EthClientInterface, error) {
+	return p.ethClient, nil
+}
+
+func throttleTestChannelConfig() ChannelConfig {
+	return ChannelConfig{
+		MaxFrameSize: 120_000,
+		CompressorConfig: compressor.Config{
+			TargetFrameSize:  1,
+			TargetNumFrames:  1,
+			ApproxComprRatio: 1.0,
+		},
+	}
+}
+
+func TestBatchSubmitter_UpdateThrottle(t *testing.T) {
+	minerAPI := new(fakeMinerAPI)
+	server := gethrpc.NewServer()
+	require.NoError(t, server.RegisterName("miner", minerAPI))
+	t.Cleanup(server.Stop)
+
+	log := testlog.Logger(t, log.LvlCrit)
+	bs := NewBatchSubmitter(DriverSetup{
+		Log:  log,
+		Metr: metrics.NoopMetrics,
+		Config: BatcherConfig{
+			NetworkTimeout:    time.Second,
+			ThrottleThreshold: 1000,
+			ThrottleTxSize:    300,
+			ThrottleBlockSize: 21_000,
+		},
+		EndpointProvider: &fakeEndpointProvider{ethClient: ethclient.NewClient(gethrpc.DialInProc(server))},
+		ChannelConfig:    throttleTestChannelConfig(),
+		RollupConfig:     &defaultTestRollupConfig,
+	})
+	bs.state.Clear()
+	ctx := context.Background()
+
+	// no backlog, no throttling
+	bs.updateThrottle(ctx)
+	require.Zero(t, minerAPI.calls)
+	require.False(t, bs.ThrottleStatus().Active)
+
+	// a backlog above the threshold applies the limits
+	block := newMiniL2Block(100)
+	require.NoError(t, bs.state.AddL2Block(block))
+	require.Greater(t, bs.state.PendingBytes(), uint64(1000))
+	bs.updateThrottle(ctx)
+	require.Equal(t, 1, minerAPI.calls)
+	require.EqualValues(t, 300, minerAPI.maxTxSize)
+	require.EqualValues(t, 21_000, minerAPI.maxBlockSize)
+	status := bs.ThrottleStatus()
+	require.True(t, status.Enabled)
+	require.True(t, status.Active)
+	require.EqualValues(t, bs.state.PendingBytes(), status.PendingBytes)
+
+	// the limits are re-sent while throttling is active, in case the engine restarted
+	minerAPI.maxTxSize, minerAPI.maxBlockSize = 0, 0
+	bs.updateThrottle(ctx)
+	require.Equal(t, 2, minerAPI.calls)
+	require.EqualValues(t, 300, minerAPI.maxTxSize)
+	require.EqualValues(t, 21_000, minerAPI.maxBlockSize)
+
+	// clearing the backlog lifts the limits
+	bs.state.Clear()
+	bs.updateThrottle(ctx)
+	require.Equal(t, 3, minerAPI.calls)
+	require.Zero(t, minerAPI.maxTxSize)
+	require.Zero(t, minerAPI.maxBlockSize)
+	require.False(t, bs.ThrottleStatus().Active)
+
+	// the engine isn't called while throttling is inactive
+	bs.updateThrottle(ctx)
+	require.Equal(t, 3, minerAPI.calls)
+}
+
+func TestBatchSubmitter_UpdateThrottleUnsupported(t *testing.T) {
+	// the engine doesn't serve the miner namespace
+	server := gethrpc.NewServer()
+	t.Cleanup(server.Stop)
+
+	log := testlog.Logger(t, log.LvlCrit)
+	bs := NewBatchSubmitter(DriverSetup{
+		Log:  log,
+		Metr: metrics.NoopMetrics,
+		Config: BatcherConfig{
+			NetworkTimeout:    time.Second,
+			ThrottleThreshold: 1000,
+		},
+		EndpointProvider: &fakeEndpointProvider{ethClient: ethclient.NewClient(gethrpc.DialInProc(server))},
+		ChannelConfig:    throttleTestChannelConfig(),
+		RollupConfig:     &defaultTestRollupConfig,
+	})
+	bs.state.Clear()
+	require.True(t, bs.ThrottleStatus().Enabled)
+
+	require.NoError(t, bs.state.AddL2Block(newMiniL2Block(100)))
+	bs.updateThrottle(context.Background())
+	status := bs.ThrottleStatus()
+	require.False(t, status.Enabled, "throttling is disabled")
+	require.False(t, status.Active)
+}
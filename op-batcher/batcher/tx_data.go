@@ -2,57 +2,112 @@ package batcher
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 // txData represents the data for a single transaction.
 //
-// Note: The batcher currently sends exactly one frame per transaction. This
-// might change in the future to allow for multiple frames from possibly
-// different channels.
+// Note: The batcher currently sends frames of a single channel per
+// transaction. Calldata transactions hold exactly one frame, while blob
+// transactions may hold multiple frames, one per blob. Whether the data is
+// sent as calldata or blobs is decided per channel.
 type txData struct {
-	frame frameData
+	frames []frameData
+	asBlob bool // indicates whether this should be sent as blob
 }
 
-// ID returns the id for this transaction data. It can be used as a map key.
+func singleFrameTxData(frame frameData) txData {
+	return txData{frames: []frameData{frame}}
+}
+
+// ID returns the id for this transaction data. Its String() can be used as a map key.
 func (td *txData) ID() txID {
-	return td.frame.id
+	id := make(txID, 0, len(td.frames))
+	for _, f := range td.frames {
+		id = append(id, f.id)
+	}
+	return id
 }
 
-// Bytes returns the transaction data. It's a version byte (0) followed by the
-// concatenated frames for this transaction.
-func (td *txData) Bytes() []byte {
-	return append([]byte{derive.DerivationVersion0}, td.frame.data...)
+// CallData returns the transaction data as calldata.
+// It's a version byte (0) followed by the concatenated frames for this transaction.
+func (td *txData) CallData() []byte {
+	data := make([]byte, 1, 1+td.FramesLen())
+	data[0] = derive.DerivationVersion0
+	for _, f := range td.frames {
+		data = append(data, f.data...)
+	}
+	return data
 }
 
+// Blobs returns the transaction data as blobs, one blob per frame. Each blob
+// is prefixed with a version byte (0).
+func (td *txData) Blobs() ([]*eth.Blob, error) {
+	blobs := make([]*eth.Blob, 0, len(td.frames))
+	for _, f := range td.frames {
+		var blob eth.Blob
+		if err := blob.FromData(append([]byte{derive.DerivationVersion0}, f.data...)); err != nil {
+			return nil, fmt.Errorf("frame %d could not be converted to blob: %w", f.id.frameNumber, err)
+		}
+		blobs = append(blobs, &blob)
+	}
+	return blobs, nil
+}
+
+// Len returns the length of the transaction data as calldata, i.e. the
+// concatenated frames including the version byte.
 func (td *txData) Len() int {
-	return 1 + len(td.frame.data)
+	return 1 + td.FramesLen()
 }
 
-// Frame returns the single frame of this tx data.
-//
-// Note: when the batcher is changed to possibly send multiple frames per tx,
-// this should be changed to a func Frames() []frameData.
-func (td *txData) Frame() frameData {
-	return td.frame
+// FramesLen returns the total length of all frames, excluding any version bytes.
+func (td *txData) FramesLen() (l int) {
+	for _, f := range td.frames {
+		l += len(f.data)
+	}
+	return l
+}
+
+// Frames returns the frames of this tx data.
+func (td *txData) Frames() []frameData {
+	return td.frames
 }
 
 // txID is an opaque identifier for a transaction.
 // It's internal fields should not be inspected after creation & are subject to change.
-// This ID must be trivially comparable & work as a map key.
-//
-// Note: transactions currently only hold a single frame, so it can be
-// identified by the frame. This needs to be changed once the batcher is changed
-// to send multiple frames per tx.
-type txID = frameID
+// Its String() can be used as a map key.
+type txID []frameID
 
 func (id txID) String() string {
-	return fmt.Sprintf("%s:%d", id.chID.String(), id.frameNumber)
+	return id.string(func(id derive.ChannelID) string { return id.String() })
 }
 
 // TerminalString implements log.TerminalStringer, formatting a string for console
 // output during logging.
 func (id txID) TerminalString() string {
-	return fmt.Sprintf("%s:%d", id.chID.TerminalString(), id.frameNumber)
+	return id.string(func(id derive.ChannelID) string { return id.TerminalString() })
+}
+
+// string formats the id as the channel id, followed by the frame numbers of
+// all consecutive frames of that channel, e.g. chID:0+1+2.
+func (id txID) string(chIDStringer func(derive.ChannelID) string) string {
+	var (
+		sb      strings.Builder
+		curChID derive.ChannelID
+	)
+	for i, f := range id {
+		if i > 0 && f.chID == curChID {
+			sb.WriteString(fmt.Sprintf("+%d", f.frameNumber))
+		} else {
+			if i > 0 {
+				sb.WriteString("|")
+			}
+			curChID = f.chID
+			sb.WriteString(fmt.Sprintf("%s:%d", chIDStringer(f.chID), f.frameNumber))
+		}
+	}
+	return sb.String()
 }
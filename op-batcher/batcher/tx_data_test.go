@@ -0,0 +1,66 @@
+package batcher
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxID_String(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		id     txID
+		expStr string
+	}{
+		{
+			desc:   "empty",
+			id:     []frameID{},
+			expStr: "",
+		},
+		{
+			desc: "single",
+			id: []frameID{{
+				chID:        [derive.ChannelIDLength]byte{0x42},
+				frameNumber: 33,
+			}},
+			expStr: "42000000000000000000000000000000:33",
+		},
+		{
+			desc: "multi",
+			id: []frameID{
+				{
+					chID:        [derive.ChannelIDLength]byte{0x42},
+					frameNumber: 33,
+				},
+				{
+					chID:        [derive.ChannelIDLength]byte{0x42},
+					frameNumber: 34,
+				},
+			},
+			expStr: "42000000000000000000000000000000:33+34",
+		},
+		{
+			desc: "multi-channel",
+			id: []frameID{
+				{
+					chID:        [derive.ChannelIDLength]byte{0x42},
+					frameNumber: 33,
+				},
+				{
+					chID:        [derive.ChannelIDLength]byte{0x42},
+					frameNumber: 34,
+				},
+				{
+					chID:        [derive.ChannelIDLength]byte{0x17},
+					frameNumber: 0,
+				},
+			},
+			expStr: "42000000000000000000000000000000:33+34|17000000000000000000000000000000:0",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			require.Equal(t, test.expStr, test.id.String())
+		})
+	}
+}
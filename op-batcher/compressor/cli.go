@@ -18,13 +18,13 @@ func CLIFlags(envPrefix string) []cli.Flag {
 	return []cli.Flag{
 		&cli.Uint64Flag{
 			Name:    TargetL1TxSizeBytesFlagName,
-			Usage:   "The target size of a batch tx submitted to L1.",
+			Usage:   "The target size of a batch tx submitted to L1. Blob txs always fill up whole blobs.",
 			Value:   100_000,
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "TARGET_L1_TX_SIZE_BYTES"),
 		},
 		&cli.IntFlag{
 			Name:    TargetNumFramesFlagName,
-			Usage:   "The target number of frames to create per channel",
+			Usage:   "The target number of frames to create per channel. Controls number of blobs per blob tx, if using Blob DA.",
 			Value:   1,
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "TARGET_NUM_FRAMES"),
 		},
@@ -12,6 +12,7 @@ import (
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -25,6 +26,8 @@ const (
 	// data availability types
 	CalldataType = "calldata"
 	BlobsType    = "blobs"
+	// AutoType dynamically switches between calldata and blobs, depending on current L1 fees
+	AutoType = "auto"
 )
 
 var (
@@ -90,10 +93,29 @@ var (
 	}
 	DataAvailabilityTypeFlag = &cli.StringFlag{
 		Name:    "data-availability-type",
-		Usage:   "The data availability type to use for submitting batches to the L1, e.g. blobs or calldata.",
+		Usage:   "The data availability type to use for submitting batches to the L1. Valid options: " + CalldataType + ", " + BlobsType + ", " + AutoType + " (chooses the cheaper of calldata and blobs for every new channel).",
 		Value:   CalldataType,
 		EnvVars: prefixEnvVars("DATA_AVAILABILITY_TYPE"),
 	}
+	ThrottleThresholdFlag = &cli.Uint64Flag{
+		Name: "throttle-threshold",
+		Usage: "The pending batch bytes, not yet added to a channel, above which the sequencer's " +
+			"DA usage gets throttled through the miner_setMaxDASize RPC of the L2 execution engine, and throttling is disabled if the engine lacks it. 0 to disable.",
+		Value:   0,
+		EnvVars: prefixEnvVars("THROTTLE_THRESHOLD"),
+	}
+	ThrottleTxSizeFlag = &cli.Uint64Flag{
+		Name:    "throttle-tx-size",
+		Usage:   "The DA size limit per transaction, applied while throttling.",
+		Value:   300,
+		EnvVars: prefixEnvVars("THROTTLE_TX_SIZE"),
+	}
+	ThrottleBlockSizeFlag = &cli.Uint64Flag{
+		Name:    "throttle-block-size",
+		Usage:   "The DA size limit per block, applied while throttling.",
+		Value:   21_000,
+		EnvVars: prefixEnvVars("THROTTLE_BLOCK_SIZE"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -114,6 +136,9 @@ var optionalFlags = []cli.Flag{
 	SequencerHDPathFlag,
 	BatchTypeFlag,
 	DataAvailabilityTypeFlag,
+	ThrottleThresholdFlag,
+	ThrottleTxSizeFlag,
+	ThrottleBlockSizeFlag,
 }
 
 func init() {
@@ -121,6 +146,7 @@ func init() {
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, tracing.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, compressor.CLIFlags(EnvVarPrefix)...)
 
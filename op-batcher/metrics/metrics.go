@@ -41,6 +41,9 @@ type Metricer interface {
 	RecordChannelClosed(id derive.ChannelID, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error)
 	RecordChannelFullySubmitted(id derive.ChannelID)
 	RecordChannelTimedOut(id derive.ChannelID)
+	RecordChannelDAType(daType string)
+
+	RecordThrottle(active bool, pendingBytes uint64)
 
 	RecordBatchTxSubmitted()
 	RecordBatchTxSuccess()
@@ -78,6 +81,12 @@ type Metrics struct {
 	channelInputBytesTotal  prometheus.Counter
 	channelOutputBytesTotal prometheus.Counter
 
+	// label by data availability type: calldata, blobs
+	channelDATypeEvs opmetrics.EventVec
+
+	throttleActive       prometheus.Gauge
+	throttlePendingBytes prometheus.Gauge
+
 	batcherTxEvs opmetrics.EventVec
 }
 
@@ -182,6 +191,19 @@ func NewMetrics(procName string) *Metrics {
 			Help:      "Total number of compressed output bytes from a channel.",
 		}),
 
+		channelDATypeEvs: opmetrics.NewEventVec(factory, ns, "", "channel_da_type", "Channel data availability type", []string{"da_type"}),
+
+		throttleActive: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "throttle_active",
+			Help:      "1 if the batcher currently throttles the sequencer's DA usage, 0 otherwise.",
+		}),
+		throttlePendingBytes: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "throttle_pending_bytes",
+			Help:      "Estimated pending batch bytes, as last evaluated for DA throttling.",
+		}),
+
 		batcherTxEvs: opmetrics.NewEventVec(factory, ns, "", "batcher_tx", "BatcherTx", []string{"stage"}),
 	}
 }
@@ -268,13 +290,13 @@ func (m *Metrics) RecordChannelClosed(id derive.ChannelID, numPendingBlocks int,
 }
 
 func (m *Metrics) RecordL2BlockInPendingQueue(block *types.Block) {
-	size := float64(estimateBatchSize(block))
+	size := float64(EstimateBatchSize(block))
 	m.pendingBlocksBytesTotal.Add(size)
 	m.pendingBlocksBytesCurrent.Add(size)
 }
 
 func (m *Metrics) RecordL2BlockInChannel(block *types.Block) {
-	size := float64(estimateBatchSize(block))
+	size := float64(EstimateBatchSize(block))
 	m.pendingBlocksBytesCurrent.Add(-1 * size)
 	// Refer to RecordL2BlocksAdded to see the current + count of bytes added to a channel
 }
@@ -292,6 +314,22 @@ func (m *Metrics) RecordChannelTimedOut(id derive.ChannelID) {
 	m.channelEvs.Record(StageTimedOut)
 }
 
+// RecordChannelDAType records the data availability type chosen for a new channel.
+func (m *Metrics) RecordChannelDAType(daType string) {
+	m.channelDATypeEvs.Record(daType)
+}
+
+// RecordThrottle records whether DA throttling is active, and the pending
+// bytes it was evaluated against.
+func (m *Metrics) RecordThrottle(active bool, pendingBytes uint64) {
+	if active {
+		m.throttleActive.Set(1)
+	} else {
+		m.throttleActive.Set(0)
+	}
+	m.throttlePendingBytes.Set(float64(pendingBytes))
+}
+
 func (m *Metrics) RecordBatchTxSubmitted() {
 	m.batcherTxEvs.Record(TxStageSubmitted)
 }
@@ -304,8 +342,8 @@ func (m *Metrics) RecordBatchTxFailed() {
 	m.batcherTxEvs.Record(TxStageFailed)
 }
 
-// estimateBatchSize estimates the size of the batch
-func estimateBatchSize(block *types.Block) uint64 {
+// EstimateBatchSize estimates the size of the batch of a block, as it is added to a channel
+func EstimateBatchSize(block *types.Block) uint64 {
 	size := uint64(70) // estimated overhead of batch metadata
 	for _, tx := range block.Transactions() {
 		// Don't include deposit transactions in the batch.
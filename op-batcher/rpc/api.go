@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
@@ -13,6 +14,20 @@ import (
 type BatcherDriver interface {
 	StartBatchSubmitting() error
 	StopBatchSubmitting(ctx context.Context) error
+	ThrottleStatus() ThrottleStatus
+}
+
+// ThrottleStatus describes the state of the sequencer's DA throttling by the batcher.
+type ThrottleStatus struct {
+	// Enabled is true if DA throttling is configured
+	Enabled bool `json:"enabled"`
+	// Active is true if the DA size limits are currently applied
+	Active bool `json:"active"`
+	// PendingBytes is the estimated batch size not yet added to a channel
+	PendingBytes hexutil.Uint64 `json:"pendingBytes"`
+	Threshold    hexutil.Uint64 `json:"threshold"`
+	MaxTxSize    hexutil.Uint64 `json:"maxTxSize"`
+	MaxBlockSize hexutil.Uint64 `json:"maxBlockSize"`
 }
 
 type adminAPI struct {
@@ -41,3 +56,7 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.StopBatchSubmitting(ctx)
 }
+
+func (a *adminAPI) ThrottleStatus(_ context.Context) (ThrottleStatus, error) {
+	return a.b.ThrottleStatus(), nil
+}
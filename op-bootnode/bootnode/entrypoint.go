@@ -30,8 +30,8 @@ func (g *gossipNoop) OnUnsafeL2Payload(_ context.Context, _ peer.ID, _ *eth.Exec
 
 type gossipConfig struct{}
 
-func (g *gossipConfig) P2PSequencerAddress() common.Address {
-	return common.Address{}
+func (g *gossipConfig) P2PSequencerAddresses() []common.Address {
+	return nil
 }
 
 type l2Chain struct{}
@@ -40,6 +40,10 @@ func (l *l2Chain) PayloadByNumber(_ context.Context, _ uint64) (*eth.ExecutionPa
 	return nil, nil
 }
 
+func (l *l2Chain) PayloadByHash(_ context.Context, _ common.Hash) (*eth.ExecutionPayload, error) {
+	return nil, nil
+}
+
 func Main(cliCtx *cli.Context) error {
 	log.Info("Initializing bootnode")
 	logCfg := oplog.ReadCLIConfig(cliCtx)
@@ -9,14 +9,20 @@ import (
 
 	"golang.org/x/exp/maps"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/mattn/go-isatty"
 	"github.com/urfave/cli/v2"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/clients"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/upgrades"
 
 	"github.com/ethereum-optimism/superchain-registry/superchain"
@@ -55,6 +61,16 @@ func main() {
 				Usage:   "The file to write the output to. If not specified, output is written to stdout",
 				EnvVars: []string{"OUTFILE"},
 			},
+			&cli.BoolFlag{
+				Name:    "simulate",
+				Usage:   "Simulate the batch against a fork of the L1 state, and check the upgraded contracts before writing the batch",
+				EnvVars: []string{"SIMULATE"},
+			},
+			&cli.StringSliceFlag{
+				Name:    "artifacts",
+				Usage:   "Paths to hardhat artifacts with build info, to resolve Solidity stack traces of failed simulated calls",
+				EnvVars: []string{"ARTIFACTS"},
+			},
 		},
 		Action: entrypoint,
 	}
@@ -105,6 +121,15 @@ func entrypoint(ctx *cli.Context) error {
 		return int(i.ChainID) - int(j.ChainID)
 	})
 
+	var sim *upgrades.Simulation
+	if ctx.Bool("simulate") {
+		sim, err = newSimulation(ctx, client, l1ChainID.Uint64())
+		if err != nil {
+			return err
+		}
+		log.Info("Simulating upgrades against L1 state", "block", sim.Header().Number, "hash", sim.Header().Hash())
+	}
+
 	// Create a batch of transactions
 	batch := safe.Batch{}
 
@@ -195,9 +220,18 @@ func entrypoint(ctx *cli.Context) error {
 		}
 
 		// Build the batch
+		start := len(batch.Transactions)
 		if err := upgrades.L1(&batch, list, *addresses, config, chainConfig, clients.L1Client); err != nil {
 			return err
 		}
+
+		if sim != nil {
+			chainBatch := safe.Batch{Transactions: batch.Transactions[start:]}
+			if err := simulate(ctx, sim, chainBatch, list, addresses, chainConfig, clients.L1Client); err != nil {
+				return fmt.Errorf("simulation of %s upgrade failed: %w", chainConfig.Name, err)
+			}
+			log.Info("Simulated upgrade", "name", chainConfig.Name, "transactions", len(chainBatch.Transactions))
+		}
 	}
 
 	// Write the batch to disk or stdout
@@ -215,6 +249,82 @@ func entrypoint(ctx *cli.Context) error {
 	return nil
 }
 
+// newSimulation forks the L1 state, with the source maps of the artifacts if any.
+func newSimulation(ctx *cli.Context, client *ethclient.Client, l1ChainID uint64) (*upgrades.Simulation, error) {
+	chainConfig, err := toL1ChainConfig(l1ChainID)
+	if err != nil {
+		return nil, err
+	}
+	var srcMaps srcmap.SourceMaps
+	if artifacts := ctx.StringSlice("artifacts"); len(artifacts) > 0 {
+		hh, err := hardhat.New("", artifacts, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read artifacts: %w", err)
+		}
+		contractSrcMaps := srcmap.NewContractSourceMaps()
+		for _, name := range simulatedContracts {
+			if err := contractSrcMaps.AddHardhat(hh, name); err != nil {
+				log.Warn("No source maps for contract", "name", name, "err", err)
+			}
+		}
+		srcMaps = contractSrcMaps
+	}
+	return upgrades.NewSimulation(ctx.Context, client, chainConfig, srcMaps)
+}
+
+// simulate applies the batch of a single chain as the owner of the ProxyAdmin, the Safe,
+// and then checks that every proxy points to the implementation in the superchain registry.
+func simulate(ctx *cli.Context, sim *upgrades.Simulation, batch safe.Batch, list superchain.ImplementationList, addresses *superchain.AddressList, chainConfig *superchain.ChainConfig, l1Client *ethclient.Client) error {
+	proxyAdmin, err := bindings.NewProxyAdminCaller(common.HexToAddress(addresses.ProxyAdmin.String()), l1Client)
+	if err != nil {
+		return err
+	}
+	owner, err := proxyAdmin.Owner(&bind.CallOpts{Context: ctx.Context})
+	if err != nil {
+		return fmt.Errorf("cannot fetch ProxyAdmin owner: %w", err)
+	}
+	log.Info("Simulating batch", "name", chainConfig.Name, "safe", owner)
+	if err := sim.ApplyBatch(owner, &batch); err != nil {
+		return err
+	}
+	if err := upgrades.CheckUpgradedL1(ctx.Context, &list, addresses, chainConfig, sim.Backend()); err != nil {
+		return fmt.Errorf("error checking upgraded L1: %w", err)
+	}
+	return nil
+}
+
+// simulatedContracts are the contracts that run during an upgrade,
+// of which the source maps are loaded to resolve failed simulated calls.
+var simulatedContracts = []string{
+	"Proxy",
+	"ProxyAdmin",
+	"L1ChugSplashProxy",
+	"ResolvedDelegateProxy",
+	"StorageSetter",
+	"SuperchainConfig",
+	"L1CrossDomainMessenger",
+	"L1ERC721Bridge",
+	"L1StandardBridge",
+	"L2OutputOracle",
+	"OptimismMintableERC20Factory",
+	"OptimismPortal",
+	"SystemConfig",
+}
+
+// toL1ChainConfig returns the chain config of a base layer chain id,
+// to simulate calls with the rules of the chain.
+func toL1ChainConfig(chainID uint64) (*params.ChainConfig, error) {
+	switch chainID {
+	case params.MainnetChainConfig.ChainID.Uint64():
+		return params.MainnetChainConfig, nil
+	case params.GoerliChainConfig.ChainID.Uint64():
+		return params.GoerliChainConfig, nil
+	case params.SepoliaChainConfig.ChainID.Uint64():
+		return params.SepoliaChainConfig, nil
+	}
+	return nil, fmt.Errorf("unsupported chain ID %d", chainID)
+}
+
 // toDeployConfigName is a temporary function that maps the chain config names
 // to deploy config names. This should be able to be removed in the future
 // with a canonical naming scheme. If an empty string is returned, then
@@ -7,6 +7,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
@@ -128,7 +129,10 @@ func NewBackendWithGenesisTimestamp(chainID *big.Int, ts uint64, shanghai bool,
 	), nil
 }
 
-func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb Deployer) ([]Deployment, error) {
+// Deploy deploys the contracts with the given constructors, one transaction and block at a time.
+// If a deployment fails, the returned error includes the Solidity stack trace of the failure,
+// resolved with the optional source maps.
+func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb Deployer, srcMaps srcmap.SourceMaps) ([]Deployment, error) {
 	results := make([]Deployment, len(constructors))
 
 	opts, err := bind.NewKeyedTransactorWithChainID(TestKey, ChainID)
@@ -151,7 +155,7 @@ func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb D
 		backend.Commit()
 		addr, err := bind.WaitDeployed(ctx, backend, tx)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", deployment.Name, err)
+			return nil, withRevertTrace(backend, tx, srcMaps, fmt.Errorf("%s: %w", deployment.Name, err))
 		}
 
 		if addr == (common.Address{}) {
@@ -180,6 +184,7 @@ func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb D
 // - backend: A pointer to backends.SimulatedBackend, representing the simulated Ethereum blockchain.
 // Expected to have Arachnid's proxy deployer predeploys at 0x4e59b44847b379578588920cA78FbF26c0B4956C, NewL2BackendWithChainIDAndPredeploys handles this for you.
 // - contractName: A string representing the name of the contract to be deployed.
+// - srcMaps: Optional source maps, to resolve the Solidity stack trace of a failed deployment.
 //
 // Returns:
 // - []byte: The deployed bytecode of the contract.
@@ -187,7 +192,7 @@ func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb D
 //
 // The function logs a fatal error and exits if there are any issues with transaction mining, if the deployment fails,
 // or if the deployed bytecode is not found at the computed address.
-func DeployWithDeterministicDeployer(backend *backends.SimulatedBackend, contractName string) ([]byte, error) {
+func DeployWithDeterministicDeployer(backend *backends.SimulatedBackend, contractName string, srcMaps srcmap.SourceMaps) ([]byte, error) {
 	opts, err := bind.NewKeyedTransactorWithChainID(TestKey, backend.Blockchain().Config().ChainID)
 	if err != nil {
 		return nil, err
@@ -225,7 +230,7 @@ func DeployWithDeterministicDeployer(backend *backends.SimulatedBackend, contrac
 		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
 	}
 	if receipt.Status == 0 {
-		return nil, errors.New("failed to deploy contract using proxy deployer")
+		return nil, withRevertTrace(backend, tx, srcMaps, errors.New("failed to deploy contract using proxy deployer"))
 	}
 
 	address := create2Address(
@@ -1,9 +1,23 @@
 package deployer
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
 )
 
 func TestCreate2Address(t *testing.T) {
@@ -60,3 +74,59 @@ func TestCreate2Address(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployRevertTrace(t *testing.T) {
+	// init code that reverts with Error("boom"), copied from the end of the code
+	typ, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: typ}}.Pack("boom")
+	require.NoError(t, err)
+	reason = append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+	initCode := append([]byte{
+		byte(vm.PUSH1), byte(len(reason)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(reason)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}, reason...)
+
+	source := "contract Boom {\n    constructor() {\n        revert(\"boom\");\n    }\n}\n"
+	srcMap, err := srcmap.ParseSourceMapWithContents([]string{"Boom.sol"}, [][]byte{[]byte(source)}, initCode,
+		fmt.Sprintf("0:10:0;;;;;;%d:14:0", strings.Index(source, "revert")))
+	require.NoError(t, err)
+	srcMaps := srcmap.NewContractSourceMaps()
+	srcMaps.Add("Boom", initCode, srcMap, nil, nil)
+
+	backend, err := NewL1Backend()
+	require.NoError(t, err)
+	deployBoom := func(backend *backends.SimulatedBackend, opts *bind.TransactOpts, _ Constructor) (*types.Transaction, error) {
+		_, tx, _, err := bind.DeployContract(opts, abi.ABI{}, initCode, backend)
+		return tx, err
+	}
+	_, err = Deploy(backend, []Constructor{{Name: "Boom"}}, deployBoom, srcMaps)
+	require.ErrorIs(t, err, bind.ErrNoCodeAfterDeploy)
+	require.Contains(t, err.Error(), "execution reverted: boom")
+	require.Contains(t, err.Error(), "at Boom.constructor")
+	require.Contains(t, err.Error(), "Boom.sol:3:9")
+}
+
+func TestDeployRevertTraceHardhat(t *testing.T) {
+	hh, err := hardhat.New("", []string{"../../op-bindings/hardhat/testdata/artifacts"}, nil)
+	require.NoError(t, err)
+	srcMaps, err := srcmap.LoadHardhatSourceMaps(hh, "HelloWorld")
+	require.NoError(t, err)
+	artifact, err := hh.GetArtifact("HelloWorld")
+	require.NoError(t, err)
+
+	backend, err := NewL1Backend()
+	require.NoError(t, err)
+	// the constructor is not payable, so deploying with value reverts in the constructor
+	deployWithValue := func(backend *backends.SimulatedBackend, opts *bind.TransactOpts, _ Constructor) (*types.Transaction, error) {
+		opts.Value = big.NewInt(1)
+		defer func() { opts.Value = nil }()
+		_, tx, _, err := bind.DeployContract(opts, abi.ABI{}, artifact.Bytecode, backend)
+		return tx, err
+	}
+	_, err = Deploy(backend, []Constructor{{Name: "HelloWorld"}}, deployWithValue, srcMaps)
+	require.ErrorIs(t, err, bind.ErrNoCodeAfterDeploy)
+	require.Contains(t, err.Error(), "at HelloWorld.constructor")
+	// the callvalue check is mapped to the constructor definition
+	require.Contains(t, err.Error(), "contracts/HelloWorld.sol:12:5")
+}
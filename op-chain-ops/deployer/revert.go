@@ -0,0 +1,73 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
+)
+
+// RevertTrace replays a mined transaction of the simulated backend, and returns the Solidity stack trace
+// of the point where it failed, or nil if it did not fail. The source maps are optional.
+func RevertTrace(backend *backends.SimulatedBackend, txHash common.Hash, srcMaps srcmap.SourceMaps) (*srcmap.RevertTrace, error) {
+	receipt, err := backend.TransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt of %s: %w", txHash, err)
+	}
+	chain := backend.Blockchain()
+	block := chain.GetBlockByHash(receipt.BlockHash)
+	if block == nil {
+		return nil, fmt.Errorf("block %s of %s not found", receipt.BlockHash, txHash)
+	}
+	parent := chain.GetHeaderByHash(block.ParentHash())
+	if parent == nil {
+		return nil, fmt.Errorf("parent block %s of %s not found", block.ParentHash(), txHash)
+	}
+	statedb, err := chain.StateAt(parent.Root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state of parent block %s: %w", parent.Hash(), err)
+	}
+
+	// replay the preceding transactions of the block, to get to the pre-state of the transaction
+	cfg := chain.Config()
+	signer := types.MakeSigner(cfg, block.Number(), block.Time())
+	blockCtx := core.NewEVMBlockContext(block.Header(), chain, nil, cfg, statedb)
+	for i, tx := range block.Transactions() {
+		msg, err := core.TransactionToMessage(tx, signer, block.BaseFee())
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert tx %s: %w", tx.Hash(), err)
+		}
+		var tracer *srcmap.RevertTracer
+		vmCfg := vm.Config{}
+		if tx.Hash() == txHash {
+			tracer = srcmap.NewRevertTracer(srcMaps)
+			vmCfg.Tracer = tracer
+		}
+		statedb.SetTxContext(tx.Hash(), i)
+		evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, cfg, vmCfg)
+		if _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.Gas())); err != nil {
+			return nil, fmt.Errorf("failed to replay tx %s: %w", tx.Hash(), err)
+		}
+		if tracer != nil {
+			return tracer.Trace(), nil
+		}
+		statedb.Finalise(cfg.IsEIP158(block.Number()))
+	}
+	return nil, fmt.Errorf("tx %s not found in block %s", txHash, block.Hash())
+}
+
+// withRevertTrace adds the Solidity stack trace of the failed transaction to err.
+// The error is returned as-is if the transaction cannot be traced.
+func withRevertTrace(backend *backends.SimulatedBackend, tx *types.Transaction, srcMaps srcmap.SourceMaps, err error) error {
+	trace, traceErr := RevertTrace(backend, tx.Hash(), srcMaps)
+	if traceErr != nil || trace == nil {
+		return err
+	}
+	return fmt.Errorf("%w\n%s", err, trace)
+}
@@ -13,12 +13,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/deployer"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/immutables"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/state"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
-// BuildL2Genesis will build the L2 genesis block.
-func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block) (*core.Genesis, error) {
+// BuildL2Genesis will build the L2 genesis block. The source maps are optional,
+// and are used to trace the Solidity source of failed predeploy deployments.
+func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block, srcMaps srcmap.SourceMaps) (*core.Genesis, error) {
 	genspec, err := NewL2Genesis(config, l1StartBlock)
 	if err != nil {
 		return nil, err
@@ -49,7 +51,7 @@ func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block) (*core.Gene
 	}
 
 	// Set up the implementations that contain immutables
-	deployResults, err := immutables.Deploy(immutableConfig)
+	deployResults, err := immutables.Deploy(immutableConfig, srcMaps)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +79,7 @@ func BuildL2Genesis(config *DeployConfig, l1StartBlock *types.Block) (*core.Gene
 			if err != nil {
 				return nil, err
 			}
-			deployedBin, err := deployer.DeployWithDeterministicDeployer(backend, name)
+			deployedBin, err := deployer.DeployWithDeterministicDeployer(backend, name, srcMaps)
 			if err != nil {
 				return nil, err
 			}
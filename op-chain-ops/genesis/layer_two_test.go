@@ -40,7 +40,7 @@ func testBuildL2Genesis(t *testing.T, config *genesis.DeployConfig) *core.Genesi
 	block, err := backend.BlockByNumber(context.Background(), common.Big0)
 	require.NoError(t, err)
 
-	gen, err := genesis.BuildL2Genesis(config, block)
+	gen, err := genesis.BuildL2Genesis(config, block, nil)
 	require.Nil(t, err)
 	require.NotNil(t, gen)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/deployer"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
 )
 
 // PredeploysImmutableConfig represents the set of L2 predeploys. It includes all
@@ -133,7 +134,8 @@ type DeploymentResults map[string]hexutil.Bytes
 // for parsing the solc output to find the correct immutable offsets and splicing in the values.
 // Skip any predeploys that do not have immutables as their bytecode will be directly inserted
 // into the state. This does not currently support recursive structs.
-func Deploy(config *PredeploysImmutableConfig) (DeploymentResults, error) {
+// The source maps are optional, and are used to trace the Solidity source of failed deployments.
+func Deploy(config *PredeploysImmutableConfig, srcMaps srcmap.SourceMaps) (DeploymentResults, error) {
 	if err := config.Check(); err != nil {
 		return DeploymentResults{}, err
 	}
@@ -162,7 +164,7 @@ func Deploy(config *PredeploysImmutableConfig) (DeploymentResults, error) {
 		deployments = append(deployments, deployment)
 	}
 
-	results, err := deployContractsWithImmutables(deployments)
+	results, err := deployContractsWithImmutables(deployments, srcMaps)
 	if err != nil {
 		return nil, fmt.Errorf("cannot deploy contracts with immutables: %w", err)
 	}
@@ -172,12 +174,12 @@ func Deploy(config *PredeploysImmutableConfig) (DeploymentResults, error) {
 // deployContractsWithImmutables will deploy contracts to a simulated backend so that their immutables
 // can be properly set. The bytecode returned in the results is suitable to be
 // inserted into the state via state surgery.
-func deployContractsWithImmutables(constructors []deployer.Constructor) (DeploymentResults, error) {
+func deployContractsWithImmutables(constructors []deployer.Constructor, srcMaps srcmap.SourceMaps) (DeploymentResults, error) {
 	backend, err := deployer.NewL2Backend()
 	if err != nil {
 		return nil, err
 	}
-	deployments, err := deployer.Deploy(backend, constructors, l2ImmutableDeployer)
+	deployments, err := deployer.Deploy(backend, constructors, l2ImmutableDeployer, srcMaps)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +98,7 @@ func TestBuildOptimism(t *testing.T) {
 	}
 
 	require.NoError(t, cfg.Check())
-	results, err := immutables.Deploy(&cfg)
+	results, err := immutables.Deploy(&cfg, nil)
 	require.NoError(t, err)
 	require.NotNil(t, results)
 
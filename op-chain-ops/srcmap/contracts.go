@@ -0,0 +1,204 @@
+package srcmap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// SourceMaps resolves the source map of the code that runs in a call frame.
+type SourceMaps interface {
+	// Lookup returns the name and source map of the contract that the code belongs to,
+	// or nil if the code is not known. If create is true, the code is the init code of a contract creation,
+	// including any constructor arguments.
+	Lookup(codeAddr common.Address, code []byte, create bool) (name string, srcMap *SourceMap)
+}
+
+type contractSourceMaps struct {
+	name         string
+	creationCode []byte
+	creation     *SourceMap
+	deployedCode []byte
+	deployed     *SourceMap
+}
+
+// ContractSourceMaps is a collection of source maps of compiled contracts.
+// Deployed code is matched by opcodes, so that code with immutable values still resolves,
+// and creation code is matched by prefix, so that constructor arguments are ignored.
+type ContractSourceMaps struct {
+	contracts []*contractSourceMaps
+	byAddr    map[common.Address]*contractSourceMaps
+}
+
+func NewContractSourceMaps() *ContractSourceMaps {
+	return &ContractSourceMaps{byAddr: make(map[common.Address]*contractSourceMaps)}
+}
+
+// Add registers the creation and deployed code of a contract, with their source maps.
+// Either of the source maps may be nil.
+func (c *ContractSourceMaps) Add(name string, creationCode []byte, creation *SourceMap, deployedCode []byte, deployed *SourceMap) {
+	c.contracts = append(c.contracts, &contractSourceMaps{
+		name:         name,
+		creationCode: creationCode,
+		creation:     creation,
+		deployedCode: deployedCode,
+		deployed:     deployed,
+	})
+}
+
+func (c *ContractSourceMaps) Lookup(codeAddr common.Address, code []byte, create bool) (string, *SourceMap) {
+	if c == nil || len(code) == 0 {
+		return "", nil
+	}
+	if create {
+		for _, contract := range c.contracts {
+			if contract.creation != nil && len(contract.creationCode) > 0 && bytes.HasPrefix(code, contract.creationCode) {
+				return contract.name, contract.creation
+			}
+		}
+		return "", nil
+	}
+	// the same code commonly runs at the same address many times, e.g. behind a proxy
+	if contract, ok := c.byAddr[codeAddr]; ok && codeMatches(contract.deployedCode, code) {
+		return contract.name, contract.deployed
+	}
+	for _, contract := range c.contracts {
+		if contract.deployed != nil && codeMatches(contract.deployedCode, code) {
+			c.byAddr[codeAddr] = contract
+			return contract.name, contract.deployed
+		}
+	}
+	return "", nil
+}
+
+// codeMatches checks if the deployed code matches the compiled code.
+// The compiled code has zeroed placeholders in the place of immutable values,
+// so PUSH data that is zero in the compiled code is not compared.
+func codeMatches(compiled []byte, deployed []byte) bool {
+	if len(compiled) == 0 || len(compiled) != len(deployed) {
+		return false
+	}
+	for pc := 0; pc < len(compiled); {
+		op := vm.OpCode(compiled[pc])
+		if compiled[pc] != deployed[pc] {
+			return false
+		}
+		pc++
+		if op.IsPush() {
+			end := pc + int(op-vm.PUSH1) + 1
+			if end > len(compiled) {
+				end = len(compiled)
+			}
+			data := compiled[pc:end]
+			if !bytes.Equal(data, deployed[pc:end]) && !allZero(data) {
+				return false
+			}
+			pc = end
+		}
+	}
+	return true
+}
+
+func allZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadHardhatSourceMaps loads the source maps of the named contracts from the build info of hardhat artifacts.
+// The sources are read from the compiler input, so the source files do not have to be available.
+// Contracts with unlinked library references are skipped, since their code cannot be matched.
+func LoadHardhatSourceMaps(hh *hardhat.Hardhat, names ...string) (*ContractSourceMaps, error) {
+	out := NewContractSourceMaps()
+	for _, name := range names {
+		if err := out.AddHardhat(hh, name); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// AddHardhat adds the source maps of the named contract, from the build info of hardhat artifacts.
+func (c *ContractSourceMaps) AddHardhat(hh *hardhat.Hardhat, name string) error {
+	buildInfo, err := hh.GetBuildInfo(name)
+	if err != nil {
+		return fmt.Errorf("failed to get build info of %s: %w", name, err)
+	}
+	if err := c.addBuildInfo(buildInfo, hardhat.ParseFullyQualifiedName(name)); err != nil {
+		return fmt.Errorf("failed to load source maps of %s: %w", name, err)
+	}
+	return nil
+}
+
+func (c *ContractSourceMaps) addBuildInfo(buildInfo *hardhat.BuildInfo, fqn hardhat.QualifiedName) error {
+	var contract *solc.CompilerOutputContract
+	for sourceName, contracts := range buildInfo.Output.Contracts {
+		if fqn.SourceName != "" && fqn.SourceName != sourceName {
+			continue
+		}
+		if v, ok := contracts[fqn.ContractName]; ok {
+			contract = &v
+			break
+		}
+	}
+	if contract == nil {
+		return fmt.Errorf("contract %s not found in build info %s", fqn.ContractName, buildInfo.Id)
+	}
+
+	// the source maps refer to the sources by ID
+	sourceNames := make([]string, 0, len(buildInfo.Output.Sources))
+	for sourceName := range buildInfo.Output.Sources {
+		sourceNames = append(sourceNames, sourceName)
+	}
+	sort.Slice(sourceNames, func(i, j int) bool {
+		return buildInfo.Output.Sources[sourceNames[i]].Id < buildInfo.Output.Sources[sourceNames[j]].Id
+	})
+	var sources []string
+	var contents [][]byte
+	for _, sourceName := range sourceNames {
+		id := int(buildInfo.Output.Sources[sourceName].Id)
+		for len(sources) < id { // gaps in the IDs are unavailable sources
+			sources = append(sources, "~unknown")
+			contents = append(contents, nil)
+		}
+		sources = append(sources, sourceName)
+		if input, ok := buildInfo.Input.Sources[sourceName]; ok {
+			contents = append(contents, []byte(input["content"]))
+		} else {
+			contents = append(contents, nil)
+		}
+	}
+
+	parse := func(bytecode solc.CompilerOutputBytecode) ([]byte, *SourceMap, error) {
+		code, err := hex.DecodeString(strings.TrimPrefix(bytecode.Object, "0x"))
+		if err != nil || len(code) == 0 || bytecode.SourceMap == "" {
+			return nil, nil, nil // unlinked or abstract contract, nothing to match against
+		}
+		srcMap, err := ParseSourceMapWithContents(sources, contents, code, bytecode.SourceMap)
+		if err != nil {
+			return nil, nil, err
+		}
+		return code, srcMap, nil
+	}
+	creationCode, creation, err := parse(contract.Evm.Bytecode)
+	if err != nil {
+		return fmt.Errorf("failed to parse creation source map: %w", err)
+	}
+	deployedCode, deployed, err := parse(contract.Evm.DeployedBytecode)
+	if err != nil {
+		return fmt.Errorf("failed to parse deployed source map: %w", err)
+	}
+	c.Add(fqn.ContractName, creationCode, creation, deployedCode, deployed)
+	return nil
+}
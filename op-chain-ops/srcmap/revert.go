@@ -0,0 +1,243 @@
+package srcmap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Frame is a call frame of a Solidity stack trace.
+type Frame struct {
+	Address common.Address
+	Create  bool
+	PC      uint64
+	// Contract is the name of the contract of the code, empty if the code is not known.
+	Contract string
+	// Source, Line and Col are the source position of PC, if the source map is known.
+	Source string
+	Line   uint32
+	Col    uint32
+}
+
+func (f *Frame) String() string {
+	name := f.Contract
+	if name == "" {
+		name = "<unknown>"
+	}
+	if f.Create {
+		name += ".constructor"
+	}
+	if f.Source == "" || f.Source == "unknown" || f.Source == "generated" || strings.HasPrefix(f.Source, "~") {
+		return fmt.Sprintf("%s (%s) pc 0x%x", name, f.Address, f.PC)
+	}
+	return fmt.Sprintf("%s (%s) %s:%d:%d", name, f.Address, f.Source, f.Line, f.Col)
+}
+
+// RevertTrace is the Solidity stack trace of a failed EVM execution.
+type RevertTrace struct {
+	// Err is the error that the execution failed with, e.g. vm.ErrExecutionReverted.
+	Err error
+	// Data is the revert data.
+	Data []byte
+	// Frames holds the call frames at the point of failure, innermost first.
+	Frames []Frame
+}
+
+// Reason decodes the revert reason of Error(string) and Panic(uint256) revert data.
+// Other revert data, e.g. custom errors, is returned as hex.
+func (t *RevertTrace) Reason() string {
+	if len(t.Data) == 0 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(t.Data); err == nil {
+		return reason
+	}
+	return fmt.Sprintf("0x%x", t.Data)
+}
+
+func (t *RevertTrace) String() string {
+	var out strings.Builder
+	if t.Err != nil {
+		out.WriteString(t.Err.Error())
+	} else {
+		out.WriteString("execution failed")
+	}
+	if reason := t.Reason(); reason != "" {
+		out.WriteString(": ")
+		out.WriteString(reason)
+	}
+	out.WriteString(t.Stack())
+	return out.String()
+}
+
+// Stack formats the frames, one indented line per frame, each starting with a newline.
+func (t *RevertTrace) Stack() string {
+	var out strings.Builder
+	for _, f := range t.Frames {
+		out.WriteString("\n\tat ")
+		out.WriteString(f.String())
+	}
+	return out.String()
+}
+
+type revertFrame struct {
+	addr   common.Address
+	code   []byte
+	create bool
+	pc     uint64
+}
+
+type revertSnapshot struct {
+	err    error
+	data   []byte
+	frames []revertFrame
+}
+
+// RevertTracer is a vm.EVMLogger that captures the Solidity stack trace of the point where an execution failed.
+//
+// Solidity bubbles up reverts of sub-calls with the same revert data, so the innermost frame with
+// the revert data is kept, rather than the frame that the revert reached the top-level in.
+// A failure in a sub-call that is handled by the caller, e.g. with try/catch, is forgotten.
+type RevertTracer struct {
+	srcMaps SourceMaps
+
+	env    *vm.EVM
+	frames []revertFrame
+	// snapshot is the call stack of the last unhandled failure, nil if there is none
+	snapshot *revertSnapshot
+	err      error
+}
+
+// NewRevertTracer creates a RevertTracer. The source maps are optional:
+// without them, frames are identified by address and program counter.
+func NewRevertTracer(srcMaps SourceMaps) *RevertTracer {
+	return &RevertTracer{srcMaps: srcMaps}
+}
+
+func (r *RevertTracer) CaptureTxStart(gasLimit uint64) {}
+
+func (r *RevertTracer) CaptureTxEnd(restGas uint64) {}
+
+func (r *RevertTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	r.env = env
+	r.frames = r.frames[:0]
+	r.snapshot = nil
+	r.err = nil
+	r.enter(to, create, input)
+}
+
+func (r *RevertTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	r.exit(err)
+	r.err = err
+}
+
+func (r *RevertTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	r.enter(to, typ == vm.CREATE || typ == vm.CREATE2, input)
+}
+
+func (r *RevertTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	r.exit(err)
+}
+
+func (r *RevertTracer) enter(to common.Address, create bool, input []byte) {
+	code := input
+	if !create {
+		// for delegate-calls and call-codes, to is the address of the code
+		code = r.env.StateDB.GetCode(to)
+	}
+	r.frames = append(r.frames, revertFrame{addr: to, code: code, create: create})
+}
+
+func (r *RevertTracer) exit(err error) {
+	if len(r.frames) == 0 {
+		return
+	}
+	r.frames = r.frames[:len(r.frames)-1]
+	// a frame that exits successfully handled any failure of its sub-calls
+	if err == nil && r.snapshot != nil && len(r.snapshot.frames) > len(r.frames) {
+		r.snapshot = nil
+	}
+}
+
+func (r *RevertTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if len(r.frames) == 0 {
+		return
+	}
+	r.frames[len(r.frames)-1].pc = pc
+	if err != nil { // faults before the opcode executes are not reported with CaptureFault
+		r.fail(err, nil)
+		return
+	}
+	if op != vm.REVERT {
+		return
+	}
+	stack := scope.Stack.Data()
+	if len(stack) < 2 {
+		return
+	}
+	offset, size := stack[len(stack)-1], stack[len(stack)-2]
+	var data []byte
+	if offset.IsUint64() && size.IsUint64() && offset.Uint64()+size.Uint64() <= uint64(scope.Memory.Len()) {
+		data = scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
+	}
+	r.fail(vm.ErrExecutionReverted, data)
+}
+
+func (r *RevertTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if len(r.frames) == 0 {
+		return
+	}
+	if errors.Is(err, vm.ErrExecutionReverted) { // already captured, with the revert data, in CaptureState
+		return
+	}
+	r.frames[len(r.frames)-1].pc = pc
+	r.fail(err, nil)
+}
+
+func (r *RevertTracer) fail(err error, data []byte) {
+	// keep the deeper failure if this failure just bubbles it up
+	if s := r.snapshot; s != nil && len(s.frames) > len(r.frames) && bytes.Equal(s.data, data) {
+		return
+	}
+	r.snapshot = &revertSnapshot{
+		err:    err,
+		data:   data,
+		frames: append([]revertFrame(nil), r.frames...),
+	}
+}
+
+// Trace returns the stack trace of the failure of the last execution, or nil if it did not fail.
+func (r *RevertTracer) Trace() *RevertTrace {
+	if r.err == nil {
+		return nil
+	}
+	out := &RevertTrace{Err: r.err}
+	if r.snapshot == nil { // e.g. failed before any code ran
+		return out
+	}
+	if r.snapshot.err != nil {
+		out.Err = r.snapshot.err
+	}
+	out.Data = r.snapshot.data
+	for i := len(r.snapshot.frames) - 1; i >= 0; i-- {
+		f := r.snapshot.frames[i]
+		frame := Frame{Address: f.addr, Create: f.create, PC: f.pc}
+		if r.srcMaps != nil {
+			name, srcMap := r.srcMaps.Lookup(f.addr, f.code, f.create)
+			frame.Contract = name
+			if srcMap != nil {
+				frame.Source, frame.Line, frame.Col = srcMap.Info(f.pc)
+			}
+		}
+		out.Frames = append(out.Frames, frame)
+	}
+	return out
+}
+
+var _ vm.EVMLogger = (*RevertTracer)(nil)
@@ -0,0 +1,142 @@
+package srcmap
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const bSource = `contract B {
+    function f() external {
+        revert("boom");
+    }
+}
+`
+
+const aSource = `contract A {
+    function g(B b) external {
+        // bubbles up the revert
+        b.f();
+    }
+}
+`
+
+var (
+	aAddr = common.HexToAddress("0xaaaa")
+	bAddr = common.HexToAddress("0xbbbb")
+	// cAddr calls B, and handles the revert
+	cAddr = common.HexToAddress("0xcccc")
+)
+
+func errorData(t *testing.T, reason string) []byte {
+	typ, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	data, err := abi.Arguments{{Type: typ}}.Pack(reason)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], data...)
+}
+
+// bCode reverts with Error("boom"), copied from the end of the code
+func bCode(t *testing.T) []byte {
+	data := errorData(t, "boom")
+	code := []byte{
+		byte(vm.PUSH1), byte(len(data)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(data)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}
+	return append(code, data...)
+}
+
+// aCode calls the address and bubbles up the revert data
+func aCode(target common.Address) []byte {
+	code := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH20)}
+	code = append(code, target.Bytes()...)
+	return append(code,
+		byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT))
+}
+
+// catchCode calls the address and stops, ignoring the result
+func catchCode(target common.Address) []byte {
+	code := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH20)}
+	code = append(code, target.Bytes()...)
+	return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.STOP))
+}
+
+func testSourceMaps(t *testing.T) *ContractSourceMaps {
+	// the REVERT of B, and the CALL of A, are the last source-mapped instructions
+	bMap, err := ParseSourceMapWithContents([]string{"B.sol"}, [][]byte{[]byte(bSource)}, bCode(t),
+		fmt.Sprintf("0:10:0;;;;;;%d:14:0", strings.Index(bSource, `revert("boom")`)))
+	require.NoError(t, err)
+	compiledA := aCode(common.Address{}) // the address is an immutable, zeroed in the compiled code
+	aMap, err := ParseSourceMapWithContents([]string{"A.sol"}, [][]byte{[]byte(aSource)}, compiledA,
+		fmt.Sprintf("0:10:0;;;;;;;%d:5:0", strings.Index(aSource, "b.f()")))
+	require.NoError(t, err)
+	srcMaps := NewContractSourceMaps()
+	srcMaps.Add("B", nil, nil, bCode(t), bMap)
+	srcMaps.Add("A", nil, nil, compiledA, aMap)
+	return srcMaps
+}
+
+func runCall(t *testing.T, tracer *RevertTracer, to common.Address) error {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	statedb.SetCode(aAddr, aCode(bAddr))
+	statedb.SetCode(bAddr, bCode(t))
+	statedb.SetCode(cAddr, catchCode(bAddr))
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: big.NewInt(1),
+		GasLimit:    30_000_000,
+		Difficulty:  big.NewInt(0),
+	}
+	env := vm.NewEVM(blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, statedb, params.TestChainConfig, vm.Config{Tracer: tracer})
+	_, _, err = env.Call(vm.AccountRef(common.HexToAddress("0x1234")), to, nil, 1_000_000, big.NewInt(0))
+	return err
+}
+
+func TestRevertTracer(t *testing.T) {
+	t.Run("bubbled", func(t *testing.T) {
+		tracer := NewRevertTracer(testSourceMaps(t))
+		require.ErrorIs(t, runCall(t, tracer, aAddr), vm.ErrExecutionReverted)
+		trace := tracer.Trace()
+		require.NotNil(t, trace)
+		require.Equal(t, "boom", trace.Reason())
+		require.Len(t, trace.Frames, 2, "innermost revert is kept when bubbled up")
+		require.Equal(t, "B", trace.Frames[0].Contract)
+		require.Equal(t, bAddr, trace.Frames[0].Address)
+		require.Equal(t, "B.sol", trace.Frames[0].Source)
+		require.Equal(t, uint32(3), trace.Frames[0].Line)
+		require.Equal(t, "A", trace.Frames[1].Contract, "code with immutables matches")
+		require.Equal(t, uint32(4), trace.Frames[1].Line)
+		require.Equal(t, fmt.Sprintf("execution reverted: boom\n\tat B (%s) B.sol:3:9\n\tat A (%s) A.sol:4:9", bAddr, aAddr),
+			trace.String())
+	})
+	t.Run("caught", func(t *testing.T) {
+		tracer := NewRevertTracer(testSourceMaps(t))
+		require.NoError(t, runCall(t, tracer, cAddr))
+		require.Nil(t, tracer.Trace(), "handled revert is not a failure")
+	})
+	t.Run("no source maps", func(t *testing.T) {
+		tracer := NewRevertTracer(nil)
+		require.Error(t, runCall(t, tracer, aAddr))
+		trace := tracer.Trace()
+		require.Len(t, trace.Frames, 2)
+		require.Equal(t, fmt.Sprintf("<unknown> (%s) pc 0xb", bAddr), trace.Frames[0].String())
+	})
+}
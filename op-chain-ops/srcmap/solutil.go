@@ -80,6 +80,9 @@ type SourceMap struct {
 }
 
 func (s *SourceMap) Info(pc uint64) (source string, line uint32, col uint32) {
+	if pc >= uint64(len(s.Instr)) { // e.g. constructor arguments appended to the init code
+		return "unknown", 0, 0
+	}
 	instr := s.Instr[pc]
 	if instr.F < 0 {
 		return "generated", 0, 0
@@ -117,6 +120,27 @@ func (s *SourceMap) FormattedInfo(pc uint64) string {
 // The sources are as referenced in the source-map by index.
 // Not all sources are necessary, some indices may be unknown.
 func ParseSourceMap(sources []string, bytecode []byte, sourceMap string) (*SourceMap, error) {
+	contents := make([][]byte, len(sources))
+	for i, s := range sources {
+		if strings.HasPrefix(s, "~") {
+			continue
+		}
+		dat, err := os.ReadFile(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read source %d %q: %w", i, s, err)
+		}
+		contents[i] = dat
+	}
+	return ParseSourceMapWithContents(sources, contents, bytecode, sourceMap)
+}
+
+// ParseSourceMapWithContents parses a solidity sourcemap, like ParseSourceMap,
+// but with the contents of the sources provided by the caller, e.g. from the compiler input.
+// Sources with nil contents are treated as unavailable.
+func ParseSourceMapWithContents(sources []string, contents [][]byte, bytecode []byte, sourceMap string) (*SourceMap, error) {
+	if len(contents) != len(sources) {
+		return nil, fmt.Errorf("got contents of %d sources, but %d sources", len(contents), len(sources))
+	}
 	instructions := strings.Split(sourceMap, ";")
 
 	srcMap := &SourceMap{
@@ -125,15 +149,11 @@ func ParseSourceMap(sources []string, bytecode []byte, sourceMap string) (*Sourc
 		Instr:   make([]InstrMapping, 0, len(bytecode)),
 	}
 	// map source code position byte offsets to line/column pairs
-	for i, s := range sources {
-		if strings.HasPrefix(s, "~") {
+	for _, dat := range contents {
+		if dat == nil {
 			srcMap.PosData = append(srcMap.PosData, nil)
 			continue
 		}
-		dat, err := os.ReadFile(s)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read source %d %q: %w", i, s, err)
-		}
 		datStr := string(dat)
 
 		out := make([]LineCol, len(datStr))
@@ -173,6 +193,8 @@ func ParseSourceMap(sources []string, bytecode []byte, sourceMap string) (*Sourc
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse instr element in source map: %w", err)
 		}
+		// empty fields of the next element inherit the values of this element
+		lastInstr = m
 
 		for j := 0; j < instLen; j++ {
 			srcMap.Instr = append(srcMap.Instr, m)
@@ -34,3 +34,15 @@ func TestSourcemap(t *testing.T) {
 		}
 	}
 }
+
+func TestSourcemapCompressed(t *testing.T) {
+	source := []byte("contract A {\n    function f() {}\n}\n")
+	// STOP, PUSH1 0, STOP: empty fields inherit the values of the previous element
+	code := []byte{0x00, 0x60, 0x00, 0x00}
+	srcMap, err := ParseSourceMapWithContents([]string{"A.sol"}, [][]byte{source}, code, "17:15:0;;0:35")
+	require.NoError(t, err)
+	require.Equal(t, "A.sol:2:5", srcMap.FormattedInfo(0))
+	require.Equal(t, "A.sol:2:5", srcMap.FormattedInfo(1))
+	require.Equal(t, "A.sol:2:5", srcMap.FormattedInfo(2))
+	require.Equal(t, "A.sol:1:0", srcMap.FormattedInfo(3))
+}
@@ -0,0 +1,197 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/trie/trienode"
+)
+
+var errForkReadOnly = errors.New("forked state cannot be committed")
+
+// ForkClient is the L1 client that the state of a simulation is forked from.
+type ForkClient interface {
+	ethereum.ChainStateReader
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// forkDB is a state.Database that reads the accounts, storage and code of a block
+// from a ForkClient, on demand. Changes to the state stay in the state.StateDB on top of it,
+// and cannot be committed.
+type forkDB struct {
+	ctx    context.Context
+	client ForkClient
+	block  *big.Int
+
+	codeLock sync.Mutex
+	code     map[common.Hash][]byte
+
+	diskDB ethdb.Database
+	trieDB *trie.Database
+}
+
+var _ state.Database = (*forkDB)(nil)
+
+func newForkDB(ctx context.Context, client ForkClient, block *big.Int) *forkDB {
+	diskDB := rawdb.NewMemoryDatabase()
+	return &forkDB{
+		ctx:    ctx,
+		client: client,
+		block:  block,
+		code:   make(map[common.Hash][]byte),
+		diskDB: diskDB,
+		trieDB: trie.NewDatabase(diskDB, nil),
+	}
+}
+
+func (db *forkDB) OpenTrie(root common.Hash) (state.Trie, error) {
+	return &forkTrie{db: db}, nil
+}
+
+func (db *forkDB) OpenStorageTrie(stateRoot common.Hash, address common.Address, root common.Hash) (state.Trie, error) {
+	// accounts that are created during the simulation have no storage to fetch
+	return &forkTrie{db: db, empty: root == types.EmptyRootHash}, nil
+}
+
+func (db *forkDB) CopyTrie(t state.Trie) state.Trie {
+	return t // tries do not hold any changes
+}
+
+func (db *forkDB) ContractCode(addr common.Address, codeHash common.Hash) ([]byte, error) {
+	db.codeLock.Lock()
+	code, ok := db.code[codeHash]
+	db.codeLock.Unlock()
+	if ok {
+		return code, nil
+	}
+	code, err := db.client.CodeAt(db.ctx, addr, db.block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch code of %s: %w", addr, err)
+	}
+	if got := crypto.Keccak256Hash(code); got != codeHash {
+		return nil, fmt.Errorf("code of %s has hash %s, expected %s", addr, got, codeHash)
+	}
+	db.codeLock.Lock()
+	db.code[codeHash] = code
+	db.codeLock.Unlock()
+	return code, nil
+}
+
+func (db *forkDB) ContractCodeSize(addr common.Address, codeHash common.Hash) (int, error) {
+	code, err := db.ContractCode(addr, codeHash)
+	return len(code), err
+}
+
+func (db *forkDB) DiskDB() ethdb.KeyValueStore {
+	return db.diskDB
+}
+
+func (db *forkDB) TrieDB() *trie.Database {
+	return db.trieDB
+}
+
+// forkTrie serves the account trie, or a storage trie, of a forkDB.
+// Updates are ignored, since the state.StateDB keeps track of all changes.
+type forkTrie struct {
+	db *forkDB
+	// empty is true for the storage trie of an account without storage
+	empty bool
+}
+
+var _ state.Trie = (*forkTrie)(nil)
+
+func (t *forkTrie) GetKey([]byte) []byte {
+	return nil
+}
+
+func (t *forkTrie) GetStorage(addr common.Address, key []byte) ([]byte, error) {
+	if t.empty {
+		return nil, nil
+	}
+	value, err := t.db.client.StorageAt(t.db.ctx, addr, common.BytesToHash(key), t.db.block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch storage %x of %s: %w", key, addr, err)
+	}
+	return value, nil
+}
+
+func (t *forkTrie) GetAccount(address common.Address) (*types.StateAccount, error) {
+	ctx := t.db.ctx
+	balance, err := t.db.client.BalanceAt(ctx, address, t.db.block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch balance of %s: %w", address, err)
+	}
+	nonce, err := t.db.client.NonceAt(ctx, address, t.db.block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nonce of %s: %w", address, err)
+	}
+	code, err := t.db.client.CodeAt(ctx, address, t.db.block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch code of %s: %w", address, err)
+	}
+	if balance.Sign() == 0 && nonce == 0 && len(code) == 0 {
+		return nil, nil
+	}
+	codeHash := types.EmptyCodeHash
+	if len(code) > 0 {
+		codeHash = crypto.Keccak256Hash(code)
+		t.db.codeLock.Lock()
+		t.db.code[codeHash] = code
+		t.db.codeLock.Unlock()
+	}
+	return &types.StateAccount{
+		Nonce:   nonce,
+		Balance: balance,
+		// The storage root is not known without a proof, and is not used by the EVM.
+		// It must not be the empty root, to read the storage of the account from the client.
+		Root:     common.Hash{1},
+		CodeHash: codeHash.Bytes(),
+	}, nil
+}
+
+func (t *forkTrie) UpdateStorage(addr common.Address, key, value []byte) error {
+	return nil
+}
+
+func (t *forkTrie) UpdateAccount(address common.Address, account *types.StateAccount) error {
+	return nil
+}
+
+func (t *forkTrie) UpdateContractCode(address common.Address, codeHash common.Hash, code []byte) error {
+	return nil
+}
+
+func (t *forkTrie) DeleteStorage(addr common.Address, key []byte) error {
+	return nil
+}
+
+func (t *forkTrie) DeleteAccount(address common.Address) error {
+	return nil
+}
+
+func (t *forkTrie) Hash() common.Hash {
+	return common.Hash{}
+}
+
+func (t *forkTrie) Commit(collectLeaf bool) (common.Hash, *trienode.NodeSet, error) {
+	return common.Hash{}, nil, errForkReadOnly
+}
+
+func (t *forkTrie) NodeIterator(startKey []byte) (trie.NodeIterator, error) {
+	return nil, errForkReadOnly
+}
+
+func (t *forkTrie) Prove(key []byte, proofDb ethdb.KeyValueWriter) error {
+	return errForkReadOnly
+}
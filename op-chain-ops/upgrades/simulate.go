@@ -0,0 +1,298 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
+
+	"github.com/ethereum-optimism/superchain-registry/superchain"
+)
+
+// simulationGasLimit is the gas limit of each call of a simulation.
+const simulationGasLimit = 30_000_000
+
+var errSimulationUnsupported = errors.New("not supported by the simulation backend")
+
+// Simulation applies Safe batches to an in-memory fork of the L1 state.
+// The state is forked at the L1 head at the time of creation, and is fetched from L1 as it is accessed.
+// Nothing is ever sent to L1.
+type Simulation struct {
+	config   *params.ChainConfig
+	header   *types.Header
+	state    *state.StateDB
+	blockCtx vm.BlockContext
+	srcMaps  srcmap.SourceMaps
+}
+
+// NewSimulation forks the state of the latest L1 block.
+// The source maps are optional, and are used to resolve the Solidity stack trace of failed calls.
+func NewSimulation(ctx context.Context, client ForkClient, config *params.ChainConfig, srcMaps srcmap.SourceMaps) (*Simulation, error) {
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	statedb, err := state.New(header.Root, newForkDB(ctx, client, header.Number), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fork state of block %s: %w", header.Number, err)
+	}
+	// Calls run on top of the head block, like eth_call does
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash: func(n uint64) common.Hash {
+			h, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+			if err != nil {
+				return common.Hash{}
+			}
+			return h.Hash()
+		},
+		Coinbase:    header.Coinbase,
+		GasLimit:    header.GasLimit,
+		BlockNumber: new(big.Int).Set(header.Number),
+		Time:        header.Time,
+		Difficulty:  new(big.Int).Set(header.Difficulty),
+		BaseFee:     header.BaseFee,
+	}
+	if header.Difficulty.Sign() == 0 {
+		random := header.MixDigest
+		blockCtx.Random = &random
+	}
+	if header.ExcessBlobGas != nil {
+		blockCtx.BlobBaseFee = eip4844.CalcBlobFee(*header.ExcessBlobGas)
+	}
+	return &Simulation{
+		config:   config,
+		header:   header,
+		state:    statedb,
+		blockCtx: blockCtx,
+		srcMaps:  srcMaps,
+	}, nil
+}
+
+// Header returns the L1 block that the state was forked at.
+func (s *Simulation) Header() *types.Header {
+	return s.header
+}
+
+// ApplyBatch runs each transaction of the batch, in order, as a call from the Safe.
+// The signatures of the Safe owners are not needed: the calls are made as the Safe itself.
+// If a transaction fails, the error includes the Solidity stack trace of the failure,
+// and the state changes of the failed transaction are discarded.
+func (s *Simulation) ApplyBatch(safeAddr common.Address, batch *safe.Batch) error {
+	for i, bt := range batch.Transactions {
+		to := bt.To
+		value := bt.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		_, trace, err := s.call(safeAddr, &to, value, bt.Data, true)
+		if err != nil {
+			var stack string
+			if trace != nil {
+				stack = trace.Stack()
+			}
+			return fmt.Errorf("batch transaction %d to %s (%s) failed: %w%s", i, bt.To, bt.Signature(), err, stack)
+		}
+	}
+	return nil
+}
+
+// call runs a call on top of the current state. The state changes are kept if the call succeeds and commit is true.
+// The stack trace is returned if the call fails.
+func (s *Simulation) call(from common.Address, to *common.Address, value *big.Int, data []byte, commit bool) ([]byte, *srcmap.RevertTrace, error) {
+	msg := &core.Message{
+		From:              from,
+		To:                to,
+		Value:             value,
+		GasLimit:          simulationGasLimit,
+		GasPrice:          new(big.Int),
+		GasFeeCap:         new(big.Int),
+		GasTipCap:         new(big.Int),
+		Data:              data,
+		SkipAccountChecks: true, // the Safe is a contract, and is impersonated
+	}
+	tracer := srcmap.NewRevertTracer(s.srcMaps)
+	snapshot := s.state.Snapshot()
+	evm := vm.NewEVM(s.blockCtx, core.NewEVMTxContext(msg), s.state, s.config, vm.Config{Tracer: tracer, NoBaseFee: true})
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err == nil {
+		err = s.state.Error()
+	}
+	if err != nil || result.Err != nil || !commit {
+		s.state.RevertToSnapshot(snapshot)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if result.Err != nil {
+		return nil, tracer.Trace(), newRevertError(result)
+	}
+	if commit {
+		s.state.Finalise(true)
+	}
+	return result.Return(), nil, nil
+}
+
+// newRevertError includes the revert reason, if any, like an L1 RPC would.
+func newRevertError(result *core.ExecutionResult) error {
+	if reason, err := abi.UnpackRevert(result.Revert()); err == nil {
+		return fmt.Errorf("%w: %s", result.Err, reason)
+	}
+	if len(result.Revert()) > 0 {
+		return fmt.Errorf("%w: 0x%x", result.Err, result.Revert())
+	}
+	return result.Err
+}
+
+// Backend returns a read-only contract backend of the simulated state,
+// to inspect the state with the regular contract bindings.
+func (s *Simulation) Backend() bind.ContractBackend {
+	return &simulationBackend{s}
+}
+
+// simulationBackend serves calls on the state of a Simulation.
+// Calls do not change the state.
+type simulationBackend struct {
+	sim *Simulation
+}
+
+func (b *simulationBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if err := b.checkBlock(blockNumber); err != nil {
+		return nil, err
+	}
+	return b.sim.state.GetCode(contract), b.sim.state.Error()
+}
+
+func (b *simulationBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if err := b.checkBlock(blockNumber); err != nil {
+		return nil, err
+	}
+	value := call.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	out, _, err := b.sim.call(call.From, call.To, value, call.Data, false)
+	return out, err
+}
+
+func (b *simulationBackend) checkBlock(blockNumber *big.Int) error {
+	if blockNumber != nil && blockNumber.Cmp(b.sim.header.Number) != 0 {
+		return fmt.Errorf("only the simulated state of block %s is available, not %s", b.sim.header.Number, blockNumber)
+	}
+	return nil
+}
+
+func (b *simulationBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if err := b.checkBlock(number); err != nil {
+		return nil, err
+	}
+	return b.sim.header, nil
+}
+
+func (b *simulationBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return b.CodeAt(ctx, account, nil)
+}
+
+func (b *simulationBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return b.sim.state.GetNonce(account), b.sim.state.Error()
+}
+
+func (b *simulationBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return nil, errSimulationUnsupported
+}
+
+func (b *simulationBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return nil, errSimulationUnsupported
+}
+
+func (b *simulationBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 0, errSimulationUnsupported
+}
+
+func (b *simulationBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return errSimulationUnsupported
+}
+
+func (b *simulationBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errSimulationUnsupported
+}
+
+func (b *simulationBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errSimulationUnsupported
+}
+
+// CheckUpgradedL1 checks the L1 contracts after an upgrade: every proxy must point to the implementation
+// in the superchain registry, and report the version of that implementation.
+func CheckUpgradedL1(ctx context.Context, list *superchain.ImplementationList, addresses *superchain.AddressList, chainConfig *superchain.ChainConfig, backend bind.ContractBackend) error {
+	if err := CheckL1(ctx, list, backend); err != nil {
+		return err
+	}
+
+	proxyAdmin, err := bindings.NewProxyAdminCaller(common.HexToAddress(addresses.ProxyAdmin.String()), backend)
+	if err != nil {
+		return err
+	}
+	proxies := []struct {
+		name     string
+		proxy    superchain.Address
+		expected superchain.VersionedContract
+	}{
+		{"L1CrossDomainMessenger", addresses.L1CrossDomainMessengerProxy, list.L1CrossDomainMessenger},
+		{"L1ERC721Bridge", addresses.L1ERC721BridgeProxy, list.L1ERC721Bridge},
+		{"L1StandardBridge", addresses.L1StandardBridgeProxy, list.L1StandardBridge},
+		{"L2OutputOracle", addresses.L2OutputOracleProxy, list.L2OutputOracle},
+		{"OptimismMintableERC20Factory", addresses.OptimismMintableERC20FactoryProxy, list.OptimismMintableERC20Factory},
+		{"OptimismPortal", addresses.OptimismPortalProxy, list.OptimismPortal},
+		{"SystemConfig", chainConfig.SystemConfigAddr, list.SystemConfig},
+	}
+	for _, p := range proxies {
+		proxy := common.HexToAddress(p.proxy.String())
+		impl, err := proxyAdmin.GetProxyImplementation(&bind.CallOpts{Context: ctx}, proxy)
+		if err != nil {
+			return fmt.Errorf("%s: cannot get implementation of proxy %s: %w", p.name, proxy, err)
+		}
+		if expected := common.HexToAddress(p.expected.Address.String()); impl != expected {
+			return fmt.Errorf("%s: proxy %s points to %s, expected %s", p.name, proxy, impl, expected)
+		}
+	}
+
+	versions, err := GetContractVersions(ctx, addresses, chainConfig, backend)
+	if err != nil {
+		return err
+	}
+	for _, v := range []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{"L1CrossDomainMessenger", versions.L1CrossDomainMessenger, list.L1CrossDomainMessenger.Version},
+		{"L1ERC721Bridge", versions.L1ERC721Bridge, list.L1ERC721Bridge.Version},
+		{"L1StandardBridge", versions.L1StandardBridge, list.L1StandardBridge.Version},
+		{"L2OutputOracle", versions.L2OutputOracle, list.L2OutputOracle.Version},
+		{"OptimismMintableERC20Factory", versions.OptimismMintableERC20Factory, list.OptimismMintableERC20Factory.Version},
+		{"OptimismPortal", versions.OptimismPortal, list.OptimismPortal.Version},
+		{"SystemConfig", versions.SystemConfig, list.SystemConfig.Version},
+	} {
+		if !cmpVersion(v.version, v.expected) {
+			return fmt.Errorf("%s: proxy reports version %s, expected %s", v.name, v.version, v.expected)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package upgrades
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/deployer"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+)
+
+var safeAddr = common.HexToAddress("0x5afe")
+
+// deployProxy deploys a proxy, with a ProxyAdmin owned by the Safe, and an implementation to upgrade to.
+func deployProxy(t *testing.T, backend *backends.SimulatedBackend) (proxyAdmin, proxy, impl common.Address) {
+	opts, err := bind.NewKeyedTransactorWithChainID(deployer.TestKey, deployer.ChainID)
+	require.NoError(t, err)
+	proxyAdmin, _, _, err = bindings.DeployProxyAdmin(opts, backend, safeAddr)
+	require.NoError(t, err)
+	backend.Commit()
+	proxy, _, _, err = bindings.DeployProxy(opts, backend, proxyAdmin)
+	require.NoError(t, err)
+	backend.Commit()
+	impl, _, _, err = bindings.DeployStorageSetter(opts, backend)
+	require.NoError(t, err)
+	backend.Commit()
+	return proxyAdmin, proxy, impl
+}
+
+func TestSimulation(t *testing.T) {
+	ctx := context.Background()
+	backend, err := deployer.NewL1Backend()
+	require.NoError(t, err)
+	proxyAdminAddr, proxy, impl := deployProxy(t, backend)
+
+	proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+	require.NoError(t, err)
+	batch := safe.Batch{}
+	require.NoError(t, batch.AddCall(proxyAdminAddr, common.Big0, upgrade, []any{proxy, impl}, proxyAdminABI))
+
+	sim, err := NewSimulation(ctx, backend, backend.Blockchain().Config(), nil)
+	require.NoError(t, err)
+	require.NoError(t, sim.ApplyBatch(safeAddr, &batch))
+
+	simProxyAdmin, err := bindings.NewProxyAdminCaller(proxyAdminAddr, sim.Backend())
+	require.NoError(t, err)
+	got, err := simProxyAdmin.GetProxyImplementation(&bind.CallOpts{}, proxy)
+	require.NoError(t, err)
+	require.Equal(t, impl, got, "simulated proxy is upgraded")
+	version, err := getVersion(ctx, proxy, sim.Backend())
+	require.NoError(t, err)
+	expected, err := getVersion(ctx, impl, backend)
+	require.NoError(t, err)
+	require.Equal(t, expected, version)
+
+	l1ProxyAdmin, err := bindings.NewProxyAdminCaller(proxyAdminAddr, backend)
+	require.NoError(t, err)
+	got, err = l1ProxyAdmin.GetProxyImplementation(&bind.CallOpts{}, proxy)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, got, "L1 is not changed")
+}
+
+func TestSimulationRevert(t *testing.T) {
+	ctx := context.Background()
+	backend, err := deployer.NewL1Backend()
+	require.NoError(t, err)
+	proxyAdminAddr, proxy, impl := deployProxy(t, backend)
+
+	proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+	require.NoError(t, err)
+	batch := safe.Batch{}
+	require.NoError(t, batch.AddCall(proxyAdminAddr, big.NewInt(0), upgrade, []any{proxy, impl}, proxyAdminABI))
+
+	sim, err := NewSimulation(ctx, backend, backend.Blockchain().Config(), nil)
+	require.NoError(t, err)
+	// only the Safe owns the ProxyAdmin
+	err = sim.ApplyBatch(common.HexToAddress("0xbad"), &batch)
+	require.ErrorContains(t, err, "batch transaction 0")
+	require.ErrorContains(t, err, "Ownable: caller is not the owner")
+	require.ErrorContains(t, err, "\tat <unknown> ("+proxyAdminAddr.String()+")")
+}
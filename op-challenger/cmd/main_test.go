@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -74,6 +75,23 @@ func TestL1ETHRPCAddress(t *testing.T) {
 	})
 }
 
+func TestL1Fallback(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, client.DefaultFallbackConfig(), cfg.L1FallbackConfig)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		urls := "http://a.example.com:8888,http://b.example.com:8888"
+		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeAlphabet, "--l1-eth-rpc", "--l1-eth-rpc="+urls,
+			"--l1.fallback.check-interval=3s", "--l1.fallback.max-head-lag=5", "--l1.fallback.recover"))
+		require.Equal(t, urls, cfg.L1EthRpc)
+		require.Equal(t, 3*time.Second, cfg.L1FallbackConfig.CheckInterval)
+		require.Equal(t, uint64(5), cfg.L1FallbackConfig.MaxHeadLag)
+		require.True(t, cfg.L1FallbackConfig.Recover)
+	})
+}
+
 func TestTraceType(t *testing.T) {
 	t.Run("Default", func(t *testing.T) {
 		expectedDefault := config.TraceTypeCannon
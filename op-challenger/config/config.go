@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"runtime"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -98,7 +101,7 @@ const (
 // This also contains config options for auxiliary services.
 // It is used to initialize the challenger.
 type Config struct {
-	L1EthRpc           string           // L1 RPC Url
+	L1EthRpc           string           // L1 RPC Url, a comma-separated list enables the fallback L1 client
 	GameFactoryAddress common.Address   // Address of the dispute game factory
 	GameAllowlist      []common.Address // Allowlist of fault game addresses
 	GameWindow         time.Duration    // Maximum time duration to look for games to progress
@@ -122,9 +125,12 @@ type Config struct {
 	CannonSnapshotFreq     uint   // Frequency of snapshots to create when executing cannon (in VM instructions)
 	CannonInfoFreq         uint   // Frequency of cannon progress log messages (in VM instructions)
 
+	L1FallbackConfig client.FallbackConfig // Switching between the L1 endpoints, if multiple are given
+
 	TxMgrConfig   txmgr.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	TracingConfig tracing.CLIConfig
 }
 
 func NewConfig(
@@ -141,9 +147,12 @@ func NewConfig(
 
 		TraceTypes: supportedTraceTypes,
 
+		L1FallbackConfig: client.DefaultFallbackConfig(),
+
 		TxMgrConfig:   txmgr.NewCLIConfig(l1EthRpc, txmgr.DefaultChallengerFlagValues),
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		TracingConfig: tracing.DefaultCLIConfig(),
 
 		Datadir: datadir,
 
@@ -161,6 +170,11 @@ func (c Config) Check() error {
 	if c.L1EthRpc == "" {
 		return ErrMissingL1EthRPC
 	}
+	if strings.Contains(c.L1EthRpc, ",") {
+		if err := c.L1FallbackConfig.Check(); err != nil {
+			return err
+		}
+	}
 	if c.RollupRpc == "" {
 		return ErrMissingRollupRpc
 	}
@@ -223,5 +237,8 @@ func (c Config) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.TracingConfig.Check(); err != nil {
+		return err
+	}
 	return nil
 }
@@ -60,6 +60,22 @@ func TestL1EthRpcRequired(t *testing.T) {
 	require.ErrorIs(t, config.Check(), ErrMissingL1EthRPC)
 }
 
+func TestL1FallbackConfig(t *testing.T) {
+	t.Run("UnusedWithSingleL1EthRpc", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.L1FallbackConfig.ErrThreshold = 0
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("InvalidWithMultipleL1EthRpc", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.L1EthRpc = "http://a.example.com:8545,http://b.example.com:8545"
+		require.NoError(t, config.Check())
+		config.L1FallbackConfig.ErrThreshold = 0
+		require.ErrorContains(t, config.Check(), "fallback error threshold")
+	})
+}
+
 func TestGameFactoryAddressRequired(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
 	config.GameFactoryAddress = common.Address{}
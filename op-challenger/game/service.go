@@ -26,6 +26,7 @@ import (
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -44,10 +45,12 @@ type Service struct {
 	rollupClient *sources.RollupClient
 
 	l1Client   *ethclient.Client
+	l1RPC      client.RPC
 	pollClient client.RPC
 
-	pprofService *oppprof.Service
-	metricsSrv   *httputil.HTTPServer
+	pprofService   *oppprof.Service
+	tracingService *tracing.Service
+	metricsSrv     *httputil.HTTPServer
 
 	balanceMetricer io.Closer
 
@@ -70,12 +73,15 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 }
 
 func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error {
-	if err := s.initTxManager(cfg); err != nil {
-		return fmt.Errorf("failed to init tx manager: %w", err)
+	if err := s.initTracing(ctx, &cfg.TracingConfig); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
 	}
 	if err := s.initL1Client(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init l1 client: %w", err)
 	}
+	if err := s.initTxManager(cfg); err != nil {
+		return fmt.Errorf("failed to init tx manager: %w", err)
+	}
 	if err := s.initRollupClient(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init rollup client: %w", err)
 	}
@@ -103,7 +109,12 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 }
 
 func (s *Service) initTxManager(cfg *config.Config) error {
-	txMgr, err := txmgr.NewSimpleTxManager("challenger", s.logger, s.metrics, cfg.TxMgrConfig)
+	// share the L1 client, the L1 RPC flag may hold multiple endpoints
+	txMgrConfig, err := txmgr.NewConfigWithClient(cfg.TxMgrConfig, s.logger, s.l1Client)
+	if err != nil {
+		return fmt.Errorf("failed to create the transaction manager config: %w", err)
+	}
+	txMgr, err := txmgr.NewSimpleTxManagerFromConfig("challenger", s.logger, s.metrics, txMgrConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create the transaction manager: %w", err)
 	}
@@ -112,16 +123,17 @@ func (s *Service) initTxManager(cfg *config.Config) error {
 }
 
 func (s *Service) initL1Client(ctx context.Context, cfg *config.Config) error {
-	l1Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, s.logger, cfg.L1EthRpc)
+	l1Client, l1RPC, err := dial.DialEthClientWithFallback(ctx, dial.DefaultDialTimeout, s.logger, cfg.L1EthRpc, cfg.L1FallbackConfig)
 	if err != nil {
 		return fmt.Errorf("failed to dial L1: %w", err)
 	}
 	s.l1Client = l1Client
+	s.l1RPC = l1RPC
 	return nil
 }
 
 func (s *Service) initPollClient(ctx context.Context, cfg *config.Config) error {
-	pollClient, err := client.NewRPCWithClient(ctx, s.logger, cfg.L1EthRpc, client.NewBaseRPCClient(s.l1Client.Client()), cfg.PollInterval)
+	pollClient, err := client.NewRPCWithClient(ctx, s.logger, cfg.L1EthRpc, s.l1RPC, cfg.PollInterval)
 	if err != nil {
 		return fmt.Errorf("failed to create RPC client: %w", err)
 	}
@@ -129,6 +141,14 @@ func (s *Service) initPollClient(ctx context.Context, cfg *config.Config) error
 	return nil
 }
 
+func (s *Service) initTracing(ctx context.Context, cfg *tracing.CLIConfig) error {
+	s.tracingService = tracing.New(s.logger, *cfg, "op-challenger", version.SimpleWithMeta)
+	if err := s.tracingService.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start tracing service: %w", err)
+	}
+	return nil
+}
+
 func (s *Service) initPProf(cfg *oppprof.CLIConfig) error {
 	s.pprofService = oppprof.New(
 		cfg.ListenEnabled,
@@ -258,11 +278,19 @@ func (s *Service) Stop(ctx context.Context) error {
 	if s.l1Client != nil {
 		s.l1Client.Close()
 	}
+	if s.l1RPC != nil {
+		s.l1RPC.Close()
+	}
 	if s.metricsSrv != nil {
 		if err := s.metricsSrv.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close metrics server: %w", err))
 		}
 	}
+	if s.tracingService != nil {
+		if err := s.tracingService.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop tracing: %w", err))
+		}
+	}
 	s.stopped.Store(true)
 	s.logger.Info("stopped challenger game service", "err", result)
 	return result
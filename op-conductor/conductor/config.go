@@ -112,9 +112,12 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 		ExecutionRPC:   ctx.String(flags.ExecutionRPC.Name),
 		Paused:         ctx.Bool(flags.Paused.Name),
 		HealthCheck: HealthCheckConfig{
-			Interval:     ctx.Uint64(flags.HealthCheckInterval.Name),
-			SafeInterval: ctx.Uint64(flags.HealthCheckSafeInterval.Name),
-			MinPeerCount: ctx.Uint64(flags.HealthCheckMinPeerCount.Name),
+			Interval:      ctx.Uint64(flags.HealthCheckInterval.Name),
+			SafeInterval:  ctx.Uint64(flags.HealthCheckSafeInterval.Name),
+			MinPeerCount:  ctx.Uint64(flags.HealthCheckMinPeerCount.Name),
+			ExecutionSync: ctx.Bool(flags.HealthCheckExecutionSync.Name),
+			L1HeadMaxAge:  ctx.Uint64(flags.HealthCheckL1HeadMaxAge.Name),
+			MaxBatcherLag: ctx.Uint64(flags.HealthCheckMaxBatcherLag.Name),
 		},
 		RollupCfg:     *rollupCfg,
 		LogConfig:     oplog.ReadCLIConfig(ctx),
@@ -134,6 +137,15 @@ type HealthCheckConfig struct {
 
 	// MinPeerCount is the minimum number of peers required for the sequencer to be healthy.
 	MinPeerCount uint64
+
+	// ExecutionSync enables the check that the execution client is not syncing.
+	ExecutionSync bool
+
+	// L1HeadMaxAge is the maximum age (in seconds) of the L1 head known to op-node. 0 disables the check.
+	L1HeadMaxAge uint64
+
+	// MaxBatcherLag is the maximum number of L2 blocks between the unsafe and safe head. 0 disables the check.
+	MaxBatcherLag uint64
 }
 
 func (c *HealthCheckConfig) Check() error {
@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/hashicorp/go-multierror"
@@ -17,11 +18,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-conductor/client"
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
 	"github.com/ethereum-optimism/optimism/op-conductor/health"
+	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
 	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
 	opp2p "github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
 	opclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 )
@@ -92,6 +96,9 @@ func NewOpConductor(
 
 func (c *OpConductor) init(ctx context.Context) error {
 	c.log.Info("initializing OpConductor", "version", c.version)
+	if err := c.initMetrics(ctx); err != nil {
+		return errors.Wrap(err, "failed to initialize metrics")
+	}
 	if err := c.initSequencerControl(ctx); err != nil {
 		return errors.Wrap(err, "failed to initialize sequencer control")
 	}
@@ -107,6 +114,25 @@ func (c *OpConductor) init(ctx context.Context) error {
 	return nil
 }
 
+func (c *OpConductor) initMetrics(ctx context.Context) error {
+	if !c.cfg.MetricsConfig.Enabled {
+		c.log.Info("metrics disabled")
+		c.metrics = metrics.NoopMetrics
+		return nil
+	}
+
+	m := metrics.NewMetrics("default")
+	c.log.Debug("starting metrics server", "addr", c.cfg.MetricsConfig.ListenAddr, "port", c.cfg.MetricsConfig.ListenPort)
+	metricsSrv, err := opmetrics.StartServer(m.Registry(), c.cfg.MetricsConfig.ListenAddr, c.cfg.MetricsConfig.ListenPort)
+	if err != nil {
+		return errors.Wrap(err, "failed to start metrics server")
+	}
+	c.log.Info("started metrics server", "addr", metricsSrv.Addr())
+	c.metrics = m
+	c.metricsServer = metricsSrv
+	return nil
+}
+
 func (c *OpConductor) initSequencerControl(ctx context.Context) error {
 	if c.ctrl != nil {
 		return nil
@@ -171,14 +197,32 @@ func (c *OpConductor) initHealthMonitor(ctx context.Context) error {
 	}
 	p2p := opp2p.NewClient(pc)
 
+	var checks []health.Check
+	if c.cfg.HealthCheck.ExecutionSync {
+		ec, err := ethclient.DialContext(ctx, c.cfg.ExecutionRPC)
+		if err != nil {
+			return errors.Wrap(err, "failed to create execution rpc client")
+		}
+		c.execClient = ec
+		checks = append(checks, health.NewExecutionSyncCheck(ec))
+	}
+	if c.cfg.HealthCheck.L1HeadMaxAge != 0 {
+		checks = append(checks, health.NewL1HeadCheck(c.cfg.HealthCheck.L1HeadMaxAge))
+	}
+	if c.cfg.HealthCheck.MaxBatcherLag != 0 {
+		checks = append(checks, health.NewBatcherLagCheck(c.cfg.HealthCheck.MaxBatcherLag))
+	}
+
 	c.hmon = health.NewSequencerHealthMonitor(
 		c.log,
+		c.metrics,
 		c.cfg.HealthCheck.Interval,
 		c.cfg.HealthCheck.SafeInterval,
 		c.cfg.HealthCheck.MinPeerCount,
 		&c.cfg.RollupCfg,
 		node,
 		p2p,
+		checks...,
 	)
 	c.healthUpdateCh = c.hmon.Subscribe()
 
@@ -215,10 +259,13 @@ type OpConductor struct {
 	log     log.Logger
 	version string
 	cfg     *Config
+	metrics metrics.Metricer
 
 	ctrl client.SequencerControl
 	cons consensus.Consensus
 	hmon health.HealthMonitor
+	// execClient is the execution client of the execution sync health check, nil if the check is disabled.
+	execClient *ethclient.Client
 
 	leader    atomic.Bool
 	healthy   atomic.Bool
@@ -239,7 +286,8 @@ type OpConductor struct {
 	shutdownCtx    context.Context
 	shutdownCancel context.CancelFunc
 
-	rpcServer *oprpc.Server
+	rpcServer     *oprpc.Server
+	metricsServer *httputil.HTTPServer
 }
 
 var _ cliapp.Lifecycle = (*OpConductor)(nil)
@@ -260,6 +308,8 @@ func (oc *OpConductor) Start(ctx context.Context) error {
 	oc.wg.Add(1)
 	go oc.loop()
 
+	oc.metrics.RecordInfo(oc.version)
+	oc.metrics.RecordUp()
 	oc.log.Info("OpConductor started")
 	return nil
 }
@@ -290,6 +340,9 @@ func (oc *OpConductor) Stop(ctx context.Context) error {
 			result = multierror.Append(result, errors.Wrap(err, "failed to stop health monitor"))
 		}
 	}
+	if oc.execClient != nil {
+		oc.execClient.Close()
+	}
 
 	if oc.cons != nil {
 		if err := oc.cons.Shutdown(); err != nil {
@@ -297,6 +350,12 @@ func (oc *OpConductor) Stop(ctx context.Context) error {
 		}
 	}
 
+	if oc.metricsServer != nil {
+		if err := oc.metricsServer.Stop(ctx); err != nil {
+			result = multierror.Append(result, errors.Wrap(err, "failed to stop metrics server"))
+		}
+	}
+
 	if result.ErrorOrNil() != nil {
 		oc.log.Error("failed to stop OpConductor", "err", result.ErrorOrNil())
 		return result.ErrorOrNil()
@@ -381,6 +440,26 @@ func (oc *OpConductor) TransferLeaderToServer(_ context.Context, id string, addr
 	return oc.cons.TransferLeaderTo(id, addr)
 }
 
+// ClusterMembership returns the current cluster membership configuration.
+func (oc *OpConductor) ClusterMembership(_ context.Context) (*consensus.ClusterMembership, error) {
+	return oc.cons.ClusterMembership()
+}
+
+// TakeSnapshot forces a snapshot of the unsafe payload FSM to be taken and persisted.
+func (oc *OpConductor) TakeSnapshot(_ context.Context) (*consensus.SnapshotInfo, error) {
+	return oc.cons.TakeSnapshot()
+}
+
+// ListSnapshots lists the persisted snapshots of the unsafe payload FSM, most recent first.
+func (oc *OpConductor) ListSnapshots(_ context.Context) ([]*consensus.SnapshotInfo, error) {
+	return oc.cons.ListSnapshots()
+}
+
+// RestoreSnapshot restores the unsafe payload FSM from a persisted snapshot, it can only be called on the leader.
+func (oc *OpConductor) RestoreSnapshot(_ context.Context, id string) error {
+	return oc.cons.RestoreSnapshot(id)
+}
+
 // CommitUnsafePayload commits a unsafe payload (lastest head) to the cluster FSM.
 func (oc *OpConductor) CommitUnsafePayload(_ context.Context, payload *eth.ExecutionPayload) error {
 	return oc.cons.CommitUnsafePayload(payload)
@@ -391,6 +470,11 @@ func (oc *OpConductor) SequencerHealthy(_ context.Context) bool {
 	return oc.healthy.Load()
 }
 
+// SequencerHealthDetails returns the report of the latest sequencer health check.
+func (oc *OpConductor) SequencerHealthDetails(_ context.Context) *health.HealthReport {
+	return oc.hmon.HealthDetails()
+}
+
 func (oc *OpConductor) loop() {
 	defer oc.wg.Done()
 
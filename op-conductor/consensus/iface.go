@@ -1,9 +1,81 @@
 package consensus
 
 import (
+	"fmt"
+
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
+// ServerSuffrage determines whether a server in the cluster gets a vote.
+type ServerSuffrage int
+
+const (
+	// Voter is a server whose vote is counted in elections.
+	Voter ServerSuffrage = iota
+	// Nonvoter is a server that receives log entries but is not considered for elections or commitment purposes.
+	Nonvoter
+)
+
+func (s ServerSuffrage) String() string {
+	switch s {
+	case Voter:
+		return "Voter"
+	case Nonvoter:
+		return "Nonvoter"
+	}
+	return "ServerSuffrage"
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ServerSuffrage) MarshalText() ([]byte, error) {
+	switch s {
+	case Voter, Nonvoter:
+		return []byte(s.String()), nil
+	}
+	return nil, fmt.Errorf("unknown server suffrage: %d", s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ServerSuffrage) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Voter":
+		*s = Voter
+	case "Nonvoter":
+		*s = Nonvoter
+	default:
+		return fmt.Errorf("unknown server suffrage: %q", text)
+	}
+	return nil
+}
+
+// ServerInfo defines the server information of a cluster member.
+type ServerInfo struct {
+	ID       string         `json:"id"`
+	Addr     string         `json:"addr"`
+	Suffrage ServerSuffrage `json:"suffrage"`
+}
+
+// ClusterMembership defines the versioned cluster membership.
+type ClusterMembership struct {
+	Servers []ServerInfo `json:"servers"`
+	// Version is the raft log index of the latest configuration, it increases with every membership change.
+	Version uint64 `json:"version"`
+}
+
+// SnapshotInfo describes a raft snapshot of the unsafe head FSM.
+type SnapshotInfo struct {
+	ID string `json:"id"`
+	// Index and Term are the raft log index and term the snapshot was taken at.
+	Index uint64 `json:"index"`
+	Term  uint64 `json:"term"`
+	// ConfigurationIndex is the raft log index of the cluster configuration contained in the snapshot.
+	ConfigurationIndex uint64 `json:"configurationIndex"`
+	// Size is the size of the snapshot in bytes.
+	Size int64 `json:"size"`
+	// UnsafeHead is the unsafe head contained in the snapshot.
+	UnsafeHead eth.BlockID `json:"unsafeHead"`
+}
+
 // Consensus defines the consensus interface for leadership election.
 //
 //go:generate mockery --name Consensus --output mocks/ --with-expecter=true
@@ -28,6 +100,15 @@ type Consensus interface {
 	TransferLeader() error
 	// TransferLeaderTo triggers leadership transfer to a specific member in the cluster.
 	TransferLeaderTo(id, addr string) error
+	// ClusterMembership returns the current cluster membership configuration.
+	ClusterMembership() (*ClusterMembership, error)
+
+	// TakeSnapshot forces a snapshot of the FSM to be taken and persisted.
+	TakeSnapshot() (*SnapshotInfo, error)
+	// ListSnapshots lists the persisted snapshots, most recent first.
+	ListSnapshots() ([]*SnapshotInfo, error)
+	// RestoreSnapshot restores the FSM from the persisted snapshot with the given ID, it can only be called on the leader.
+	RestoreSnapshot(id string) error
 
 	// CommitPayload commits latest unsafe payload to the FSM.
 	CommitUnsafePayload(payload *eth.ExecutionPayload) error
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	consensus "github.com/ethereum-optimism/optimism/op-conductor/consensus"
 	eth "github.com/ethereum-optimism/optimism/op-service/eth"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -106,6 +107,59 @@ func (_c *Consensus_AddVoter_Call) RunAndReturn(run func(string, string) error)
 	return _c
 }
 
+// ClusterMembership provides a mock function with given fields:
+func (_m *Consensus) ClusterMembership() (*consensus.ClusterMembership, error) {
+	ret := _m.Called()
+
+	var r0 *consensus.ClusterMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*consensus.ClusterMembership, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *consensus.ClusterMembership); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus.ClusterMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Consensus_ClusterMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClusterMembership'
+type Consensus_ClusterMembership_Call struct {
+	*mock.Call
+}
+
+// ClusterMembership is a helper method to define mock.On call
+func (_e *Consensus_Expecter) ClusterMembership() *Consensus_ClusterMembership_Call {
+	return &Consensus_ClusterMembership_Call{Call: _e.mock.On("ClusterMembership")}
+}
+
+func (_c *Consensus_ClusterMembership_Call) Run(run func()) *Consensus_ClusterMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Consensus_ClusterMembership_Call) Return(_a0 *consensus.ClusterMembership, _a1 error) *Consensus_ClusterMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Consensus_ClusterMembership_Call) RunAndReturn(run func() (*consensus.ClusterMembership, error)) *Consensus_ClusterMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CommitUnsafePayload provides a mock function with given fields: payload
 func (_m *Consensus) CommitUnsafePayload(payload *eth.ExecutionPayload) error {
 	ret := _m.Called(payload)
@@ -368,6 +422,59 @@ func (_c *Consensus_LeaderWithID_Call) RunAndReturn(run func() (string, string))
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields:
+func (_m *Consensus) ListSnapshots() ([]*consensus.SnapshotInfo, error) {
+	ret := _m.Called()
+
+	var r0 []*consensus.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*consensus.SnapshotInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*consensus.SnapshotInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*consensus.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Consensus_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type Consensus_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+func (_e *Consensus_Expecter) ListSnapshots() *Consensus_ListSnapshots_Call {
+	return &Consensus_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots")}
+}
+
+func (_c *Consensus_ListSnapshots_Call) Run(run func()) *Consensus_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Consensus_ListSnapshots_Call) Return(_a0 []*consensus.SnapshotInfo, _a1 error) *Consensus_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Consensus_ListSnapshots_Call) RunAndReturn(run func() ([]*consensus.SnapshotInfo, error)) *Consensus_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveServer provides a mock function with given fields: id
 func (_m *Consensus) RemoveServer(id string) error {
 	ret := _m.Called(id)
@@ -410,6 +517,48 @@ func (_c *Consensus_RemoveServer_Call) RunAndReturn(run func(string) error) *Con
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: id
+func (_m *Consensus) RestoreSnapshot(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Consensus_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type Consensus_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - id string
+func (_e *Consensus_Expecter) RestoreSnapshot(id interface{}) *Consensus_RestoreSnapshot_Call {
+	return &Consensus_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", id)}
+}
+
+func (_c *Consensus_RestoreSnapshot_Call) Run(run func(id string)) *Consensus_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Consensus_RestoreSnapshot_Call) Return(_a0 error) *Consensus_RestoreSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Consensus_RestoreSnapshot_Call) RunAndReturn(run func(string) error) *Consensus_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ServerID provides a mock function with given fields:
 func (_m *Consensus) ServerID() string {
 	ret := _m.Called()
@@ -492,6 +641,59 @@ func (_c *Consensus_Shutdown_Call) RunAndReturn(run func() error) *Consensus_Shu
 	return _c
 }
 
+// TakeSnapshot provides a mock function with given fields:
+func (_m *Consensus) TakeSnapshot() (*consensus.SnapshotInfo, error) {
+	ret := _m.Called()
+
+	var r0 *consensus.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*consensus.SnapshotInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *consensus.SnapshotInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Consensus_TakeSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeSnapshot'
+type Consensus_TakeSnapshot_Call struct {
+	*mock.Call
+}
+
+// TakeSnapshot is a helper method to define mock.On call
+func (_e *Consensus_Expecter) TakeSnapshot() *Consensus_TakeSnapshot_Call {
+	return &Consensus_TakeSnapshot_Call{Call: _e.mock.On("TakeSnapshot")}
+}
+
+func (_c *Consensus_TakeSnapshot_Call) Run(run func()) *Consensus_TakeSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Consensus_TakeSnapshot_Call) Return(_a0 *consensus.SnapshotInfo, _a1 error) *Consensus_TakeSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Consensus_TakeSnapshot_Call) RunAndReturn(run func() (*consensus.SnapshotInfo, error)) *Consensus_TakeSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferLeader provides a mock function with given fields:
 func (_m *Consensus) TransferLeader() error {
 	ret := _m.Called()
@@ -3,6 +3,7 @@ package consensus
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -26,8 +27,9 @@ type RaftConsensus struct {
 	log       log.Logger
 	rollupCfg *rollup.Config
 
-	serverID raft.ServerID
-	r        *raft.Raft
+	serverID  raft.ServerID
+	r         *raft.Raft
+	snapshots raft.SnapshotStore
 
 	unsafeTracker *unsafeHeadTracker
 }
@@ -106,6 +108,7 @@ func NewRaftConsensus(log log.Logger, serverID, serverAddr, storageDir string, b
 		log:           log,
 		r:             r,
 		serverID:      raft.ServerID(serverID),
+		snapshots:     snapshotStore,
 		unsafeTracker: fsm,
 		rollupCfg:     rollupCfg,
 	}, nil
@@ -231,3 +234,126 @@ func (rc *RaftConsensus) LatestUnsafePayload() *eth.ExecutionPayload {
 	payload := rc.unsafeTracker.UnsafeHead()
 	return &payload
 }
+
+// ClusterMembership implements Consensus, it returns the current cluster membership configuration.
+func (rc *RaftConsensus) ClusterMembership() (*ClusterMembership, error) {
+	future := rc.r.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return nil, errors.Wrap(err, "failed to get raft configuration")
+	}
+
+	var servers []ServerInfo
+	for _, srv := range future.Configuration().Servers {
+		suffrage := Voter
+		if srv.Suffrage != raft.Voter {
+			suffrage = Nonvoter
+		}
+		servers = append(servers, ServerInfo{
+			ID:       string(srv.ID),
+			Addr:     string(srv.Address),
+			Suffrage: suffrage,
+		})
+	}
+	version, err := rc.latestConfigurationIndex()
+	if err != nil {
+		return nil, err
+	}
+	return &ClusterMembership{
+		Servers: servers,
+		Version: version,
+	}, nil
+}
+
+// latestConfigurationIndex returns the raft log index of the latest configuration.
+// raft doesn't expose it, so it is tracked by the FSM as configuration entries are applied. Entries compacted into a
+// snapshot are not applied again on startup, in which case the configuration index of the latest snapshot is returned.
+func (rc *RaftConsensus) latestConfigurationIndex() (uint64, error) {
+	if index := rc.unsafeTracker.ConfigurationIndex(); index > 0 {
+		return index, nil
+	}
+
+	metas, err := rc.snapshots.List()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list snapshots")
+	}
+	if len(metas) == 0 {
+		return 0, nil
+	}
+	return metas[0].ConfigurationIndex, nil
+}
+
+// TakeSnapshot implements Consensus, it forces a snapshot of the unsafe head FSM to be taken and persisted.
+func (rc *RaftConsensus) TakeSnapshot() (*SnapshotInfo, error) {
+	future := rc.r.Snapshot()
+	if err := future.Error(); err != nil {
+		rc.log.Error("failed to take snapshot", "err", err)
+		return nil, errors.Wrap(err, "failed to take snapshot")
+	}
+	meta, reader, err := future.Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open snapshot")
+	}
+	defer reader.Close()
+	return newSnapshotInfo(meta, reader)
+}
+
+// ListSnapshots implements Consensus, it lists the persisted snapshots, most recent first.
+// Snapshots that fail to open or decode are logged and skipped.
+func (rc *RaftConsensus) ListSnapshots() ([]*SnapshotInfo, error) {
+	metas, err := rc.snapshots.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshots")
+	}
+
+	infos := make([]*SnapshotInfo, 0, len(metas))
+	for _, m := range metas {
+		info, err := rc.openSnapshot(m.ID)
+		if err != nil {
+			rc.log.Warn("skipping unreadable snapshot", "id", m.ID, "err", err)
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// RestoreSnapshot implements Consensus, it restores the unsafe head FSM from the persisted snapshot with the given ID.
+// This can only be called on the leader, the restored state is then replicated to the followers.
+// It is meant to be used to rebuild a cluster from a snapshot, e.g. one copied from another node's storage directory.
+func (rc *RaftConsensus) RestoreSnapshot(id string) error {
+	meta, reader, err := rc.snapshots.Open(id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open snapshot %s", id)
+	}
+	defer reader.Close()
+
+	if err := rc.r.Restore(meta, reader, defaultTimeout); err != nil {
+		rc.log.Error("failed to restore snapshot", "id", id, "err", err)
+		return errors.Wrapf(err, "failed to restore snapshot %s", id)
+	}
+	return nil
+}
+
+func (rc *RaftConsensus) openSnapshot(id string) (*SnapshotInfo, error) {
+	meta, reader, err := rc.snapshots.Open(id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open snapshot %s", id)
+	}
+	defer reader.Close()
+	return newSnapshotInfo(meta, reader)
+}
+
+func newSnapshotInfo(meta *raft.SnapshotMeta, r io.Reader) (*SnapshotInfo, error) {
+	var data unsafeHeadData
+	if err := data.UnmarshalSSZ(r); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode snapshot %s", meta.ID)
+	}
+	return &SnapshotInfo{
+		ID:                 meta.ID,
+		Index:              meta.Index,
+		Term:               meta.Term,
+		ConfigurationIndex: meta.ConfigurationIndex,
+		Size:               meta.Size,
+		UnsafeHead:         data.payload.ID(),
+	}, nil
+}
@@ -12,12 +12,16 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
-var _ raft.FSM = (*unsafeHeadTracker)(nil)
+var (
+	_ raft.FSM                = (*unsafeHeadTracker)(nil)
+	_ raft.ConfigurationStore = (*unsafeHeadTracker)(nil)
+)
 
 // unsafeHeadTracker implements raft.FSM for storing unsafe head payload into raft consensus layer.
 type unsafeHeadTracker struct {
-	mtx        sync.RWMutex
-	unsafeHead unsafeHeadData
+	mtx         sync.RWMutex
+	unsafeHead  unsafeHeadData
+	configIndex uint64
 }
 
 // Apply implements raft.FSM, it applies the latest change (latest unsafe head payload) to FSM.
@@ -62,6 +66,24 @@ func (t *unsafeHeadTracker) Snapshot() (raft.FSMSnapshot, error) {
 	}, nil
 }
 
+// StoreConfiguration implements raft.ConfigurationStore, it tracks the log index of the latest applied configuration.
+func (t *unsafeHeadTracker) StoreConfiguration(index uint64, _ raft.Configuration) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.configIndex < index {
+		t.configIndex = index
+	}
+}
+
+// ConfigurationIndex returns the log index of the latest applied configuration, 0 if none was applied since startup.
+func (t *unsafeHeadTracker) ConfigurationIndex() uint64 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.configIndex
+}
+
 // UnsafeHead returns the latest unsafe head payload.
 func (t *unsafeHeadTracker) UnsafeHead() eth.ExecutionPayload {
 	t.mtx.RLock()
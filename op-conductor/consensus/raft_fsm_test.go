@@ -76,6 +76,14 @@ func TestUnsafeHeadTracker(t *testing.T) {
 		require.Equal(t, eth.BlockV1, tracker.unsafeHead.version)
 		require.Equal(t, hexutil.Uint64(2), tracker.unsafeHead.payload.BlockNumber)
 	})
+
+	t.Run("StoreConfiguration", func(t *testing.T) {
+		require.Zero(t, tracker.ConfigurationIndex())
+		tracker.StoreConfiguration(5, raft.Configuration{})
+		require.Equal(t, uint64(5), tracker.ConfigurationIndex())
+		tracker.StoreConfiguration(3, raft.Configuration{})
+		require.Equal(t, uint64(5), tracker.ConfigurationIndex(), "keeps the latest index")
+	})
 }
 
 type mockReadCloser struct {
@@ -1,10 +1,13 @@
 package consensus
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -64,3 +67,83 @@ func TestCommitAndRead(t *testing.T) {
 	unsafeHead = cons.LatestUnsafePayload()
 	require.Equal(t, payload, unsafeHead)
 }
+
+func TestClusterMembershipAndSnapshots(t *testing.T) {
+	log := testlog.Logger(t, log.LvlInfo)
+	serverID := "SequencerA"
+	serverAddr := "127.0.0.1:0"
+	now := uint64(time.Now().Unix())
+	rollupCfg := &rollup.Config{
+		CanyonTime: &now,
+	}
+	storageDir := t.TempDir()
+
+	cons, err := NewRaftConsensus(log, serverID, serverAddr, storageDir, true, rollupCfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, cons.Shutdown()) })
+
+	// wait till it became leader
+	<-cons.LeaderCh()
+
+	membership, err := cons.ClusterMembership()
+	require.NoError(t, err)
+	require.Len(t, membership.Servers, 1)
+	require.Equal(t, serverID, membership.Servers[0].ID)
+	require.Equal(t, Voter, membership.Servers[0].Suffrage)
+	require.NotZero(t, membership.Version)
+
+	snapshots, err := cons.ListSnapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	payload := &eth.ExecutionPayload{
+		BlockNumber:  1,
+		BlockHash:    common.Hash{0x01},
+		Timestamp:    hexutil.Uint64(now),
+		Transactions: []eth.Data{},
+		ExtraData:    []byte{},
+		Withdrawals:  &types.Withdrawals{},
+	}
+	require.NoError(t, cons.CommitUnsafePayload(payload))
+
+	info, err := cons.TakeSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, payload.ID(), info.UnsafeHead)
+	require.NotZero(t, info.Index)
+
+	snapshots, err = cons.ListSnapshots()
+	require.NoError(t, err)
+	require.Equal(t, []*SnapshotInfo{info}, snapshots)
+
+	// restoring the snapshot brings the FSM back to the snapshot state
+	require.NoError(t, cons.CommitUnsafePayload(&eth.ExecutionPayload{
+		BlockNumber:  2,
+		Timestamp:    hexutil.Uint64(now),
+		Transactions: []eth.Data{},
+		ExtraData:    []byte{},
+		Withdrawals:  &types.Withdrawals{},
+	}))
+	require.NoError(t, cons.RestoreSnapshot(info.ID))
+	require.Equal(t, payload, cons.LatestUnsafePayload())
+
+	// unreadable snapshots are skipped
+	snapshots, err = cons.ListSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	statePath := filepath.Join(storageDir, serverID, "snapshots", snapshots[0].ID, "state.bin")
+	require.NoError(t, os.WriteFile(statePath, []byte{0xff}, 0o644))
+	snapshots, err = cons.ListSnapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
+
+func TestServerSuffrageJSON(t *testing.T) {
+	info := ServerInfo{ID: "SequencerB", Addr: "127.0.0.1:50050", Suffrage: Nonvoter}
+	data, err := json.Marshal(info)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"SequencerB","addr":"127.0.0.1:50050","suffrage":"Nonvoter"}`, string(data))
+
+	var decoded ServerInfo
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, info, decoded)
+}
@@ -63,6 +63,24 @@ var (
 		Usage:   "Minimum number of peers required to be considered healthy",
 		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_MIN_PEER_COUNT"),
 	}
+	HealthCheckExecutionSync = &cli.BoolFlag{
+		Name:    "healthcheck.execution-sync",
+		Usage:   "Whether to consider the sequencer unhealthy while its execution client is syncing",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_EXECUTION_SYNC"),
+		Value:   false,
+	}
+	HealthCheckL1HeadMaxAge = &cli.Uint64Flag{
+		Name:    "healthcheck.l1-head-max-age",
+		Usage:   "Maximum age (in seconds) of the L1 head known to op-node to be considered healthy. 0 to disable.",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_L1_HEAD_MAX_AGE"),
+		Value:   0,
+	}
+	HealthCheckMaxBatcherLag = &cli.Uint64Flag{
+		Name:    "healthcheck.max-batcher-lag",
+		Usage:   "Maximum number of L2 blocks between the unsafe and safe head to be considered healthy. 0 to disable.",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "HEALTHCHECK_MAX_BATCHER_LAG"),
+		Value:   0,
+	}
 	Paused = &cli.BoolFlag{
 		Name:    "paused",
 		Usage:   "Whether the conductor is paused",
@@ -85,6 +103,9 @@ var requiredFlags = []cli.Flag{
 
 var optionalFlags = []cli.Flag{
 	Paused,
+	HealthCheckExecutionSync,
+	HealthCheckL1HeadMaxAge,
+	HealthCheckMaxBatcherLag,
 }
 
 func init() {
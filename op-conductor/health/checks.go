@@ -0,0 +1,211 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// Names of the health checks.
+const (
+	UnsafeHeadCheckName    = "unsafe_head"
+	SafeHeadCheckName      = "safe_head"
+	PeerCountCheckName     = "peer_count"
+	SyncStatusCheckName    = "sync_status"
+	ExecutionSyncCheckName = "execution_sync"
+	L1HeadCheckName        = "l1_head"
+	BatcherLagCheckName    = "batcher_lag"
+)
+
+// CheckResult is the result of a single health check.
+type CheckResult struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Healthy is true if the check passed.
+	Healthy bool `json:"healthy"`
+	// Observed is the value observed by the check, e.g. the number of connected peers.
+	Observed uint64 `json:"observed"`
+	// Threshold is the value the observed value is compared against.
+	Threshold uint64 `json:"threshold"`
+	// Error is set if the check failed to observe a value.
+	Error string `json:"error,omitempty"`
+}
+
+// HealthReport is the structured result of a sequencer health check.
+type HealthReport struct {
+	// Healthy is true if all checks passed.
+	Healthy bool `json:"healthy"`
+	// Timestamp is the unix time (in seconds) the health check was performed at.
+	Timestamp uint64 `json:"timestamp"`
+	// Checks contains the results of all performed checks.
+	Checks []CheckResult `json:"checks"`
+}
+
+// FailedChecks returns the results of all checks that did not pass.
+func (r *HealthReport) FailedChecks() []CheckResult {
+	var failed []CheckResult
+	for _, c := range r.Checks {
+		if !c.Healthy {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// Check is a single check performed by the [SequencerHealthMonitor].
+// Checks are provided with the sync status of the sequencer's op-node and the current unix time.
+type Check interface {
+	// Name returns the name of the check.
+	Name() string
+	// Check performs the check.
+	Check(ctx context.Context, status *eth.SyncStatus, now uint64) CheckResult
+}
+
+func maxAgeResult(name string, now, t, maxAge uint64) CheckResult {
+	var age uint64
+	if now > t {
+		age = now - t
+	}
+	return CheckResult{
+		Name:      name,
+		Healthy:   age <= maxAge,
+		Observed:  age,
+		Threshold: maxAge,
+	}
+}
+
+func errorResult(name string, threshold uint64, err error) CheckResult {
+	return CheckResult{
+		Name:      name,
+		Threshold: threshold,
+		Error:     err.Error(),
+	}
+}
+
+// unsafeHeadCheck checks that the unsafe head is progressing per block time.
+type unsafeHeadCheck struct {
+	maxAge uint64
+}
+
+func (c *unsafeHeadCheck) Name() string { return UnsafeHeadCheckName }
+
+func (c *unsafeHeadCheck) Check(_ context.Context, status *eth.SyncStatus, now uint64) CheckResult {
+	return maxAgeResult(c.Name(), now, status.UnsafeL2.Time, c.maxAge)
+}
+
+// safeHeadCheck checks that the safe head is progressing every configured batch submission interval.
+type safeHeadCheck struct {
+	maxAge uint64
+}
+
+func (c *safeHeadCheck) Name() string { return SafeHeadCheckName }
+
+func (c *safeHeadCheck) Check(_ context.Context, status *eth.SyncStatus, now uint64) CheckResult {
+	return maxAgeResult(c.Name(), now, status.SafeL2.Time, c.maxAge)
+}
+
+// peerCountCheck checks that the peer count is above the configured minimum.
+type peerCountCheck struct {
+	p2p          p2p.API
+	minPeerCount uint64
+}
+
+func (c *peerCountCheck) Name() string { return PeerCountCheckName }
+
+func (c *peerCountCheck) Check(ctx context.Context, _ *eth.SyncStatus, _ uint64) CheckResult {
+	stats, err := c.p2p.PeerStats(ctx)
+	if err != nil {
+		return errorResult(c.Name(), c.minPeerCount, fmt.Errorf("failed to get peer stats: %w", err))
+	}
+	return CheckResult{
+		Name:      c.Name(),
+		Healthy:   uint64(stats.Connected) >= c.minPeerCount,
+		Observed:  uint64(stats.Connected),
+		Threshold: c.minPeerCount,
+	}
+}
+
+// SyncProgressor is the execution client method used by the execution sync check.
+type SyncProgressor interface {
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+}
+
+// ExecutionSyncCheck checks that the execution client is not syncing.
+// The observed value is the number of blocks the execution client is behind its highest known block.
+type ExecutionSyncCheck struct {
+	client SyncProgressor
+}
+
+// NewExecutionSyncCheck creates a new check of the sync state of the execution client.
+func NewExecutionSyncCheck(client SyncProgressor) *ExecutionSyncCheck {
+	return &ExecutionSyncCheck{client: client}
+}
+
+func (c *ExecutionSyncCheck) Name() string { return ExecutionSyncCheckName }
+
+func (c *ExecutionSyncCheck) Check(ctx context.Context, _ *eth.SyncStatus, _ uint64) CheckResult {
+	progress, err := c.client.SyncProgress(ctx)
+	if err != nil {
+		return errorResult(c.Name(), 0, fmt.Errorf("failed to get execution client sync progress: %w", err))
+	}
+	if progress == nil {
+		// not syncing
+		return CheckResult{Name: c.Name(), Healthy: true}
+	}
+	var behind uint64
+	if progress.HighestBlock > progress.CurrentBlock {
+		behind = progress.HighestBlock - progress.CurrentBlock
+	}
+	return CheckResult{
+		Name:     c.Name(),
+		Healthy:  false,
+		Observed: behind,
+	}
+}
+
+// L1HeadCheck checks that the L1 head known to the op-node is fresh.
+// The observed value is the age of the L1 head in seconds.
+type L1HeadCheck struct {
+	maxAge uint64
+}
+
+// NewL1HeadCheck creates a new check of the L1 head freshness, with the maximum allowed age in seconds.
+func NewL1HeadCheck(maxAge uint64) *L1HeadCheck {
+	return &L1HeadCheck{maxAge: maxAge}
+}
+
+func (c *L1HeadCheck) Name() string { return L1HeadCheckName }
+
+func (c *L1HeadCheck) Check(_ context.Context, status *eth.SyncStatus, now uint64) CheckResult {
+	return maxAgeResult(c.Name(), now, status.HeadL1.Time, c.maxAge)
+}
+
+// BatcherLagCheck checks that the batcher keeps up with the sequencer.
+// The observed value is the number of L2 blocks between the unsafe and the safe head.
+type BatcherLagCheck struct {
+	maxLag uint64
+}
+
+// NewBatcherLagCheck creates a new check of the batcher lag, with the maximum allowed lag in L2 blocks.
+func NewBatcherLagCheck(maxLag uint64) *BatcherLagCheck {
+	return &BatcherLagCheck{maxLag: maxLag}
+}
+
+func (c *BatcherLagCheck) Name() string { return BatcherLagCheckName }
+
+func (c *BatcherLagCheck) Check(_ context.Context, status *eth.SyncStatus, _ uint64) CheckResult {
+	var lag uint64
+	if status.UnsafeL2.Number > status.SafeL2.Number {
+		lag = status.UnsafeL2.Number - status.SafeL2.Number
+	}
+	return CheckResult{
+		Name:      c.Name(),
+		Healthy:   lag <= c.maxLag,
+		Observed:  lag,
+		Threshold: c.maxLag,
+	}
+}
@@ -0,0 +1,65 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type mockSyncProgressor struct {
+	progress *ethereum.SyncProgress
+	err      error
+}
+
+func (m *mockSyncProgressor) SyncProgress(context.Context) (*ethereum.SyncProgress, error) {
+	return m.progress, m.err
+}
+
+func TestExecutionSyncCheck(t *testing.T) {
+	ctx := context.Background()
+	client := new(mockSyncProgressor)
+	check := NewExecutionSyncCheck(client)
+
+	res := check.Check(ctx, &eth.SyncStatus{}, 0)
+	require.Equal(t, CheckResult{Name: ExecutionSyncCheckName, Healthy: true}, res)
+
+	client.progress = &ethereum.SyncProgress{CurrentBlock: 90, HighestBlock: 100}
+	res = check.Check(ctx, &eth.SyncStatus{}, 0)
+	require.Equal(t, CheckResult{Name: ExecutionSyncCheckName, Observed: 10}, res)
+
+	client.progress, client.err = nil, errors.New("boom")
+	res = check.Check(ctx, &eth.SyncStatus{}, 0)
+	require.False(t, res.Healthy)
+	require.Contains(t, res.Error, "boom")
+}
+
+func TestL1HeadCheck(t *testing.T) {
+	check := NewL1HeadCheck(30)
+	status := &eth.SyncStatus{HeadL1: eth.L1BlockRef{Time: 1000}}
+
+	res := check.Check(context.Background(), status, 1030)
+	require.Equal(t, CheckResult{Name: L1HeadCheckName, Healthy: true, Observed: 30, Threshold: 30}, res)
+
+	res = check.Check(context.Background(), status, 1031)
+	require.Equal(t, CheckResult{Name: L1HeadCheckName, Observed: 31, Threshold: 30}, res)
+}
+
+func TestBatcherLagCheck(t *testing.T) {
+	check := NewBatcherLagCheck(100)
+	status := &eth.SyncStatus{
+		UnsafeL2: eth.L2BlockRef{Number: 200},
+		SafeL2:   eth.L2BlockRef{Number: 100},
+	}
+
+	res := check.Check(context.Background(), status, 0)
+	require.Equal(t, CheckResult{Name: BatcherLagCheckName, Healthy: true, Observed: 100, Threshold: 100}, res)
+
+	status.UnsafeL2.Number = 201
+	res = check.Check(context.Background(), status, 0)
+	require.Equal(t, CheckResult{Name: BatcherLagCheckName, Observed: 101, Threshold: 100}, res)
+}
@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	health "github.com/ethereum-optimism/optimism/op-conductor/health"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // HealthMonitor is an autogenerated mock type for the HealthMonitor type
 type HealthMonitor struct {
@@ -17,6 +20,49 @@ func (_m *HealthMonitor) EXPECT() *HealthMonitor_Expecter {
 	return &HealthMonitor_Expecter{mock: &_m.Mock}
 }
 
+// HealthDetails provides a mock function with given fields:
+func (_m *HealthMonitor) HealthDetails() *health.HealthReport {
+	ret := _m.Called()
+
+	var r0 *health.HealthReport
+	if rf, ok := ret.Get(0).(func() *health.HealthReport); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*health.HealthReport)
+		}
+	}
+
+	return r0
+}
+
+// HealthMonitor_HealthDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HealthDetails'
+type HealthMonitor_HealthDetails_Call struct {
+	*mock.Call
+}
+
+// HealthDetails is a helper method to define mock.On call
+func (_e *HealthMonitor_Expecter) HealthDetails() *HealthMonitor_HealthDetails_Call {
+	return &HealthMonitor_HealthDetails_Call{Call: _e.mock.On("HealthDetails")}
+}
+
+func (_c *HealthMonitor_HealthDetails_Call) Run(run func()) *HealthMonitor_HealthDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthMonitor_HealthDetails_Call) Return(_a0 *health.HealthReport) *HealthMonitor_HealthDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthMonitor_HealthDetails_Call) RunAndReturn(run func() *health.HealthReport) *HealthMonitor_HealthDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields:
 func (_m *HealthMonitor) Start() error {
 	ret := _m.Called()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
@@ -22,40 +23,48 @@ type HealthMonitor interface {
 	Start() error
 	// Stop stops the health check.
 	Stop() error
+	// HealthDetails returns the report of the latest health check, or nil if no check has been performed yet.
+	HealthDetails() *HealthReport
 }
 
 // NewSequencerHealthMonitor creates a new sequencer health monitor.
 // interval is the interval between health checks measured in seconds.
 // safeInterval is the interval between safe head progress measured in seconds.
 // minPeerCount is the minimum number of peers required for the sequencer to be healthy.
-func NewSequencerHealthMonitor(log log.Logger, interval, safeInterval, minPeerCount uint64, rollupCfg *rollup.Config, node dial.RollupClientInterface, p2p p2p.API) HealthMonitor {
+// extraChecks are performed in addition to the unsafe head, safe head and peer count checks.
+func NewSequencerHealthMonitor(log log.Logger, m metrics.Metricer, interval, safeInterval, minPeerCount uint64, rollupCfg *rollup.Config, node dial.RollupClientInterface, p2p p2p.API, extraChecks ...Check) HealthMonitor {
+	checks := []Check{
+		// allow at most one block drift for unsafe head
+		&unsafeHeadCheck{maxAge: interval + rollupCfg.BlockTime},
+		&safeHeadCheck{maxAge: safeInterval},
+		&peerCountCheck{p2p: p2p, minPeerCount: minPeerCount},
+	}
 	return &SequencerHealthMonitor{
 		log:            log,
+		metrics:        m,
 		done:           make(chan struct{}),
 		interval:       interval,
 		healthUpdateCh: make(chan bool),
-		rollupCfg:      rollupCfg,
-		safeInterval:   safeInterval,
-		minPeerCount:   minPeerCount,
+		checks:         append(checks, extraChecks...),
 		node:           node,
-		p2p:            p2p,
 	}
 }
 
 // SequencerHealthMonitor monitors sequencer health.
 type SequencerHealthMonitor struct {
-	log  log.Logger
-	done chan struct{}
-	wg   sync.WaitGroup
+	log     log.Logger
+	metrics metrics.Metricer
+	done    chan struct{}
+	wg      sync.WaitGroup
 
-	rollupCfg      *rollup.Config
-	safeInterval   uint64
-	minPeerCount   uint64
 	interval       uint64
+	checks         []Check
 	healthUpdateCh chan bool
 
+	reportLock sync.RWMutex
+	lastReport *HealthReport
+
 	node dial.RollupClientInterface
-	p2p  p2p.API
 }
 
 var _ HealthMonitor = (*SequencerHealthMonitor)(nil)
@@ -85,6 +94,13 @@ func (hm *SequencerHealthMonitor) Subscribe() <-chan bool {
 	return hm.healthUpdateCh
 }
 
+// HealthDetails implements HealthMonitor.
+func (hm *SequencerHealthMonitor) HealthDetails() *HealthReport {
+	hm.reportLock.RLock()
+	defer hm.reportLock.RUnlock()
+	return hm.lastReport
+}
+
 func (hm *SequencerHealthMonitor) loop() {
 	defer hm.wg.Done()
 
@@ -97,44 +113,49 @@ func (hm *SequencerHealthMonitor) loop() {
 		case <-hm.done:
 			return
 		case <-ticker.C:
-			hm.healthUpdateCh <- hm.healthCheck()
+			report := hm.healthCheck()
+			hm.reportLock.Lock()
+			hm.lastReport = report
+			hm.reportLock.Unlock()
+			hm.healthUpdateCh <- report.Healthy
 		}
 	}
 }
 
-// healthCheck checks the health of the sequencer by 3 criteria:
+// healthCheck checks the health of the sequencer by the following criteria:
 // 1. unsafe head is progressing per block time
 // 2. safe head is progressing every configured batch submission interval
 // 3. peer count is above the configured minimum
-func (hm *SequencerHealthMonitor) healthCheck() bool {
+// 4. any additionally configured checks pass
+func (hm *SequencerHealthMonitor) healthCheck() *HealthReport {
 	ctx := context.Background()
+	now := uint64(time.Now().Unix())
+	report := &HealthReport{Timestamp: now}
+	defer hm.recordReport(report)
+
 	status, err := hm.node.SyncStatus(ctx)
 	if err != nil {
 		hm.log.Error("health monitor failed to get sync status", "err", err)
-		return false
-	}
-
-	now := uint64(time.Now().Unix())
-	// allow at most one block drift for unsafe head
-	if now-status.UnsafeL2.Time > hm.interval+hm.rollupCfg.BlockTime {
-		hm.log.Error("unsafe head is not progressing", "lastSeenUnsafeBlock", status.UnsafeL2)
-		return false
+		report.Checks = append(report.Checks, errorResult(SyncStatusCheckName, 0, err))
+		return report
 	}
 
-	if now-status.SafeL2.Time > hm.safeInterval {
-		hm.log.Error("safe head is not progressing", "safe_head_time", status.SafeL2.Time, "now", now)
-		return false
+	report.Healthy = true
+	for _, check := range hm.checks {
+		res := check.Check(ctx, status, now)
+		report.Checks = append(report.Checks, res)
+		if !res.Healthy {
+			report.Healthy = false
+			hm.log.Error("sequencer health check failed", "check", res.Name,
+				"observed", res.Observed, "threshold", res.Threshold, "err", res.Error)
+		}
 	}
+	return report
+}
 
-	stats, err := hm.p2p.PeerStats(ctx)
-	if err != nil {
-		hm.log.Error("health monitor failed to get peer stats", "err", err)
-		return false
-	}
-	if uint64(stats.Connected) < hm.minPeerCount {
-		hm.log.Error("peer count is below minimum", "connected", stats.Connected, "minPeerCount", hm.minPeerCount)
-		return false
+func (hm *SequencerHealthMonitor) recordReport(report *HealthReport) {
+	hm.metrics.RecordHealthCheck(report.Healthy)
+	for _, res := range report.Checks {
+		hm.metrics.RecordHealthCheckResult(res.Name, res.Healthy, res.Observed, res.Threshold)
 	}
-
-	return true
 }
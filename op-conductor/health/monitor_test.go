@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	p2pMocks "github.com/ethereum-optimism/optimism/op-node/p2p/mocks"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -49,7 +50,7 @@ func (s *HealthMonitorTestSuite) SetupSuite() {
 }
 
 func (s *HealthMonitorTestSuite) SetupTest() {
-	s.monitor = NewSequencerHealthMonitor(s.log, s.interval, s.safeInterval, s.minPeerCount, s.rollupCfg, s.rc, s.pc)
+	s.monitor = NewSequencerHealthMonitor(s.log, metrics.NoopMetrics, s.interval, s.safeInterval, s.minPeerCount, s.rollupCfg, s.rc, s.pc)
 	err := s.monitor.Start()
 	s.NoError(err)
 }
@@ -79,6 +80,15 @@ func (s *HealthMonitorTestSuite) TestUnhealthyLowPeerCount() {
 	healthUpdateCh := s.monitor.Subscribe()
 	healthy := <-healthUpdateCh
 	s.False(healthy)
+
+	report := s.monitor.HealthDetails()
+	s.NotNil(report)
+	s.False(report.Healthy)
+	s.Equal([]CheckResult{{
+		Name:      PeerCountCheckName,
+		Observed:  unhealthyPeerCount,
+		Threshold: minPeerCount,
+	}}, report.FailedChecks())
 }
 
 func (s *HealthMonitorTestSuite) TestUnhealthyUnsafeHeadNotProgressing() {
@@ -0,0 +1,149 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+const Namespace = "op_conductor"
+
+// implements the Registry getter, for metrics HTTP server to hook into
+var _ opmetrics.RegistryMetricer = (*Metrics)(nil)
+
+type Metricer interface {
+	RecordInfo(version string)
+	RecordUp()
+
+	// RecordHealthCheck records the overall result of a sequencer health check.
+	RecordHealthCheck(healthy bool)
+	// RecordHealthCheckResult records the result of a single named check of a sequencer health check.
+	RecordHealthCheckResult(check string, healthy bool, observed uint64, threshold uint64)
+}
+
+type Metrics struct {
+	ns       string
+	registry *prometheus.Registry
+	factory  opmetrics.Factory
+
+	info prometheus.GaugeVec
+	up   prometheus.Gauge
+
+	healthy        prometheus.Gauge
+	healthChecks   prometheus.CounterVec
+	checkHealthy   prometheus.GaugeVec
+	checkObserved  prometheus.GaugeVec
+	checkThreshold prometheus.GaugeVec
+	checkFailures  prometheus.CounterVec
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics(procName string) *Metrics {
+	if procName == "" {
+		procName = "default"
+	}
+	ns := Namespace + "_" + procName
+
+	registry := opmetrics.NewRegistry()
+	factory := opmetrics.With(registry)
+
+	return &Metrics{
+		ns:       ns,
+		registry: registry,
+		factory:  factory,
+
+		info: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "info",
+			Help:      "Pseudo-metric tracking version and config info",
+		}, []string{
+			"version",
+		}),
+		up: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "up",
+			Help:      "1 if the op-conductor has finished starting up",
+		}),
+		healthy: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "sequencer_healthy",
+			Help:      "1 if the last sequencer health check passed, 0 otherwise",
+		}),
+		healthChecks: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "health_checks_total",
+			Help:      "Number of sequencer health checks, by result",
+		}, []string{
+			"result",
+		}),
+		checkHealthy: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "health_check_healthy",
+			Help:      "1 if the named check of the last sequencer health check passed, 0 otherwise",
+		}, []string{
+			"check",
+		}),
+		checkObserved: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "health_check_observed",
+			Help:      "Value observed by the named check of the last sequencer health check",
+		}, []string{
+			"check",
+		}),
+		checkThreshold: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "health_check_threshold",
+			Help:      "Threshold of the named check of the sequencer health check",
+		}, []string{
+			"check",
+		}),
+		checkFailures: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "health_check_failures_total",
+			Help:      "Number of failures of the named check of the sequencer health check",
+		}, []string{
+			"check",
+		}),
+	}
+}
+
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+func (m *Metrics) Document() []opmetrics.DocumentedMetric {
+	return m.factory.Document()
+}
+
+// RecordInfo sets a pseudo-metric that contains versioning and
+// config info for the op-conductor.
+func (m *Metrics) RecordInfo(version string) {
+	m.info.WithLabelValues(version).Set(1)
+}
+
+// RecordUp sets the up metric to 1.
+func (m *Metrics) RecordUp() {
+	m.up.Set(1)
+}
+
+func (m *Metrics) RecordHealthCheck(healthy bool) {
+	if healthy {
+		m.healthy.Set(1)
+		m.healthChecks.WithLabelValues("healthy").Inc()
+	} else {
+		m.healthy.Set(0)
+		m.healthChecks.WithLabelValues("unhealthy").Inc()
+	}
+}
+
+func (m *Metrics) RecordHealthCheckResult(check string, healthy bool, observed uint64, threshold uint64) {
+	if healthy {
+		m.checkHealthy.WithLabelValues(check).Set(1)
+	} else {
+		m.checkHealthy.WithLabelValues(check).Set(0)
+		m.checkFailures.WithLabelValues(check).Inc()
+	}
+	m.checkObserved.WithLabelValues(check).Set(float64(observed))
+	m.checkThreshold.WithLabelValues(check).Set(float64(threshold))
+}
@@ -0,0 +1,12 @@
+package metrics
+
+type noopMetrics struct{}
+
+var NoopMetrics Metricer = new(noopMetrics)
+
+func (*noopMetrics) RecordInfo(version string) {}
+func (*noopMetrics) RecordUp()                 {}
+
+func (*noopMetrics) RecordHealthCheck(healthy bool) {}
+func (*noopMetrics) RecordHealthCheckResult(check string, healthy bool, observed uint64, threshold uint64) {
+}
@@ -3,6 +3,8 @@ package rpc
 import (
 	"context"
 
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -19,6 +21,8 @@ type API interface {
 	Resume(ctx context.Context) error
 	// SequencerHealthy returns true if the sequencer is healthy.
 	SequencerHealthy(ctx context.Context) (bool, error)
+	// SequencerHealthDetails returns the detailed results of the latest sequencer health check.
+	SequencerHealthDetails(ctx context.Context) (*health.HealthReport, error)
 
 	// Consensus related APIs
 	// Leader returns true if the server is the leader.
@@ -35,6 +39,15 @@ type API interface {
 	TransferLeader(ctx context.Context) error
 	// TransferLeaderToServer transfers leadership to a specific server.
 	TransferLeaderToServer(ctx context.Context, id string, addr string) error
+	// ClusterMembership returns the current cluster membership configuration.
+	ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error)
+	// TakeSnapshot forces a raft snapshot of the unsafe payload FSM to be taken and persisted.
+	TakeSnapshot(ctx context.Context) (*consensus.SnapshotInfo, error)
+	// ListSnapshots lists the persisted raft snapshots, most recent first.
+	ListSnapshots(ctx context.Context) ([]*consensus.SnapshotInfo, error)
+	// RestoreSnapshot restores the unsafe payload FSM from the persisted raft snapshot with the given ID.
+	// It can only be called on the leader, and should only be used to rebuild a cluster from a snapshot.
+	RestoreSnapshot(ctx context.Context, id string) error
 
 	// APIs called by op-node
 	// Active returns true if op-conductor is active.
@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -14,6 +16,7 @@ type conductor interface {
 	Paused() bool
 	Stopped() bool
 	SequencerHealthy(ctx context.Context) bool
+	SequencerHealthDetails(ctx context.Context) *health.HealthReport
 
 	Leader(ctx context.Context) bool
 	LeaderWithID(ctx context.Context) (string, string)
@@ -22,6 +25,10 @@ type conductor interface {
 	RemoveServer(ctx context.Context, id string) error
 	TransferLeader(ctx context.Context) error
 	TransferLeaderToServer(ctx context.Context, id string, addr string) error
+	ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error)
+	TakeSnapshot(ctx context.Context) (*consensus.SnapshotInfo, error)
+	ListSnapshots(ctx context.Context) ([]*consensus.SnapshotInfo, error)
+	RestoreSnapshot(ctx context.Context, id string) error
 	CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayload) error
 }
 
@@ -106,3 +113,28 @@ func (api *APIBackend) TransferLeaderToServer(ctx context.Context, id string, ad
 func (api *APIBackend) SequencerHealthy(ctx context.Context) (bool, error) {
 	return api.con.SequencerHealthy(ctx), nil
 }
+
+// SequencerHealthDetails implements API.
+func (api *APIBackend) SequencerHealthDetails(ctx context.Context) (*health.HealthReport, error) {
+	return api.con.SequencerHealthDetails(ctx), nil
+}
+
+// ClusterMembership implements API.
+func (api *APIBackend) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
+	return api.con.ClusterMembership(ctx)
+}
+
+// TakeSnapshot implements API.
+func (api *APIBackend) TakeSnapshot(ctx context.Context) (*consensus.SnapshotInfo, error) {
+	return api.con.TakeSnapshot(ctx)
+}
+
+// ListSnapshots implements API.
+func (api *APIBackend) ListSnapshots(ctx context.Context) ([]*consensus.SnapshotInfo, error) {
+	return api.con.ListSnapshots(ctx)
+}
+
+// RestoreSnapshot implements API.
+func (api *APIBackend) RestoreSnapshot(ctx context.Context, id string) error {
+	return api.con.RestoreSnapshot(ctx, id)
+}
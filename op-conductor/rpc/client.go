@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -93,3 +95,36 @@ func (c *APIClient) SequencerHealthy(ctx context.Context) (bool, error) {
 	err := c.c.CallContext(ctx, &healthy, prefixRPC("sequencerHealthy"))
 	return healthy, err
 }
+
+// SequencerHealthDetails implements API.
+func (c *APIClient) SequencerHealthDetails(ctx context.Context) (*health.HealthReport, error) {
+	var report *health.HealthReport
+	err := c.c.CallContext(ctx, &report, prefixRPC("sequencerHealthDetails"))
+	return report, err
+}
+
+// ClusterMembership implements API.
+func (c *APIClient) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
+	var membership *consensus.ClusterMembership
+	err := c.c.CallContext(ctx, &membership, prefixRPC("clusterMembership"))
+	return membership, err
+}
+
+// TakeSnapshot implements API.
+func (c *APIClient) TakeSnapshot(ctx context.Context) (*consensus.SnapshotInfo, error) {
+	var info *consensus.SnapshotInfo
+	err := c.c.CallContext(ctx, &info, prefixRPC("takeSnapshot"))
+	return info, err
+}
+
+// ListSnapshots implements API.
+func (c *APIClient) ListSnapshots(ctx context.Context) ([]*consensus.SnapshotInfo, error) {
+	var infos []*consensus.SnapshotInfo
+	err := c.c.CallContext(ctx, &infos, prefixRPC("listSnapshots"))
+	return infos, err
+}
+
+// RestoreSnapshot implements API.
+func (c *APIClient) RestoreSnapshot(ctx context.Context, id string) error {
+	return c.c.CallContext(ctx, nil, prefixRPC("restoreSnapshot"), id)
+}
@@ -68,12 +68,16 @@ func NewL2Proposer(t Testing, log log.Logger, cfg *ProposerCfg, l1 *ethclient.Cl
 	proposerConfig := proposer.ProposerConfig{
 		PollInterval:           time.Second,
 		NetworkTimeout:         time.Second,
-		ProposalInterval:       cfg.ProposalInterval,
 		L2OutputOracleAddr:     cfg.OutputOracleAddr,
 		DisputeGameFactoryAddr: cfg.DisputeGameFactoryAddr,
-		DisputeGameType:        cfg.DisputeGameType,
 		AllowNonFinalized:      cfg.AllowNonFinalized,
 	}
+	if cfg.DisputeGameFactoryAddr != nil {
+		proposerConfig.DisputeGames = []proposer.DisputeGameConfig{{
+			GameType:         cfg.DisputeGameType,
+			ProposalInterval: cfg.ProposalInterval,
+		}}
+	}
 	rollupProvider, err := dial.NewStaticL2RollupProviderFromExistingRollup(rollupCl)
 	require.NoError(t, err)
 	driverSetup := proposer.DriverSetup{
@@ -2,6 +2,7 @@ package op_heartbeat
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-heartbeat/flags"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
@@ -14,6 +15,18 @@ type Config struct {
 	HTTPAddr string
 	HTTPPort int
 
+	// HistoryDir is where the history of verified heartbeats is kept. No history is kept if empty.
+	HistoryDir string
+	// HistoryMaxPeers is the max number of peers a history is kept for.
+	HistoryMaxPeers int
+	// HistoryMaxEntries is the max number of heartbeats kept in the history of a peer.
+	HistoryMaxEntries int
+	// HistoryRetention is how long heartbeats are kept in the history.
+	HistoryRetention time.Duration
+
+	// RequireSigned rejects unsigned heartbeats.
+	RequireSigned bool
+
 	Log oplog.CLIConfig
 
 	Metrics opmetrics.CLIConfig
@@ -28,6 +41,17 @@ func (c Config) Check() error {
 	if c.HTTPPort <= 0 {
 		return errors.New("must specify a valid HTTP port")
 	}
+	if c.HistoryDir != "" {
+		if c.HistoryMaxPeers <= 0 {
+			return errors.New("must specify a positive max number of history peers")
+		}
+		if c.HistoryMaxEntries <= 0 {
+			return errors.New("must specify a positive max number of history entries")
+		}
+		if c.HistoryRetention <= 0 {
+			return errors.New("must specify a positive history retention")
+		}
+	}
 	if err := c.Metrics.Check(); err != nil {
 		return err
 	}
@@ -39,10 +63,15 @@ func (c Config) Check() error {
 
 func NewConfig(ctx *cli.Context) Config {
 	return Config{
-		HTTPAddr: ctx.String(flags.HTTPAddrFlag.Name),
-		HTTPPort: ctx.Int(flags.HTTPPortFlag.Name),
-		Log:      oplog.ReadCLIConfig(ctx),
-		Metrics:  opmetrics.ReadCLIConfig(ctx),
-		Pprof:    oppprof.ReadCLIConfig(ctx),
+		HTTPAddr:          ctx.String(flags.HTTPAddrFlag.Name),
+		HTTPPort:          ctx.Int(flags.HTTPPortFlag.Name),
+		HistoryDir:        ctx.String(flags.HistoryDirFlag.Name),
+		HistoryMaxPeers:   ctx.Int(flags.HistoryMaxPeersFlag.Name),
+		HistoryMaxEntries: ctx.Int(flags.HistoryMaxEntriesFlag.Name),
+		HistoryRetention:  ctx.Duration(flags.HistoryRetentionFlag.Name),
+		RequireSigned:     ctx.Bool(flags.RequireSignedFlag.Name),
+		Log:               oplog.ReadCLIConfig(ctx),
+		Metrics:           opmetrics.ReadCLIConfig(ctx),
+		Pprof:             oppprof.ReadCLIConfig(ctx),
 	}
 }
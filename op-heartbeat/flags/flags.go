@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
@@ -14,8 +16,13 @@ func prefixEnvVars(name string) []string {
 }
 
 const (
-	HTTPAddrFlagName = "http.addr"
-	HTTPPortFlagName = "http.port"
+	HTTPAddrFlagName          = "http.addr"
+	HTTPPortFlagName          = "http.port"
+	HistoryDirFlagName        = "history.dir"
+	HistoryMaxPeersFlagName   = "history.max-peers"
+	HistoryMaxEntriesFlagName = "history.max-entries"
+	HistoryRetentionFlagName  = "history.retention"
+	RequireSignedFlagName     = "require-signed"
 )
 
 var (
@@ -31,6 +38,34 @@ var (
 		Value:   8080,
 		EnvVars: prefixEnvVars("HTTP_PORT"),
 	}
+	HistoryDirFlag = &cli.StringFlag{
+		Name:    HistoryDirFlagName,
+		Usage:   "Directory to keep the history of signed heartbeats in, per peer. No history is kept if empty",
+		EnvVars: prefixEnvVars("HISTORY_DIR"),
+	}
+	HistoryMaxPeersFlag = &cli.IntFlag{
+		Name:    HistoryMaxPeersFlagName,
+		Usage:   "Max number of peers to keep the history of. Heartbeats of further peers are not recorded",
+		Value:   1000,
+		EnvVars: prefixEnvVars("HISTORY_MAX_PEERS"),
+	}
+	HistoryMaxEntriesFlag = &cli.IntFlag{
+		Name:    HistoryMaxEntriesFlagName,
+		Usage:   "Max number of heartbeats to keep in the history of a peer",
+		Value:   1000,
+		EnvVars: prefixEnvVars("HISTORY_MAX_ENTRIES"),
+	}
+	HistoryRetentionFlag = &cli.DurationFlag{
+		Name:    HistoryRetentionFlagName,
+		Usage:   "How long to keep heartbeats in the history. Peers without heartbeats within the retention are removed",
+		Value:   7 * 24 * time.Hour,
+		EnvVars: prefixEnvVars("HISTORY_RETENTION"),
+	}
+	RequireSignedFlag = &cli.BoolFlag{
+		Name:    RequireSignedFlagName,
+		Usage:   "Reject unsigned heartbeats, instead of recording them in metrics",
+		EnvVars: prefixEnvVars("REQUIRE_SIGNED"),
+	}
 )
 
 var Flags []cli.Flag
@@ -39,6 +74,11 @@ func init() {
 	Flags = []cli.Flag{
 		HTTPAddrFlag,
 		HTTPPortFlag,
+		HistoryDirFlag,
+		HistoryMaxPeersFlag,
+		HistoryMaxEntriesFlag,
+		HistoryRetentionFlag,
+		RequireSignedFlag,
 	}
 
 	Flags = append(Flags, oplog.CLIFlags(envPrefix)...)
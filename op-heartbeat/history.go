@@ -0,0 +1,293 @@
+package op_heartbeat
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
+)
+
+const historyFileExt = ".jsonl"
+
+// historyPruneInterval is how often the histories of peers that haven't sent a heartbeat within the retention are removed.
+const historyPruneInterval = time.Hour
+
+var (
+	ErrStaleHeartbeat = errors.New("heartbeat is not newer than the last recorded heartbeat of the peer")
+	ErrHistoryFull    = errors.New("history is kept for the max number of peers already")
+)
+
+// HistoryEntry is a verified heartbeat, as recorded in the history of a peer.
+type HistoryEntry struct {
+	ReceivedAt time.Time `json:"receivedAt"`
+	IP         string    `json:"ip"`
+	heartbeat.Payload
+}
+
+// peerHistory is the in-memory state of the history file of a peer.
+type peerHistory struct {
+	// loaded is set once the last recorded payload timestamp and the number of entries are read from disk
+	loaded bool
+	// last recorded payload timestamp, to reject replayed heartbeats
+	lastSeen uint64
+	entries  int
+	// lastWrite is when a heartbeat of the peer was last recorded
+	lastWrite time.Time
+}
+
+// History keeps an on-disk history of the verified heartbeats of each peer.
+// The heartbeats of each peer are appended to a JSON-lines file named after the peer ID.
+// The history is kept for at most maxPeers peers, of at most maxEntries heartbeats each. Once the history of a peer
+// exceeds maxEntries, it is compacted to its newest half. Heartbeats received before the retention are dropped on
+// compaction, and the histories of peers that haven't sent a heartbeat within the retention are removed.
+type History struct {
+	dir        string
+	maxPeers   int
+	maxEntries int
+	retention  time.Duration
+
+	mu        sync.Mutex
+	peers     map[peer.ID]*peerHistory
+	lastPrune time.Time
+}
+
+func NewHistory(dir string, maxPeers int, maxEntries int, retention time.Duration) (*History, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create history dir: %w", err)
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history dir: %w", err)
+	}
+	peers := make(map[peer.ID]*peerHistory)
+	for _, file := range files {
+		name, ok := strings.CutSuffix(file.Name(), historyFileExt)
+		if !ok || file.IsDir() {
+			continue
+		}
+		id, err := peer.Decode(name)
+		if err != nil {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat history of peer %s: %w", id, err)
+		}
+		peers[id] = &peerHistory{lastWrite: info.ModTime()}
+	}
+	return &History{
+		dir:        dir,
+		maxPeers:   maxPeers,
+		maxEntries: maxEntries,
+		retention:  retention,
+		peers:      peers,
+	}, nil
+}
+
+func (h *History) path(id peer.ID) string {
+	return filepath.Join(h.dir, id.String()+historyFileExt)
+}
+
+// Record appends the verified payload to the history of the peer.
+// Payloads that are not newer than the last recorded payload of the peer are rejected with ErrStaleHeartbeat.
+// Payloads of new peers are rejected with ErrHistoryFull once the history is kept for the max number of peers.
+func (h *History) Record(payload *heartbeat.Payload, ip string, receivedAt time.Time) error {
+	id, err := peer.Decode(payload.PeerID)
+	if err != nil {
+		return fmt.Errorf("invalid peer ID %q: %w", payload.PeerID, err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if receivedAt.Sub(h.lastPrune) >= historyPruneInterval {
+		if err := h.prune(receivedAt); err != nil {
+			return err
+		}
+	}
+	ph, ok := h.peers[id]
+	if !ok {
+		if len(h.peers) >= h.maxPeers {
+			return ErrHistoryFull
+		}
+		ph = &peerHistory{loaded: true}
+		h.peers[id] = ph
+	}
+	if !ph.loaded {
+		// After a restart, continue from what is on disk
+		if err := h.loadLast(id, ph); err != nil {
+			return err
+		}
+	}
+	if payload.Timestamp <= ph.lastSeen {
+		return ErrStaleHeartbeat
+	}
+
+	data, err := json.Marshal(&HistoryEntry{ReceivedAt: receivedAt.UTC(), IP: ip, Payload: *payload})
+	if err != nil {
+		return fmt.Errorf("failed to encode history entry: %w", err)
+	}
+	f, err := os.OpenFile(h.path(id), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open history of peer %s: %w", id, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write history of peer %s: %w", id, err)
+	}
+	ph.lastSeen = payload.Timestamp
+	ph.lastWrite = receivedAt
+	ph.entries++
+	if ph.entries > h.maxEntries {
+		return h.compact(id, ph, receivedAt)
+	}
+	return nil
+}
+
+// Load returns the recorded history of the peer, oldest first.
+func (h *History) Load(id peer.ID) ([]HistoryEntry, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.load(id)
+}
+
+func (h *History) load(id peer.ID) ([]HistoryEntry, error) {
+	f, err := os.Open(h.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open history of peer %s: %w", id, err)
+	}
+	defer f.Close()
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), HTTPMaxBodySize)
+	for scanner.Scan() {
+		var entry HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode history of peer %s: %w", id, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history of peer %s: %w", id, err)
+	}
+	return entries, nil
+}
+
+// loadLast reads the last recorded payload timestamp of the peer from the last line of its history.
+// The number of entries is not counted, it is assumed to be at the max so that the next heartbeat compacts the history.
+func (h *History) loadLast(id peer.ID, ph *peerHistory) error {
+	f, err := os.Open(h.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		ph.loaded = true
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to open history of peer %s: %w", id, err)
+	}
+	defer f.Close()
+	line, err := lastLine(f)
+	if err != nil {
+		return fmt.Errorf("failed to read history of peer %s: %w", id, err)
+	}
+	if len(line) > 0 {
+		var entry HistoryEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("failed to decode history of peer %s: %w", id, err)
+		}
+		ph.lastSeen = entry.Timestamp
+	}
+	ph.entries = h.maxEntries
+	ph.loaded = true
+	return nil
+}
+
+// compact rewrites the history of the peer with its newest half of entries, received within the retention.
+func (h *History) compact(id peer.ID, ph *peerHistory, now time.Time) error {
+	entries, err := h.load(id)
+	if err != nil {
+		return err
+	}
+	if keep := max(h.maxEntries/2, 1); len(entries) > keep {
+		entries = entries[len(entries)-keep:]
+	}
+	cutoff := now.Add(-h.retention)
+	for len(entries) > 0 && entries[0].ReceivedAt.Before(cutoff) {
+		entries = entries[1:]
+	}
+
+	var buf bytes.Buffer
+	for i := range entries {
+		data, err := json.Marshal(&entries[i])
+		if err != nil {
+			return fmt.Errorf("failed to encode history entry: %w", err)
+		}
+		buf.Write(append(data, '\n'))
+	}
+	tmp := h.path(id) + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write history of peer %s: %w", id, err)
+	}
+	if err := os.Rename(tmp, h.path(id)); err != nil {
+		return fmt.Errorf("failed to replace history of peer %s: %w", id, err)
+	}
+	ph.entries = len(entries)
+	return nil
+}
+
+// prune removes the histories of the peers that haven't sent a heartbeat within the retention.
+func (h *History) prune(now time.Time) error {
+	cutoff := now.Add(-h.retention)
+	for id, ph := range h.peers {
+		if !ph.lastWrite.Before(cutoff) {
+			continue
+		}
+		if err := os.Remove(h.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove history of peer %s: %w", id, err)
+		}
+		delete(h.peers, id)
+	}
+	h.lastPrune = now
+	return nil
+}
+
+// lastLine returns the last line of the file, without reading the lines before it.
+func lastLine(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	end := info.Size()
+	var line []byte
+	chunk := make([]byte, 4096)
+	for end > 0 {
+		n := int64(len(chunk))
+		if end < n {
+			n = end
+		}
+		if _, err := f.ReadAt(chunk[:n], end-n); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		end -= n
+		line = append(append([]byte(nil), chunk[:n]...), line...)
+		// skip the newline terminating the last line
+		trimmed := bytes.TrimRight(line, "\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+		if int64(len(line)) > HTTPMaxBodySize {
+			return nil, errors.New("last line exceeds the max heartbeat size")
+		}
+	}
+	return bytes.TrimRight(line, "\n"), nil
+}
@@ -0,0 +1,114 @@
+package op_heartbeat
+
+import (
+	"crypto/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
+)
+
+func newTestPeer(t *testing.T) peer.ID {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	return id
+}
+
+func TestHistoryMaxEntries(t *testing.T) {
+	dir := t.TempDir()
+	history, err := NewHistory(dir, 10, 4, time.Hour)
+	require.NoError(t, err)
+	id := newTestPeer(t)
+
+	now := time.Now()
+	for i := uint64(1); i <= 5; i++ {
+		require.NoError(t, history.Record(&heartbeat.Payload{PeerID: id.String(), Timestamp: i}, "1.2.3.4", now))
+	}
+	// exceeding the max entries compacts the history to its newest half
+	entries, err := history.Load(id)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(4), entries[0].Timestamp)
+	require.Equal(t, uint64(5), entries[1].Timestamp)
+
+	// after a restart, the last heartbeat is read back to detect replays
+	history, err = NewHistory(dir, 10, 4, time.Hour)
+	require.NoError(t, err)
+	require.ErrorIs(t, history.Record(&heartbeat.Payload{PeerID: id.String(), Timestamp: 5}, "1.2.3.4", now), ErrStaleHeartbeat)
+	require.NoError(t, history.Record(&heartbeat.Payload{PeerID: id.String(), Timestamp: 6}, "1.2.3.4", now))
+	entries, err = history.Load(id)
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "the restored history is compacted, as its size is unknown")
+	require.Equal(t, uint64(6), entries[1].Timestamp)
+}
+
+func TestHistoryMaxPeers(t *testing.T) {
+	history, err := NewHistory(t.TempDir(), 2, 10, time.Hour)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, history.Record(&heartbeat.Payload{PeerID: newTestPeer(t).String(), Timestamp: 1}, "1.2.3.4", now))
+	}
+	require.ErrorIs(t, history.Record(&heartbeat.Payload{PeerID: newTestPeer(t).String(), Timestamp: 1}, "1.2.3.4", now), ErrHistoryFull)
+}
+
+func TestHistoryRetention(t *testing.T) {
+	history, err := NewHistory(t.TempDir(), 2, 6, time.Hour)
+	require.NoError(t, err)
+	stale, active := newTestPeer(t), newTestPeer(t)
+
+	now := time.Now()
+	require.NoError(t, history.Record(&heartbeat.Payload{PeerID: stale.String(), Timestamp: 1}, "1.2.3.4", now))
+	for i := uint64(1); i <= 5; i++ {
+		require.NoError(t, history.Record(&heartbeat.Payload{PeerID: active.String(), Timestamp: i}, "1.2.3.4", now))
+	}
+	require.NoError(t, history.Record(&heartbeat.Payload{PeerID: active.String(), Timestamp: 6}, "1.2.3.4", now.Add(45*time.Minute)))
+	later := now.Add(90 * time.Minute)
+	require.NoError(t, history.Record(&heartbeat.Payload{PeerID: active.String(), Timestamp: 7}, "1.2.3.4", later))
+
+	// heartbeats received before the retention are dropped on compaction
+	entries, err := history.Load(active)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(6), entries[0].Timestamp)
+	require.Equal(t, uint64(7), entries[1].Timestamp)
+
+	// the peer without heartbeats within the retention is removed, making room for a new peer
+	_, err = os.Stat(history.path(stale))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.NoError(t, history.Record(&heartbeat.Payload{PeerID: newTestPeer(t).String(), Timestamp: 1}, "1.2.3.4", later))
+}
+
+func TestLastLine(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "history")
+	require.NoError(t, err)
+	defer f.Close()
+
+	line, err := lastLine(f)
+	require.NoError(t, err)
+	require.Empty(t, line)
+
+	long := make([]byte, 10_000)
+	for i := range long {
+		long[i] = 'a'
+	}
+	_, err = f.Write(append([]byte("first\n"), append(long, '\n')...))
+	require.NoError(t, err)
+	line, err = lastLine(f)
+	require.NoError(t, err)
+	require.Equal(t, long, line)
+
+	_, err = f.Write([]byte("last\n"))
+	require.NoError(t, err)
+	line, err = lastLine(f)
+	require.NoError(t, err)
+	require.Equal(t, []byte("last"), line)
+}
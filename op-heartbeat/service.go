@@ -29,6 +29,8 @@ import (
 const (
 	HTTPMaxHeaderSize = 10 * 1024
 	HTTPMaxBodySize   = 1024 * 1024
+	// MaxClockSkew bounds how far the timestamp of a signed heartbeat may be from the time it is received.
+	MaxClockSkew = 5 * time.Minute
 )
 
 func Main(version string) func(ctx *cli.Context) error {
@@ -107,11 +109,21 @@ func Start(ctx context.Context, l log.Logger, cfg Config, version string) (*Hear
 		return nil, fmt.Errorf("failed to start pprof service: %w", err)
 	}
 
+	var history *History
+	if cfg.HistoryDir != "" {
+		h, err := NewHistory(cfg.HistoryDir, cfg.HistoryMaxPeers, cfg.HistoryMaxEntries, cfg.HistoryRetention)
+		if err != nil {
+			return nil, errors.Join(err, hs.Stop(ctx))
+		}
+		history = h
+		l.Info("recording heartbeat history", "dir", cfg.HistoryDir, "max_peers", cfg.HistoryMaxPeers, "max_entries", cfg.HistoryMaxEntries, "retention", cfg.HistoryRetention)
+	}
+
 	metrics := NewMetrics(registry)
 	metrics.RecordVersion(version)
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", HealthzHandler)
-	mux.Handle("/", Handler(l, metrics))
+	mux.Handle("/", Handler(l, metrics, history, cfg.RequireSigned))
 	recorder := opmetrics.NewPromHTTPRecorder(registry, MetricsNamespace)
 	mw := opmetrics.NewHTTPRecordingMiddleware(recorder, mux)
 
@@ -133,7 +145,11 @@ func Start(ctx context.Context, l log.Logger, cfg Config, version string) (*Hear
 	return hs, nil
 }
 
-func Handler(l log.Logger, metrics Metrics) http.HandlerFunc {
+// Handler records heartbeats in metrics.
+// Signed heartbeats must be signed by the key of the peer ID they claim,
+// and are recorded in the history of the peer, if a history is provided.
+// Unsigned heartbeats are only recorded in metrics, or rejected if requireSigned is set.
+func Handler(l log.Logger, metrics Metrics, history *History, requireSigned bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ipStr := r.Header.Get("X-Forwarded-For")
 		// XFF can be a comma-separated list. Left-most is the original client.
@@ -147,14 +163,60 @@ func Handler(l log.Logger, metrics Metrics) http.HandlerFunc {
 			"remote_addr", r.RemoteAddr,
 		)
 
-		var payload heartbeat.Payload
-		dec := json.NewDecoder(io.LimitReader(r.Body, int64(HTTPMaxBodySize)))
-		if err := dec.Decode(&payload); err != nil {
+		body, err := io.ReadAll(io.LimitReader(r.Body, int64(HTTPMaxBodySize)))
+		if err != nil {
+			innerL.Info("error reading request payload", "err", err)
+			w.WriteHeader(400)
+			return
+		}
+		var signed heartbeat.SignedPayload
+		if err := json.Unmarshal(body, &signed); err != nil {
 			innerL.Info("error decoding request payload", "err", err)
 			w.WriteHeader(400)
 			return
 		}
 
+		var payload heartbeat.Payload
+		if len(signed.Payload) == 0 {
+			if requireSigned {
+				innerL.Info("rejecting unsigned heartbeat")
+				w.WriteHeader(401)
+				return
+			}
+			// legacy unsigned heartbeat
+			if err := json.Unmarshal(body, &payload); err != nil {
+				innerL.Info("error decoding request payload", "err", err)
+				w.WriteHeader(400)
+				return
+			}
+		} else {
+			verified, err := heartbeat.VerifyPayload(&signed)
+			if err != nil {
+				innerL.Info("error verifying signed payload", "err", err)
+				w.WriteHeader(401)
+				return
+			}
+			now := time.Now()
+			signedAt := time.Unix(int64(verified.Timestamp), 0)
+			if signedAt.Before(now.Add(-MaxClockSkew)) || signedAt.After(now.Add(MaxClockSkew)) {
+				innerL.Info("signed payload timestamp out of range", "timestamp", verified.Timestamp)
+				w.WriteHeader(400)
+				return
+			}
+			if history != nil {
+				if err := history.Record(verified, ipStr, now); errors.Is(err, ErrStaleHeartbeat) {
+					innerL.Info("rejecting replayed heartbeat", "peer_id", verified.PeerID, "timestamp", verified.Timestamp)
+					w.WriteHeader(400)
+					return
+				} else if errors.Is(err, ErrHistoryFull) {
+					innerL.Warn("not recording heartbeat history of new peer", "peer_id", verified.PeerID, "err", err)
+				} else if err != nil {
+					innerL.Error("error recording heartbeat history", "err", err)
+				}
+			}
+			payload = *verified
+		}
+
 		innerL.Info(
 			"got heartbeat",
 			"version", payload.Version,
@@ -162,6 +224,8 @@ func Handler(l log.Logger, metrics Metrics) http.HandlerFunc {
 			"moniker", payload.Moniker,
 			"peer_id", payload.PeerID,
 			"chain_id", payload.ChainID,
+			"signed", len(signed.Payload) != 0,
+			"unsafe_l2", payload.UnsafeL2,
 		)
 
 		metrics.RecordHeartbeat(payload, ipStr)
@@ -3,14 +3,18 @@ package op_heartbeat
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
@@ -129,6 +133,87 @@ func TestService(t *testing.T) {
 	}
 }
 
+func TestSignedHeartbeats(t *testing.T) {
+	httpPort := freePort(t)
+	historyDir := t.TempDir()
+	cfg := Config{
+		HTTPAddr:          "127.0.0.1",
+		HTTPPort:          httpPort,
+		HistoryDir:        historyDir,
+		HistoryMaxPeers:   10,
+		HistoryMaxEntries: 10,
+		HistoryRetention:  time.Hour,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv, err := Start(ctx, log.New(), cfg, "foobar")
+	require.NoError(t, err)
+	defer cancel()
+	defer func() {
+		require.NoError(t, srv.Stop(ctx), "close heartbeat server")
+	}()
+
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	other, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+
+	post := func(t *testing.T, signer heartbeat.Signer, payload heartbeat.Payload) int {
+		signed, err := heartbeat.SignPayload(signer, &payload)
+		require.NoError(t, err)
+		data, err := json.Marshal(signed)
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("http://127.0.0.1:%d", httpPort), bytes.NewReader(data))
+		require.NoError(t, err)
+		req.Header.Set("X-Forwarded-For", "1.2.3.200")
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	now := uint64(time.Now().Unix())
+	payload := heartbeat.Payload{
+		Version:   "v0.1.0-beta.1",
+		Moniker:   "alice",
+		PeerID:    id.String(),
+		ChainID:   10,
+		Timestamp: now,
+	}
+
+	require.Equal(t, 204, post(t, priv, payload))
+	// replays are rejected
+	require.Equal(t, 400, post(t, priv, payload))
+	// the signature must match the claimed peer ID
+	spoofed := payload
+	spoofed.Moniker = "mallory"
+	spoofed.Timestamp = now + 1
+	require.Equal(t, 401, post(t, other, spoofed))
+	// timestamps must be recent
+	stale := payload
+	stale.Timestamp = now - uint64((2 * MaxClockSkew).Seconds())
+	require.Equal(t, 400, post(t, priv, stale))
+
+	next := payload
+	next.Timestamp = now + 1
+	next.Version = "v0.1.0-beta.2"
+	require.Equal(t, 204, post(t, priv, next))
+
+	history, err := NewHistory(historyDir, 10, 10, time.Hour)
+	require.NoError(t, err)
+	entries, err := history.Load(id)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "1.2.3.200", entries[0].IP)
+	require.Equal(t, payload, entries[0].Payload)
+	require.Equal(t, next, entries[1].Payload)
+
+	// the history survives restarts: replays are still detected
+	require.ErrorIs(t, history.Record(&next, "1.2.3.200", time.Now()), ErrStaleHeartbeat)
+}
+
 func freePort(t *testing.T) int {
 	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
 	require.NoError(t, err)
@@ -137,3 +222,48 @@ func freePort(t *testing.T) int {
 	defer l.Close()
 	return l.Addr().(*net.TCPAddr).Port
 }
+
+func TestRequireSigned(t *testing.T) {
+	httpPort := freePort(t)
+	cfg := Config{
+		HTTPAddr:      "127.0.0.1",
+		HTTPPort:      httpPort,
+		RequireSigned: true,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv, err := Start(ctx, log.New(), cfg, "foobar")
+	require.NoError(t, err)
+	defer cancel()
+	defer func() {
+		require.NoError(t, srv.Stop(ctx), "close heartbeat server")
+	}()
+
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	payload := heartbeat.Payload{
+		Version:   "v0.1.0-beta.1",
+		PeerID:    id.String(),
+		ChainID:   10,
+		Timestamp: uint64(time.Now().Unix()),
+	}
+
+	post := func(t *testing.T, body any) int {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("http://127.0.0.1:%d", httpPort), bytes.NewReader(data))
+		require.NoError(t, err)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	require.Equal(t, 401, post(t, payload), "unsigned heartbeats are rejected")
+
+	signed, err := heartbeat.SignPayload(priv, &payload)
+	require.NoError(t, err)
+	require.Equal(t, 204, post(t, signed))
+}
@@ -19,7 +19,9 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/srcmap"
 )
 
 var (
@@ -52,6 +54,10 @@ var (
 		Name:  "outfile.rollup",
 		Usage: "Path to rollup output file",
 	}
+	artifactsFlag = &cli.StringSliceFlag{
+		Name:  "artifacts",
+		Usage: "Paths to hardhat artifacts with build info, to resolve Solidity stack traces of failed predeploy deployments",
+	}
 
 	l1AllocsFlag = &cli.StringFlag{
 		Name:  "l1-allocs",
@@ -77,6 +83,7 @@ var (
 		l1DeploymentsFlag,
 		outfileL2Flag,
 		outfileRollupFlag,
+		artifactsFlag,
 	}
 )
 
@@ -234,8 +241,13 @@ var Subcommands = cli.Commands{
 
 			log.Info("Using L1 Start Block", "number", l1StartBlock.Number(), "hash", l1StartBlock.Hash().Hex())
 
+			srcMaps, err := loadPredeploySourceMaps(ctx.StringSlice("artifacts"))
+			if err != nil {
+				return err
+			}
+
 			// Build the L2 genesis block
-			l2Genesis, err := genesis.BuildL2Genesis(config, l1StartBlock)
+			l2Genesis, err := genesis.BuildL2Genesis(config, l1StartBlock, srcMaps)
 			if err != nil {
 				return fmt.Errorf("error creating l2 genesis: %w", err)
 			}
@@ -257,6 +269,25 @@ var Subcommands = cli.Commands{
 	},
 }
 
+// loadPredeploySourceMaps loads the source maps of the predeploys from the hardhat artifacts, if any.
+// Predeploys without build info are skipped, so that their failures are traced without Solidity sources.
+func loadPredeploySourceMaps(artifacts []string) (srcmap.SourceMaps, error) {
+	if len(artifacts) == 0 {
+		return nil, nil
+	}
+	hh, err := hardhat.New("", artifacts, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read artifacts: %w", err)
+	}
+	srcMaps := srcmap.NewContractSourceMaps()
+	for name := range predeploys.Predeploys {
+		if err := srcMaps.AddHardhat(hh, name); err != nil {
+			log.Warn("No source maps for predeploy", "name", name, "err", err)
+		}
+	}
+	return srcMaps, nil
+}
+
 // writeJSONFile will write a JSON file to disk at the given path
 // containing the JSON serialized input value.
 func writeJSONFile(outfile string, input any) error {
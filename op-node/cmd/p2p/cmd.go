@@ -102,4 +102,5 @@ var Subcommands = cli.Commands{
 			return nil
 		},
 	},
+	crawlCommand,
 }
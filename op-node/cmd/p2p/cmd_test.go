@@ -8,6 +8,8 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
 )
 
 func TestPrivPub2PeerID(t *testing.T) {
@@ -37,3 +39,31 @@ func TestPrivPub2PeerID(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestWriteCensusCSV(t *testing.T) {
+	census := []*p2p.CrawledNode{
+		{
+			NodeID:          "aa",
+			PeerID:          "16Uiu2",
+			ChainID:         10,
+			Reachable:       true,
+			AgentVersion:    "optimism",
+			Addresses:       []string{"/ip4/127.0.0.1/tcp/9222", "/ip4/10.0.0.1/tcp/9222"},
+			Protocols:       []string{"/ipfs/id/1.0.0", "/opstack/req/payload_by_number/10/0"},
+			PayloadByNumber: true,
+			ENR:             "enr:-abc",
+		},
+		{
+			NodeID:    "bb",
+			ChainID:   10,
+			Error:     "dial backoff, timeout",
+			Addresses: []string{},
+			Protocols: []string{},
+		},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, WriteCensusCSV(&buf, census))
+	require.Equal(t, "node_id,peer_id,chain_id,version,reachable,agent_version,protocol_version,payload_by_number,addresses,protocols,error,enr\n"+
+		"aa,16Uiu2,10,0,true,optimism,,true,/ip4/127.0.0.1/tcp/9222 /ip4/10.0.0.1/tcp/9222,/ipfs/id/1.0.0 /opstack/req/payload_by_number/10/0,,enr:-abc\n"+
+		"bb,,10,0,false,,,false,,,\"dial backoff, timeout\",\n", buf.String())
+}
@@ -0,0 +1,164 @@
+package p2p
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+var (
+	crawlChainIDFlag = &cli.Uint64Flag{
+		Name:     "chain-id",
+		Usage:    "L2 chain ID of the nodes to crawl",
+		Required: true,
+	}
+	crawlBootnodesFlag = &cli.StringSliceFlag{
+		Name:  "bootnodes",
+		Usage: "Comma-separated enode/ENR URLs to start the crawl from. Defaults to the OP Stack bootnodes",
+	}
+	crawlListenIPFlag = &cli.StringFlag{
+		Name:  "listen-ip",
+		Usage: "IP to bind the discovery UDP socket to",
+		Value: "0.0.0.0",
+	}
+	crawlDurationFlag = &cli.DurationFlag{
+		Name:  "duration",
+		Usage: "How long to walk the DHT for",
+		Value: time.Minute,
+	}
+	crawlHandshakeTimeoutFlag = &cli.DurationFlag{
+		Name:  "handshake-timeout",
+		Usage: "Timeout of the libp2p handshake with each discovered node",
+		Value: 10 * time.Second,
+	}
+	crawlConcurrencyFlag = &cli.IntFlag{
+		Name:  "concurrency",
+		Usage: "Number of nodes to handshake with in parallel",
+		Value: 16,
+	}
+	crawlFormatFlag = &cli.StringFlag{
+		Name:  "format",
+		Usage: "Output format of the census, json or csv",
+		Value: "json",
+	}
+	crawlOutputFlag = &cli.PathFlag{
+		Name:  "output",
+		Usage: "Path to write the census to. Defaults to stdout",
+	}
+)
+
+var crawlCommand = &cli.Command{
+	Name:  "crawl",
+	Usage: "Walks the discv5 DHT for nodes of an OP Stack chain, and outputs a census of the nodes",
+	Flags: []cli.Flag{
+		crawlChainIDFlag,
+		crawlBootnodesFlag,
+		crawlListenIPFlag,
+		crawlDurationFlag,
+		crawlHandshakeTimeoutFlag,
+		crawlConcurrencyFlag,
+		crawlFormatFlag,
+		crawlOutputFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.ReadCLIConfig(ctx))
+
+		format := ctx.String(crawlFormatFlag.Name)
+		if format != "json" && format != "csv" {
+			return fmt.Errorf("unknown output format %q", format)
+		}
+		listenIP := net.ParseIP(ctx.String(crawlListenIPFlag.Name))
+		if listenIP == nil {
+			return fmt.Errorf("invalid listen IP %q", ctx.String(crawlListenIPFlag.Name))
+		}
+		bootnodes := p2p.DefaultBootnodes
+		if ctx.IsSet(crawlBootnodesFlag.Name) {
+			bootnodes = nil
+			for _, url := range ctx.StringSlice(crawlBootnodesFlag.Name) {
+				node, err := enode.Parse(enode.ValidSchemes, strings.TrimSpace(url))
+				if err != nil {
+					return fmt.Errorf("invalid bootnode %q: %w", url, err)
+				}
+				bootnodes = append(bootnodes, node)
+			}
+		}
+
+		census, err := p2p.Crawl(ctx.Context, logger, &p2p.CrawlConfig{
+			L2ChainID:        new(big.Int).SetUint64(ctx.Uint64(crawlChainIDFlag.Name)),
+			Bootnodes:        bootnodes,
+			ListenIP:         listenIP,
+			Duration:         ctx.Duration(crawlDurationFlag.Name),
+			HandshakeTimeout: ctx.Duration(crawlHandshakeTimeoutFlag.Name),
+			Concurrency:      ctx.Int(crawlConcurrencyFlag.Name),
+			UserAgent:        "op-node-crawler",
+		})
+		if err != nil {
+			return fmt.Errorf("crawl failed: %w", err)
+		}
+
+		out := io.Writer(os.Stdout)
+		if path := ctx.Path(crawlOutputFlag.Name); path != "" {
+			f, err := os.Create(path)
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+			defer f.Close()
+			out = f
+		}
+		if format == "csv" {
+			return WriteCensusCSV(out, census)
+		}
+		return WriteCensusJSON(out, census)
+	},
+}
+
+func WriteCensusJSON(w io.Writer, census []*p2p.CrawledNode) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(census)
+}
+
+// WriteCensusCSV writes one row per node. Lists of addresses and protocols are space-separated.
+func WriteCensusCSV(w io.Writer, census []*p2p.CrawledNode) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{
+		"node_id", "peer_id", "chain_id", "version", "reachable", "agent_version", "protocol_version",
+		"payload_by_number", "addresses", "protocols", "error", "enr",
+	}); err != nil {
+		return err
+	}
+	for _, n := range census {
+		if err := cw.Write([]string{
+			n.NodeID,
+			n.PeerID,
+			strconv.FormatUint(n.ChainID, 10),
+			strconv.FormatUint(n.Version, 10),
+			strconv.FormatBool(n.Reachable),
+			n.AgentVersion,
+			n.ProtocolVersion,
+			strconv.FormatBool(n.PayloadByNumber),
+			strings.Join(n.Addresses, " "),
+			strings.Join(n.Protocols, " "),
+			n.Error,
+			n.ENR,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,385 @@
+package withdrawal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/withdrawals"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+var (
+	l1RPCFlag = &cli.StringFlag{
+		Name:     txmgr.L1RPCFlagName,
+		Usage:    "RPC URL for an Ethereum L1 node",
+		Required: true,
+	}
+	l2RPCFlag = &cli.StringFlag{
+		Name:     "l2-eth-rpc",
+		Usage:    "RPC URL for an L2 execution node. Must serve eth_getProof for the blocks proposed on L1",
+		Required: true,
+	}
+	portalAddressFlag = &cli.StringFlag{
+		Name:     "portal-address",
+		Usage:    "Address of the OptimismPortal contract",
+		Required: true,
+	}
+	l2ooAddressFlag = &cli.StringFlag{
+		Name:  "l2oo-address",
+		Usage: "Address of the L2OutputOracle contract, to prove against its outputs. Cannot be used with --dgf-address",
+	}
+	dgfAddressFlag = &cli.StringFlag{
+		Name:  "dgf-address",
+		Usage: "Address of the DisputeGameFactory contract, to prove against its games. Cannot be used with --l2oo-address",
+	}
+	gameTypeFlag = &cli.UintFlag{
+		Name:  "game-type",
+		Usage: "Type of the dispute games to prove against. Only used with --dgf-address",
+		Value: 0,
+	}
+	txHashFlag = &cli.StringFlag{
+		Name:     "tx-hash",
+		Usage:    "Hash of the L2 transaction that initiated the withdrawal",
+		Required: true,
+	}
+	pollIntervalFlag = &cli.DurationFlag{
+		Name:  "poll-interval",
+		Usage: "How often to check whether the withdrawal can be finalized",
+		Value: 12 * time.Second,
+	}
+
+	withdrawalFlags = append([]cli.Flag{
+		l1RPCFlag,
+		l2RPCFlag,
+		portalAddressFlag,
+		l2ooAddressFlag,
+		dgfAddressFlag,
+		gameTypeFlag,
+		txHashFlag,
+		pollIntervalFlag,
+	}, txmgr.CLIFlags(flags.EnvVarPrefix)...)
+)
+
+var Subcommands = cli.Commands{
+	{
+		Name:   "prove",
+		Usage:  "Proves a withdrawal on L1",
+		Flags:  withdrawalFlags,
+		Action: withdrawalAction((*withdrawer).prove),
+	},
+	{
+		Name:   "finalize",
+		Usage:  "Waits until a proven withdrawal can be finalized, and finalizes it on L1",
+		Flags:  withdrawalFlags,
+		Action: withdrawalAction((*withdrawer).finalize),
+	},
+	{
+		Name:  "run",
+		Usage: "Proves a withdrawal, waits until it can be finalized, and finalizes it on L1",
+		Flags: withdrawalFlags,
+		Action: withdrawalAction(func(w *withdrawer, ctx context.Context) error {
+			if err := w.prove(ctx); err != nil {
+				return err
+			}
+			return w.finalize(ctx)
+		}),
+	},
+}
+
+// withdrawer proves and finalizes a single withdrawal, sending the L1 transactions through the txmgr.
+type withdrawer struct {
+	log          log.Logger
+	l1           *ethclient.Client
+	l2           *ethclient.Client
+	txMgr        txmgr.TxManager
+	pollInterval time.Duration
+
+	txHash    common.Hash
+	portal    common.Address
+	portalABI *abi.ABI
+	// Exactly one of l2oo and dgf is set
+	l2oo     *bindings.L2OutputOracleCaller
+	dgf      *bindings.DisputeGameFactoryCaller
+	gameType uint8
+}
+
+func withdrawalAction(fn func(w *withdrawer, ctx context.Context) error) cli.ActionFunc {
+	return func(cliCtx *cli.Context) error {
+		logger := oplog.NewLogger(oplog.AppOut(cliCtx), oplog.ReadCLIConfig(cliCtx))
+		w, err := newWithdrawer(cliCtx, logger)
+		if err != nil {
+			return err
+		}
+		defer w.close()
+		return fn(w, cliCtx.Context)
+	}
+}
+
+func newWithdrawer(cliCtx *cli.Context, logger log.Logger) (*withdrawer, error) {
+	if cliCtx.IsSet(l2ooAddressFlag.Name) == cliCtx.IsSet(dgfAddressFlag.Name) {
+		return nil, fmt.Errorf("exactly one of --%s and --%s must be set", l2ooAddressFlag.Name, dgfAddressFlag.Name)
+	}
+	if cliCtx.Uint(gameTypeFlag.Name) > 255 {
+		return nil, fmt.Errorf("invalid game type %d", cliCtx.Uint(gameTypeFlag.Name))
+	}
+	txHash, err := parseHash(cliCtx.String(txHashFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", txHashFlag.Name, err)
+	}
+	portal, err := parseAddress(cliCtx.String(portalAddressFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", portalAddressFlag.Name, err)
+	}
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	txMgrCfg := txmgr.ReadCLIConfig(cliCtx)
+	if err := txMgrCfg.Check(); err != nil {
+		return nil, err
+	}
+	l1, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(l1RPCFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	w := &withdrawer{
+		log:          logger,
+		l1:           l1,
+		pollInterval: cliCtx.Duration(pollIntervalFlag.Name),
+		txHash:       txHash,
+		portal:       portal,
+		portalABI:    portalABI,
+		gameType:     uint8(cliCtx.Uint(gameTypeFlag.Name)),
+	}
+	if err := w.init(cliCtx, txMgrCfg); err != nil {
+		w.close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *withdrawer) init(cliCtx *cli.Context, txMgrCfg txmgr.CLIConfig) error {
+	l2, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(l2RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	w.l2 = l2
+
+	// The L2OutputOracle based portal reads the proven output from the oracle, while the
+	// fault proofs portal takes the index of a dispute game, so the proof must match the portal
+	portal, err := bindings.NewOptimismPortalCaller(w.portal, w.l1)
+	if err != nil {
+		return err
+	}
+	faultProofs, err := withdrawals.IsFaultProofsPortal(cliCtx.Context, portal)
+	if err != nil {
+		return err
+	}
+	if faultProofs && cliCtx.IsSet(l2ooAddressFlag.Name) {
+		return fmt.Errorf("portal %v proves withdrawals against dispute games, use --%s instead of --%s", w.portal, dgfAddressFlag.Name, l2ooAddressFlag.Name)
+	} else if !faultProofs && cliCtx.IsSet(dgfAddressFlag.Name) {
+		return fmt.Errorf("portal %v proves withdrawals against the L2OutputOracle, use --%s instead of --%s", w.portal, l2ooAddressFlag.Name, dgfAddressFlag.Name)
+	}
+
+	if cliCtx.IsSet(l2ooAddressFlag.Name) {
+		addr, err := parseAddress(cliCtx.String(l2ooAddressFlag.Name))
+		if err != nil {
+			return fmt.Errorf("invalid --%s: %w", l2ooAddressFlag.Name, err)
+		}
+		if w.l2oo, err = bindings.NewL2OutputOracleCaller(addr, w.l1); err != nil {
+			return err
+		}
+	} else {
+		addr, err := parseAddress(cliCtx.String(dgfAddressFlag.Name))
+		if err != nil {
+			return fmt.Errorf("invalid --%s: %w", dgfAddressFlag.Name, err)
+		}
+		if w.dgf, err = bindings.NewDisputeGameFactoryCaller(addr, w.l1); err != nil {
+			return err
+		}
+	}
+	txMgr, err := txmgr.NewSimpleTxManager("withdrawal", w.log, &txmetrics.NoopTxMetrics{}, txMgrCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create tx manager: %w", err)
+	}
+	w.txMgr = txMgr
+	return nil
+}
+
+func (w *withdrawer) close() {
+	if w.txMgr != nil {
+		w.txMgr.Close()
+	}
+	if w.l2 != nil {
+		w.l2.Close()
+	}
+	w.l1.Close()
+}
+
+// proveParameters builds the withdrawal proof against the latest output or dispute game that covers the withdrawal.
+func (w *withdrawer) proveParameters(ctx context.Context) (withdrawals.ProvenWithdrawalParameters, error) {
+	proofCl := gethclient.New(w.l2.Client())
+	if w.dgf != nil {
+		return withdrawals.ProveWithdrawalParametersFaultProofs(ctx, proofCl, w.l2, w.l2, w.txHash, w.dgf, w.l1, w.gameType)
+	}
+
+	receipt, err := w.l2.TransactionReceipt(ctx, w.txHash)
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get withdrawal receipt: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	latest, err := w.l2oo.LatestBlockNumber(opts)
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get latest output block: %w", err)
+	}
+	if latest.Cmp(receipt.BlockNumber) < 0 {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("withdrawal in L2 block %v is not covered by the latest output at L2 block %v", receipt.BlockNumber, latest)
+	}
+	index, err := w.l2oo.GetL2OutputIndexAfter(opts, receipt.BlockNumber)
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get output index: %w", err)
+	}
+	output, err := w.l2oo.GetL2Output(opts, index)
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get output %v: %w", index, err)
+	}
+	header, err := w.l2.HeaderByNumber(ctx, output.L2BlockNumber)
+	if err != nil {
+		return withdrawals.ProvenWithdrawalParameters{}, fmt.Errorf("failed to get L2 header %v: %w", output.L2BlockNumber, err)
+	}
+	return withdrawals.ProveWithdrawalParameters(ctx, proofCl, w.l2, w.txHash, header, w.l2oo)
+}
+
+func (w *withdrawer) prove(ctx context.Context) error {
+	params, err := w.proveParameters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build withdrawal proof: %w", err)
+	}
+	data, err := w.portalABI.Pack("proveWithdrawalTransaction", withdrawalTransaction(params), params.L2OutputIndex, params.OutputRootProof, params.WithdrawalProof)
+	if err != nil {
+		return fmt.Errorf("failed to pack prove transaction: %w", err)
+	}
+	w.log.Info("Proving withdrawal", "tx_hash", w.txHash, "l2_block_hash", common.Hash(params.OutputRootProof.LatestBlockhash), "output_index", params.L2OutputIndex)
+	return w.send(ctx, "prove", data)
+}
+
+func (w *withdrawer) finalize(ctx context.Context) error {
+	receipt, err := w.l2.TransactionReceipt(ctx, w.txHash)
+	if err != nil {
+		return fmt.Errorf("failed to get withdrawal receipt: %w", err)
+	}
+	ev, err := withdrawals.ParseMessagePassed(receipt)
+	if err != nil {
+		return err
+	}
+	portal, err := bindings.NewOptimismPortalCaller(w.portal, w.l1)
+	if err != nil {
+		return err
+	}
+	finalized, err := portal.FinalizedWithdrawals(&bind.CallOpts{Context: ctx}, ev.WithdrawalHash)
+	if err != nil {
+		return fmt.Errorf("failed to check if withdrawal is finalized: %w", err)
+	}
+	if finalized {
+		w.log.Info("Withdrawal already finalized", "tx_hash", w.txHash, "withdrawal_hash", common.Hash(ev.WithdrawalHash))
+		return nil
+	}
+
+	data, err := w.portalABI.Pack("finalizeWithdrawalTransaction", bindings.TypesWithdrawalTransaction{
+		Nonce:    ev.Nonce,
+		Sender:   ev.Sender,
+		Target:   ev.Target,
+		Value:    ev.Value,
+		GasLimit: ev.GasLimit,
+		Data:     ev.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pack finalize transaction: %w", err)
+	}
+	if err := w.waitFinalizable(ctx, data); err != nil {
+		return err
+	}
+	w.log.Info("Finalizing withdrawal", "tx_hash", w.txHash, "withdrawal_hash", common.Hash(ev.WithdrawalHash))
+	return w.send(ctx, "finalize", data)
+}
+
+// waitFinalizable polls until the finalization call no longer reverts. This covers both the finalization period of
+// outputs and the resolution of dispute games, without depending on how the portal determines finalization.
+func (w *withdrawer) waitFinalizable(ctx context.Context, data []byte) error {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+	for {
+		_, err := w.l1.CallContract(ctx, ethereum.CallMsg{From: w.txMgr.From(), To: &w.portal, Data: data}, nil)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		w.log.Info("Withdrawal cannot be finalized yet", "tx_hash", w.txHash, "reason", err)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (w *withdrawer) send(ctx context.Context, name string, data []byte) error {
+	receipt, err := w.txMgr.Send(ctx, txmgr.TxCandidate{
+		TxData: data,
+		To:     &w.portal,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send %s transaction: %w", name, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("%s transaction %v reverted", name, receipt.TxHash)
+	}
+	w.log.Info("Withdrawal transaction included", "action", name, "tx", receipt.TxHash, "l1_block", receipt.BlockNumber)
+	return nil
+}
+
+func withdrawalTransaction(params withdrawals.ProvenWithdrawalParameters) bindings.TypesWithdrawalTransaction {
+	return bindings.TypesWithdrawalTransaction{
+		Nonce:    params.Nonce,
+		Sender:   params.Sender,
+		Target:   params.Target,
+		Value:    params.Value,
+		GasLimit: params.GasLimit,
+		Data:     params.Data,
+	}
+}
+
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+func parseHash(s string) (common.Hash, error) {
+	var h common.Hash
+	if err := h.UnmarshalText([]byte(s)); err != nil {
+		return common.Hash{}, err
+	}
+	return h, nil
+}
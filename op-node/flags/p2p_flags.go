@@ -14,45 +14,47 @@ func p2pEnv(envprefix, v string) []string {
 }
 
 var (
-	DisableP2PName         = "p2p.disable"
-	NoDiscoveryName        = "p2p.no-discovery"
-	ScoringName            = "p2p.scoring"
-	PeerScoringName        = "p2p.scoring.peers"
-	PeerScoreBandsName     = "p2p.score.bands"
-	BanningName            = "p2p.ban.peers"
-	BanningThresholdName   = "p2p.ban.threshold"
-	BanningDurationName    = "p2p.ban.duration"
-	TopicScoringName       = "p2p.scoring.topics"
-	P2PPrivPathName        = "p2p.priv.path"
-	P2PPrivRawName         = "p2p.priv.raw"
-	ListenIPName           = "p2p.listen.ip"
-	ListenTCPPortName      = "p2p.listen.tcp"
-	ListenUDPPortName      = "p2p.listen.udp"
-	AdvertiseIPName        = "p2p.advertise.ip"
-	AdvertiseTCPPortName   = "p2p.advertise.tcp"
-	AdvertiseUDPPortName   = "p2p.advertise.udp"
-	BootnodesName          = "p2p.bootnodes"
-	StaticPeersName        = "p2p.static"
-	NetRestrictName        = "p2p.netrestrict"
-	HostMuxName            = "p2p.mux"
-	HostSecurityName       = "p2p.security"
-	PeersLoName            = "p2p.peers.lo"
-	PeersHiName            = "p2p.peers.hi"
-	PeersGraceName         = "p2p.peers.grace"
-	NATName                = "p2p.nat"
-	UserAgentName          = "p2p.useragent"
-	TimeoutNegotiationName = "p2p.timeout.negotiation"
-	TimeoutAcceptName      = "p2p.timeout.accept"
-	TimeoutDialName        = "p2p.timeout.dial"
-	PeerstorePathName      = "p2p.peerstore.path"
-	DiscoveryPathName      = "p2p.discovery.path"
-	SequencerP2PKeyName    = "p2p.sequencer.key"
-	GossipMeshDName        = "p2p.gossip.mesh.d"
-	GossipMeshDloName      = "p2p.gossip.mesh.lo"
-	GossipMeshDhiName      = "p2p.gossip.mesh.dhi"
-	GossipMeshDlazyName    = "p2p.gossip.mesh.dlazy"
-	GossipFloodPublishName = "p2p.gossip.mesh.floodpublish"
-	SyncReqRespName        = "p2p.sync.req-resp"
+	DisableP2PName                 = "p2p.disable"
+	NoDiscoveryName                = "p2p.no-discovery"
+	ScoringName                    = "p2p.scoring"
+	PeerScoringName                = "p2p.scoring.peers"
+	PeerScoreBandsName             = "p2p.score.bands"
+	BanningName                    = "p2p.ban.peers"
+	BanningThresholdName           = "p2p.ban.threshold"
+	BanningDurationName            = "p2p.ban.duration"
+	TopicScoringName               = "p2p.scoring.topics"
+	P2PPrivPathName                = "p2p.priv.path"
+	P2PPrivRawName                 = "p2p.priv.raw"
+	ListenIPName                   = "p2p.listen.ip"
+	ListenTCPPortName              = "p2p.listen.tcp"
+	ListenUDPPortName              = "p2p.listen.udp"
+	AdvertiseIPName                = "p2p.advertise.ip"
+	AdvertiseTCPPortName           = "p2p.advertise.tcp"
+	AdvertiseUDPPortName           = "p2p.advertise.udp"
+	BootnodesName                  = "p2p.bootnodes"
+	StaticPeersName                = "p2p.static"
+	NetRestrictName                = "p2p.netrestrict"
+	HostMuxName                    = "p2p.mux"
+	HostSecurityName               = "p2p.security"
+	PeersLoName                    = "p2p.peers.lo"
+	PeersHiName                    = "p2p.peers.hi"
+	PeersGraceName                 = "p2p.peers.grace"
+	NATName                        = "p2p.nat"
+	UserAgentName                  = "p2p.useragent"
+	TimeoutNegotiationName         = "p2p.timeout.negotiation"
+	TimeoutAcceptName              = "p2p.timeout.accept"
+	TimeoutDialName                = "p2p.timeout.dial"
+	PeerstorePathName              = "p2p.peerstore.path"
+	DiscoveryPathName              = "p2p.discovery.path"
+	SequencerP2PKeyName            = "p2p.sequencer.key"
+	UnsafeBlockSignersName         = "p2p.unsafe-block-signers"
+	UnsafeBlockSignersOverrideName = "p2p.unsafe-block-signers.override"
+	GossipMeshDName                = "p2p.gossip.mesh.d"
+	GossipMeshDloName              = "p2p.gossip.mesh.lo"
+	GossipMeshDhiName              = "p2p.gossip.mesh.dhi"
+	GossipMeshDlazyName            = "p2p.gossip.mesh.dlazy"
+	GossipFloodPublishName         = "p2p.gossip.mesh.floodpublish"
+	SyncReqRespName                = "p2p.sync.req-resp"
 )
 
 func deprecatedP2PFlags(envPrefix string) []cli.Flag {
@@ -307,6 +309,19 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			Value:    "",
 			EnvVars:  p2pEnv(envPrefix, "SEQUENCER_KEY"),
 		},
+		&cli.StringSliceFlag{
+			Name:     UnsafeBlockSignersName,
+			Usage:    "Comma-separated addresses that may sign gossiped unsafe blocks, in addition to the unsafe block signer of the SystemConfig. Allows every sequencer of a failover cluster to sign with its own key.",
+			Required: false,
+			EnvVars:  p2pEnv(envPrefix, "UNSAFE_BLOCK_SIGNERS"),
+		},
+		&cli.BoolFlag{
+			Name:     UnsafeBlockSignersOverrideName,
+			Usage:    "Ignore the unsafe block signer of the SystemConfig, and only accept the signers of --" + UnsafeBlockSignersName + ". For testing only.",
+			Required: false,
+			Hidden:   true,
+			EnvVars:  p2pEnv(envPrefix, "UNSAFE_BLOCK_SIGNERS_OVERRIDE"),
+		},
 		&cli.UintFlag{
 			Name:     GossipMeshDName,
 			Usage:    "Configure GossipSub topic stable mesh target count, a.k.a. desired outbound degree, number of peers to gossip to",
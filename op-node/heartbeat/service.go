@@ -5,11 +5,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 // SendInterval determines the delay between requests. This must be larger than the MinHeartbeatInterval in the server.
@@ -21,33 +27,129 @@ type Payload struct {
 	Moniker string `json:"moniker"`
 	PeerID  string `json:"peerID"`
 	ChainID uint64 `json:"chainID"`
+
+	// Timestamp is the unix time the payload was signed at, to prevent replays of signed payloads.
+	Timestamp uint64 `json:"timestamp,omitempty"`
+
+	// Sync heads of the node, if available.
+	UnsafeL2    *eth.BlockID `json:"unsafeL2,omitempty"`
+	SafeL2      *eth.BlockID `json:"safeL2,omitempty"`
+	FinalizedL2 *eth.BlockID `json:"finalizedL2,omitempty"`
+}
+
+// SignedPayload authenticates a Payload as sent by the owner of the p2p identity of the Payload PeerID.
+// The signature commits to the exact JSON encoding of the payload.
+type SignedPayload struct {
+	Payload   json.RawMessage `json:"payload"`
+	Signature hexutil.Bytes   `json:"signature"`
+}
+
+// Signer signs heartbeat payloads. The libp2p private key of the node implements this.
+type Signer interface {
+	Sign(msg []byte) ([]byte, error)
+}
+
+// SyncStatusFn retrieves the sync status of the node, to report the sync heads in the heartbeat.
+type SyncStatusFn func(ctx context.Context) (*eth.SyncStatus, error)
+
+// signingDomain separates heartbeat signatures from any other data signed with the p2p identity key.
+var signingDomain = []byte("op-heartbeat-payload-v0")
+
+func signingMessage(payload []byte) []byte {
+	msg := make([]byte, 0, len(signingDomain)+len(payload))
+	msg = append(msg, signingDomain...)
+	return append(msg, payload...)
+}
+
+// SignPayload encodes and signs the payload.
+func SignPayload(signer Signer, payload *Payload) (*SignedPayload, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payload: %w", err)
+	}
+	sig, err := signer.Sign(signingMessage(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign payload: %w", err)
+	}
+	return &SignedPayload{Payload: data, Signature: sig}, nil
+}
+
+// VerifyPayload decodes the signed payload,
+// and verifies that the signature was made by the key of the PeerID that the payload claims.
+func VerifyPayload(signed *SignedPayload) (*Payload, error) {
+	var payload Payload
+	if err := json.Unmarshal(signed.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+	id, err := peer.Decode(payload.PeerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid peer ID %q: %w", payload.PeerID, err)
+	}
+	pub, err := id.ExtractPublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("cannot extract public key from peer ID %s: %w", id, err)
+	}
+	ok, err := pub.Verify(signingMessage(signed.Payload), signed.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify signature: %w", err)
+	}
+	if !ok {
+		return nil, errors.New("signature does not match peer ID")
+	}
+	return &payload, nil
 }
 
 // Beat sends a heartbeat to the server at the given URL. It will send a heartbeat immediately, and then every SendInterval.
 // Beat spawns a goroutine that will send heartbeats until the context is canceled.
+//
+// If a signer is provided, each heartbeat is timestamped and signed.
+// If a status function is provided, the sync heads of the node are included in each heartbeat.
 func Beat(
 	ctx context.Context,
 	log log.Logger,
 	url string,
 	payload *Payload,
+	signer Signer,
+	status SyncStatusFn,
 ) error {
-	payloadJSON, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("telemetry crashed: %w", err)
-	}
-
 	client := &http.Client{
 		Timeout: 10 * time.Second,
 	}
 
+	encode := func() ([]byte, error) {
+		p := *payload
+		if status != nil {
+			if st, err := status(ctx); err != nil {
+				log.Warn("failed to retrieve sync status for heartbeat", "err", err)
+			} else {
+				unsafeL2, safeL2, finalizedL2 := st.UnsafeL2.ID(), st.SafeL2.ID(), st.FinalizedL2.ID()
+				p.UnsafeL2, p.SafeL2, p.FinalizedL2 = &unsafeL2, &safeL2, &finalizedL2
+			}
+		}
+		if signer == nil {
+			return json.Marshal(&p)
+		}
+		p.Timestamp = uint64(time.Now().Unix())
+		signed, err := SignPayload(signer, &p)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(signed)
+	}
+
 	send := func() {
+		payloadJSON, err := encode()
+		if err != nil {
+			log.Error("error encoding heartbeat", "err", err)
+			return
+		}
 		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payloadJSON))
-		req.Header.Set("User-Agent", fmt.Sprintf("op-node/%s", payload.Version))
-		req.Header.Set("Content-Type", "application/json")
 		if err != nil {
 			log.Error("error creating heartbeat HTTP request", "err", err)
 			return
 		}
+		req.Header.Set("User-Agent", fmt.Sprintf("op-node/%s", payload.Version))
+		req.Header.Set("Content-Type", "application/json")
 		res, err := client.Do(req)
 		if err != nil {
 			log.Warn("error sending heartbeat", "err", err)
@@ -2,15 +2,22 @@ package heartbeat
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 const expHeartbeat = `{
@@ -43,7 +50,7 @@ func TestBeat(t *testing.T) {
 			Moniker: "yeet",
 			PeerID:  "1UiUfoobar",
 			ChainID: 1234,
-		})
+		}, nil, nil)
 		doneCh <- struct{}{}
 	}()
 
@@ -56,3 +63,102 @@ func TestBeat(t *testing.T) {
 		t.Fatalf("error: %v", ctx.Err())
 	}
 }
+
+func TestBeatSigned(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	reqCh := make(chan []byte, 2)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		reqCh <- body
+		r.Body.Close()
+	}))
+	defer s.Close()
+
+	status := func(ctx context.Context) (*eth.SyncStatus, error) {
+		return &eth.SyncStatus{
+			UnsafeL2:    eth.L2BlockRef{Hash: common.Hash{0x03}, Number: 300},
+			SafeL2:      eth.L2BlockRef{Hash: common.Hash{0x02}, Number: 200},
+			FinalizedL2: eth.L2BlockRef{Hash: common.Hash{0x01}, Number: 100},
+		}, nil
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		_ = Beat(ctx, log.Root(), s.URL, &Payload{
+			Version: "v1.2.3",
+			Meta:    "meta",
+			Moniker: "yeet",
+			PeerID:  id.String(),
+			ChainID: 1234,
+		}, priv, status)
+		doneCh <- struct{}{}
+	}()
+
+	select {
+	case body := <-reqCh:
+		cancel()
+		<-doneCh
+		var signed SignedPayload
+		require.NoError(t, json.Unmarshal(body, &signed))
+		payload, err := VerifyPayload(&signed)
+		require.NoError(t, err)
+		require.Equal(t, id.String(), payload.PeerID)
+		require.Equal(t, "yeet", payload.Moniker)
+		require.NotZero(t, payload.Timestamp)
+		require.Equal(t, eth.BlockID{Hash: common.Hash{0x03}, Number: 300}, *payload.UnsafeL2)
+		require.Equal(t, eth.BlockID{Hash: common.Hash{0x02}, Number: 200}, *payload.SafeL2)
+		require.Equal(t, eth.BlockID{Hash: common.Hash{0x01}, Number: 100}, *payload.FinalizedL2)
+	case <-ctx.Done():
+		t.Fatalf("error: %v", ctx.Err())
+	}
+}
+
+func TestVerifyPayload(t *testing.T) {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	other, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("Valid", func(t *testing.T) {
+		signed, err := SignPayload(priv, &Payload{PeerID: id.String(), Moniker: "alice"})
+		require.NoError(t, err)
+		payload, err := VerifyPayload(signed)
+		require.NoError(t, err)
+		require.Equal(t, "alice", payload.Moniker)
+	})
+
+	t.Run("SpoofedPeerID", func(t *testing.T) {
+		signed, err := SignPayload(other, &Payload{PeerID: id.String(), Moniker: "alice"})
+		require.NoError(t, err)
+		_, err = VerifyPayload(signed)
+		require.ErrorContains(t, err, "signature does not match peer ID")
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		signed, err := SignPayload(priv, &Payload{PeerID: id.String(), Moniker: "alice"})
+		require.NoError(t, err)
+		tampered, err := json.Marshal(&Payload{PeerID: id.String(), Moniker: "mallory"})
+		require.NoError(t, err)
+		signed.Payload = tampered
+		_, err = VerifyPayload(signed)
+		require.ErrorContains(t, err, "signature does not match peer ID")
+	})
+
+	t.Run("InvalidPeerID", func(t *testing.T) {
+		signed, err := SignPayload(priv, &Payload{PeerID: "1UiUfoobar"})
+		require.NoError(t, err)
+		_, err = VerifyPayload(signed)
+		require.ErrorContains(t, err, "invalid peer ID")
+	})
+}
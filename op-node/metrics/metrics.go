@@ -52,6 +52,7 @@ type Metricer interface {
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
 	RecordGossipEvent(evType int32)
+	RecordGossipBlockSigner(signer common.Address)
 	IncPeerCount()
 	DecPeerCount()
 	IncStreamCount()
@@ -68,6 +69,8 @@ type Metricer interface {
 	SetPeerScores(allScores []store.PeerScores)
 	ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
 	ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ClientPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration)
 	PayloadsQuarantineSize(n int)
 	RecordPeerUnban()
 	RecordIPUnban()
@@ -128,15 +131,16 @@ type Metrics struct {
 	frameAddedEvent        *metrics.Event
 
 	// P2P Metrics
-	PeerCount         prometheus.Gauge
-	StreamCount       prometheus.Gauge
-	GossipEventsTotal *prometheus.CounterVec
-	BandwidthTotal    *prometheus.GaugeVec
-	PeerUnbans        prometheus.Counter
-	IPUnbans          prometheus.Counter
-	Dials             *prometheus.CounterVec
-	Accepts           *prometheus.CounterVec
-	PeerScores        *prometheus.HistogramVec
+	PeerCount          prometheus.Gauge
+	StreamCount        prometheus.Gauge
+	GossipEventsTotal  *prometheus.CounterVec
+	GossipBlockSigners *prometheus.CounterVec
+	BandwidthTotal     *prometheus.GaugeVec
+	PeerUnbans         prometheus.Counter
+	IPUnbans           prometheus.Counter
+	Dials              *prometheus.CounterVec
+	Accepts            *prometheus.CounterVec
+	PeerScores         *prometheus.HistogramVec
 
 	ChannelInputBytes prometheus.Counter
 
@@ -252,6 +256,14 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"type",
 		}),
+		GossipBlockSigners: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_block_signers_total",
+			Help:      "Count of accepted gossiped blocks by signer address",
+		}, []string{
+			"signer",
+		}),
 		BandwidthTotal: factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -480,6 +492,10 @@ func (m *Metrics) RecordGossipEvent(evType int32) {
 	m.GossipEventsTotal.WithLabelValues(pb.TraceEvent_Type_name[evType]).Inc()
 }
 
+func (m *Metrics) RecordGossipBlockSigner(signer common.Address) {
+	m.GossipBlockSigners.WithLabelValues(signer.Hex()).Inc()
+}
+
 func (m *Metrics) IncPeerCount() {
 	m.PeerCount.Inc()
 }
@@ -562,6 +578,21 @@ func (m *Metrics) ServerPayloadByNumberEvent(num uint64, resultCode byte, durati
 	m.P2PPayloadByNumber.WithLabelValues("server").Set(float64(num))
 }
 
+func (m *Metrics) ClientPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration) {
+	if resultCode > 4 { // summarize all high codes to reduce metrics overhead
+		resultCode = 5
+	}
+	code := strconv.FormatUint(uint64(resultCode), 10)
+	m.P2PReqTotal.WithLabelValues("client", "payload_by_hash", code).Inc()
+	m.P2PReqDurationSeconds.WithLabelValues("client", "payload_by_hash", code).Observe(float64(duration) / float64(time.Second))
+}
+
+func (m *Metrics) ServerPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration) {
+	code := strconv.FormatUint(uint64(resultCode), 10)
+	m.P2PReqTotal.WithLabelValues("server", "payload_by_hash", code).Inc()
+	m.P2PReqDurationSeconds.WithLabelValues("server", "payload_by_hash", code).Observe(float64(duration) / float64(time.Second))
+}
+
 func (m *Metrics) PayloadsQuarantineSize(n int) {
 	m.PayloadsQuarantineTotal.Set(float64(n))
 }
@@ -673,6 +704,9 @@ func (n *noopMetricer) RecordSequencerReset() {
 func (n *noopMetricer) RecordGossipEvent(evType int32) {
 }
 
+func (n *noopMetricer) RecordGossipBlockSigner(signer common.Address) {
+}
+
 func (n *noopMetricer) SetPeerScores(allScores []store.PeerScores) {
 }
 
@@ -707,6 +741,12 @@ func (n *noopMetricer) ClientPayloadByNumberEvent(num uint64, resultCode byte, d
 func (n *noopMetricer) ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration) {
 }
 
+func (n *noopMetricer) ClientPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration) {
+}
+
+func (n *noopMetricer) ServerPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration) {
+}
+
 func (n *noopMetricer) PayloadsQuarantineSize(int) {
 }
 
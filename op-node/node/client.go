@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -90,7 +91,10 @@ func (p *PreparedL2Endpoints) Setup(ctx context.Context, log log.Logger, rollupC
 }
 
 type L1EndpointConfig struct {
-	L1NodeAddr string // Address of L1 User JSON-RPC endpoint to use (eth namespace required)
+	// L1NodeAddr is the address of the L1 User JSON-RPC endpoint to use (eth namespace required).
+	// A comma-separated list of addresses enables automatic failover between the endpoints,
+	// preferring them in the given order.
+	L1NodeAddr string
 
 	// L1TrustRPC: if we trust the L1 RPC we do not have to validate L1 response contents like headers
 	// against block hashes, or cached transaction sender addresses.
@@ -115,6 +119,9 @@ type L1EndpointConfig struct {
 	// It is recommended to use websockets or IPC for efficient following of the changing block.
 	// Setting this to 0 disables polling.
 	HttpPollInterval time.Duration
+
+	// FallbackConfig configures the failover between the endpoints, if multiple L1NodeAddr are given.
+	FallbackConfig client.FallbackConfig
 }
 
 var _ L1EndpointSetup = (*L1EndpointConfig)(nil)
@@ -129,6 +136,11 @@ func (cfg *L1EndpointConfig) Check() error {
 	if cfg.MaxConcurrency < 1 {
 		return fmt.Errorf("max concurrent requests cannot be less than 1, was %d", cfg.MaxConcurrency)
 	}
+	if strings.Contains(cfg.L1NodeAddr, ",") {
+		if err := cfg.FallbackConfig.Check(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -141,9 +153,19 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 		opts = append(opts, client.WithRateLimit(cfg.RateLimit, cfg.BatchSize))
 	}
 
-	l1Node, err := client.NewRPC(ctx, log, cfg.L1NodeAddr, opts...)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to dial L1 address (%s): %w", cfg.L1NodeAddr, err)
+	var l1Node client.RPC
+	if addrs := strings.Split(cfg.L1NodeAddr, ","); len(addrs) > 1 {
+		fallback, err := client.NewFallbackRPC(ctx, log, addrs, cfg.FallbackConfig, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial L1 addresses (%s): %w", cfg.L1NodeAddr, err)
+		}
+		l1Node = fallback
+	} else {
+		var err error
+		l1Node, err = client.NewRPC(ctx, log, cfg.L1NodeAddr, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial L1 address (%s): %w", cfg.L1NodeAddr, err)
+		}
 	}
 	rpcCfg := sources.L1ClientDefaultConfig(rollupCfg, cfg.L1TrustRPC, cfg.L1RPCKind)
 	rpcCfg.MaxRequestsPerBatch = cfg.BatchSize
@@ -13,6 +13,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -38,6 +40,9 @@ type Config struct {
 
 	Pprof oppprof.CLIConfig
 
+	// Tracing configures the export of OpenTelemetry traces
+	Tracing tracing.CLIConfig
+
 	// Used to poll the L1 for new finalized or safe blocks
 	L1EpochPollInterval time.Duration
 
@@ -49,6 +54,9 @@ type Config struct {
 	// but if log-events are not coming in (e.g. not syncing blocks) then the reload ensures the config stays accurate.
 	RuntimeConfigReloadInterval time.Duration
 
+	// UnsafeBlockSigners configures the signers accepted for gossiped unsafe blocks
+	UnsafeBlockSigners UnsafeBlockSignersConfig
+
 	// Optional
 	Tracer    Tracer
 	Heartbeat HeartbeatConfig
@@ -66,6 +74,16 @@ type Config struct {
 	RethDBPath string
 }
 
+// UnsafeBlockSignersConfig configures the set of addresses that may sign gossiped unsafe blocks.
+type UnsafeBlockSignersConfig struct {
+	// Addresses are accepted next to the unsafe block signer of the SystemConfig,
+	// e.g. so that every sequencer of a failover cluster can sign with its own key.
+	Addresses []common.Address
+	// Override ignores the unsafe block signer of the SystemConfig, and only accepts Addresses.
+	// Intended for testing.
+	Override bool
+}
+
 type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
@@ -142,11 +160,17 @@ func (cfg *Config) Check() error {
 	if err := cfg.Pprof.Check(); err != nil {
 		return fmt.Errorf("pprof config error: %w", err)
 	}
+	if err := cfg.Tracing.Check(); err != nil {
+		return fmt.Errorf("tracing config error: %w", err)
+	}
 	if cfg.P2P != nil {
 		if err := cfg.P2P.Check(); err != nil {
 			return fmt.Errorf("p2p config error: %w", err)
 		}
 	}
+	if cfg.UnsafeBlockSigners.Override && len(cfg.UnsafeBlockSigners.Addresses) == 0 {
+		return errors.New("unsafe block signers override requires at least one unsafe block signer")
+	}
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
 		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
 	}
@@ -24,9 +24,11 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
 var (
@@ -53,8 +55,9 @@ type OpNode struct {
 
 	rollupHalt string // when to halt the rollup, disabled if empty
 
-	pprofService *oppprof.Service
-	metricsSrv   *httputil.HTTPServer
+	pprofService   *oppprof.Service
+	tracingService *tracing.Service
+	metricsSrv     *httputil.HTTPServer
 
 	beacon *sources.L1BeaconClient
 
@@ -108,6 +111,9 @@ func New(ctx context.Context, cfg *Config, log log.Logger, snapshotLog log.Logge
 
 func (n *OpNode) init(ctx context.Context, cfg *Config, snapshotLog log.Logger) error {
 	n.log.Info("Initializing rollup node", "version", n.appVersion)
+	if err := n.initTracing(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
 	if err := n.initTracer(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init the trace: %w", err)
 	}
@@ -145,6 +151,14 @@ func (n *OpNode) init(ctx context.Context, cfg *Config, snapshotLog log.Logger)
 	return nil
 }
 
+func (n *OpNode) initTracing(ctx context.Context, cfg *Config) error {
+	n.tracingService = tracing.New(n.log, cfg.Tracing, "op-node", n.appVersion)
+	if err := n.tracingService.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start tracing service: %w", err)
+	}
+	return nil
+}
+
 func (n *OpNode) initTracer(ctx context.Context, cfg *Config) error {
 	if cfg.Tracer != nil {
 		n.tracer = cfg.Tracer
@@ -199,7 +213,7 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 
 func (n *OpNode) initRuntimeConfig(ctx context.Context, cfg *Config) error {
 	// attempt to load runtime config, repeat N times
-	n.runCfg = NewRuntimeConfig(n.log, n.l1Source, &cfg.Rollup)
+	n.runCfg = NewRuntimeConfig(n.log, n.l1Source, &cfg.Rollup, cfg.UnsafeBlockSigners)
 
 	confDepth := cfg.Driver.VerifierConfDepth
 	reload := func(ctx context.Context) (eth.L1BlockRef, error) {
@@ -335,7 +349,7 @@ func (n *OpNode) initRPCServer(ctx context.Context, cfg *Config) error {
 		return err
 	}
 	if n.p2pNode != nil {
-		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, n.log, n.metrics))
+		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, oplog.ForModule(n.log, "p2p"), n.metrics))
 	}
 	if cfg.RPC.EnableAdmin {
 		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics, n.log))
@@ -369,10 +383,16 @@ func (n *OpNode) initHeartbeat(cfg *Config) {
 		return
 	}
 	var peerID string
+	var signer heartbeat.Signer
 	if cfg.P2P.Disabled() {
 		peerID = "disabled"
 	} else {
-		peerID = n.P2P().Host().ID().String()
+		h := n.P2P().Host()
+		peerID = h.ID().String()
+		// sign the heartbeats with the p2p identity, so the server can verify the peer ID
+		if priv := h.Peerstore().PrivKey(h.ID()); priv != nil {
+			signer = priv
+		}
 	}
 
 	payload := &heartbeat.Payload{
@@ -384,7 +404,7 @@ func (n *OpNode) initHeartbeat(cfg *Config) {
 	}
 
 	go func(url string) {
-		if err := heartbeat.Beat(n.resourcesCtx, n.log, url, payload); err != nil {
+		if err := heartbeat.Beat(n.resourcesCtx, n.log, url, payload, signer, n.l2Driver.SyncStatus); err != nil {
 			log.Error("heartbeat goroutine crashed", "err", err)
 		}
 	}(cfg.Heartbeat.URL)
@@ -409,13 +429,14 @@ func (n *OpNode) initPProf(cfg *Config) error {
 
 func (n *OpNode) initP2P(ctx context.Context, cfg *Config) error {
 	if cfg.P2P != nil {
-		p2pNode, err := p2p.NewNodeP2P(n.resourcesCtx, &cfg.Rollup, n.log, cfg.P2P, n, n.l2Source, n.runCfg, n.metrics, cfg.Sync.SyncMode == sync.ELSync)
+		p2pLog := oplog.ForModule(n.log, "p2p")
+		p2pNode, err := p2p.NewNodeP2P(n.resourcesCtx, &cfg.Rollup, p2pLog, cfg.P2P, n, n.l2Source, n.runCfg, n.metrics, cfg.Sync.SyncMode == sync.ELSync)
 		if err != nil || p2pNode == nil {
 			return err
 		}
 		n.p2pNode = p2pNode
 		if n.p2pNode.Dv5Udp() != nil {
-			go n.p2pNode.DiscoveryProcess(n.resourcesCtx, n.log, &cfg.Rollup, cfg.P2P.TargetPeers())
+			go n.p2pNode.DiscoveryProcess(n.resourcesCtx, p2pLog, &cfg.Rollup, cfg.P2P.TargetPeers())
 		}
 	}
 	return nil
@@ -632,6 +653,11 @@ func (n *OpNode) Stop(ctx context.Context) error {
 			result = multierror.Append(result, fmt.Errorf("failed to close metrics server: %w", err))
 		}
 	}
+	if n.tracingService != nil {
+		if err := n.tracingService.Stop(ctx); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to stop tracing: %w", err))
+		}
+	}
 
 	return result.ErrorOrNil()
 }
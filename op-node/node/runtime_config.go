@@ -3,6 +3,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -49,6 +50,7 @@ type RuntimeConfig struct {
 
 	l1Client  RuntimeCfgL1Source
 	rollupCfg *rollup.Config
+	signers   UnsafeBlockSignersConfig
 
 	// l1Ref is the current source of the data,
 	// if this is invalidated with a reorg the data will have to be reloaded.
@@ -68,20 +70,39 @@ type runtimeConfigData struct {
 
 var _ p2p.GossipRuntimeConfig = (*RuntimeConfig)(nil)
 
-func NewRuntimeConfig(log log.Logger, l1Client RuntimeCfgL1Source, rollupCfg *rollup.Config) *RuntimeConfig {
+func NewRuntimeConfig(log log.Logger, l1Client RuntimeCfgL1Source, rollupCfg *rollup.Config, signers UnsafeBlockSignersConfig) *RuntimeConfig {
 	return &RuntimeConfig{
 		log:       log,
 		l1Client:  l1Client,
 		rollupCfg: rollupCfg,
+		signers:   signers,
 	}
 }
 
+// P2PSequencerAddress returns the unsafe block signer of the SystemConfig.
 func (r *RuntimeConfig) P2PSequencerAddress() common.Address {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	return r.p2pBlockSignerAddr
 }
 
+// P2PSequencerAddresses returns the unsafe block signer of the SystemConfig, unless overridden,
+// together with the locally configured unsafe block signers.
+func (r *RuntimeConfig) P2PSequencerAddresses() []common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	addrs := make([]common.Address, 0, len(r.signers.Addresses)+1)
+	if !r.signers.Override && r.p2pBlockSignerAddr != (common.Address{}) {
+		addrs = append(addrs, r.p2pBlockSignerAddr)
+	}
+	for _, addr := range r.signers.Addresses {
+		if !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
 func (r *RuntimeConfig) RequiredProtocolVersion() params.ProtocolVersion {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -122,6 +143,7 @@ func (r *RuntimeConfig) Load(ctx context.Context, l1Ref eth.L1BlockRef) error {
 	r.p2pBlockSignerAddr = common.BytesToAddress(p2pSignerVal[:])
 	r.required = requiredProtVersion
 	r.recommended = recommendedProtoVersion
-	r.log.Info("loaded new runtime config values!", "p2p_seq_address", r.p2pBlockSignerAddr)
+	r.log.Info("loaded new runtime config values!", "p2p_seq_address", r.p2pBlockSignerAddr,
+		"extra_p2p_seq_addresses", r.signers.Addresses, "override_p2p_seq_address", r.signers.Override)
 	return nil
 }
@@ -0,0 +1,58 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubRuntimeCfgL1Source struct {
+	signer common.Address
+}
+
+func (s *stubRuntimeCfgL1Source) ReadStorageAt(_ context.Context, _ common.Address, storageSlot common.Hash, _ common.Hash) (common.Hash, error) {
+	if storageSlot == UnsafeBlockSignerAddressSystemConfigStorageSlot {
+		return common.BytesToHash(s.signer[:]), nil
+	}
+	return common.Hash{}, nil
+}
+
+func TestRuntimeConfigP2PSequencerAddresses(t *testing.T) {
+	systemConfigSigner := common.Address{0x01}
+	extra := []common.Address{{0x02}, systemConfigSigner, {0x03}}
+
+	load := func(t *testing.T, signer common.Address, signers UnsafeBlockSignersConfig) *RuntimeConfig {
+		runCfg := NewRuntimeConfig(testlog.Logger(t, log.LvlInfo), &stubRuntimeCfgL1Source{signer: signer}, &rollup.Config{}, signers)
+		require.NoError(t, runCfg.Load(context.Background(), eth.L1BlockRef{}))
+		return runCfg
+	}
+
+	t.Run("SystemConfigOnly", func(t *testing.T) {
+		runCfg := load(t, systemConfigSigner, UnsafeBlockSignersConfig{})
+		require.Equal(t, systemConfigSigner, runCfg.P2PSequencerAddress())
+		require.Equal(t, []common.Address{systemConfigSigner}, runCfg.P2PSequencerAddresses())
+	})
+
+	t.Run("Extra", func(t *testing.T) {
+		runCfg := load(t, systemConfigSigner, UnsafeBlockSignersConfig{Addresses: extra})
+		require.Equal(t, []common.Address{systemConfigSigner, {0x02}, {0x03}}, runCfg.P2PSequencerAddresses())
+	})
+
+	t.Run("Override", func(t *testing.T) {
+		runCfg := load(t, common.Address{0x04}, UnsafeBlockSignersConfig{Addresses: extra, Override: true})
+		require.Equal(t, common.Address{0x04}, runCfg.P2PSequencerAddress())
+		require.Equal(t, extra, runCfg.P2PSequencerAddresses())
+	})
+
+	t.Run("NoSigner", func(t *testing.T) {
+		runCfg := load(t, common.Address{}, UnsafeBlockSignersConfig{})
+		require.Empty(t, runCfg.P2PSequencerAddresses())
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
 type rpcServer struct {
@@ -72,7 +73,7 @@ func (s *rpcServer) Start() error {
 	// other services to connect to the opnode. VHosts in particular
 	// defaults to localhost, which will prevent containers from
 	// calling into the opnode without an "invalid host" error.
-	nodeHandler := node.NewHTTPHandlerStack(srv, []string{"*"}, []string{"*"}, nil)
+	nodeHandler := node.NewHTTPHandlerStack(tracing.NewRPCServerMiddleware(srv), []string{"*"}, []string{"*"}, nil)
 
 	mux := http.NewServeMux()
 	mux.Handle("/", nodeHandler)
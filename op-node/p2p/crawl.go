@@ -0,0 +1,249 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	decredSecp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// CrawlConfig configures a crawl of the discv5 DHT for the nodes of an OP Stack chain.
+type CrawlConfig struct {
+	L2ChainID *big.Int
+	Bootnodes []*enode.Node
+	// ListenIP is the IP the discv5 UDP socket binds to, on an available port.
+	ListenIP net.IP
+	// Duration limits how long the DHT is walked. Handshakes with the discovered nodes may take longer.
+	Duration time.Duration
+	// HandshakeTimeout limits the libp2p connection and identify exchange with each node.
+	HandshakeTimeout time.Duration
+	// Concurrency is the number of nodes to handshake with in parallel.
+	Concurrency int
+	UserAgent   string
+}
+
+func (c *CrawlConfig) Check() error {
+	if c.L2ChainID == nil {
+		return errors.New("missing L2 chain ID")
+	}
+	if len(c.Bootnodes) == 0 {
+		return errors.New("no bootnodes to start the crawl from")
+	}
+	if c.Duration <= 0 {
+		return errors.New("crawl duration must be positive")
+	}
+	if c.HandshakeTimeout <= 0 {
+		return errors.New("handshake timeout must be positive")
+	}
+	if c.Concurrency <= 0 {
+		return errors.New("concurrency must be positive")
+	}
+	return nil
+}
+
+// CrawledNode is the census entry of a node discovered during a crawl.
+type CrawledNode struct {
+	NodeID string `json:"node_id"`
+	PeerID string `json:"peer_id"`
+	ENR    string `json:"enr"`
+	// ChainID and Version are the contents of the "opstack" ENR entry
+	ChainID uint64 `json:"chain_id"`
+	Version uint64 `json:"version"`
+
+	// Reachable is set if the libp2p handshake with the node succeeded
+	Reachable       bool     `json:"reachable"`
+	Error           string   `json:"error,omitempty"`
+	AgentVersion    string   `json:"agent_version,omitempty"`
+	ProtocolVersion string   `json:"protocol_version,omitempty"`
+	Addresses       []string `json:"addresses"`
+	Protocols       []string `json:"protocols"`
+	// PayloadByNumber is set if the node serves the payload-by-number req-resp sync protocol
+	PayloadByNumber bool `json:"payload_by_number"`
+}
+
+// Crawl walks the discv5 DHT for nodes of the configured chain, and tries a libp2p handshake with each of them.
+// The census is sorted by node ID.
+func Crawl(ctx context.Context, log log.Logger, cfg *CrawlConfig) ([]*CrawledNode, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, fmt.Errorf("invalid crawl config: %w", err)
+	}
+	key, err := decredSecp.GeneratePrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate crawler key: %w", err)
+	}
+
+	h, err := libp2p.New(
+		libp2p.Identity((*crypto.Secp256k1PrivateKey)(key)),
+		libp2p.UserAgent(cfg.UserAgent),
+		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.NoListenAddrs,
+		libp2p.DisableRelay(),
+		libp2p.WithDialTimeout(cfg.HandshakeTimeout),
+		YamuxC(), MplexC(), NoiseC(), TlsC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create crawler host: %w", err)
+	}
+	defer h.Close()
+
+	udpV5, err := crawlDiscovery(log, cfg, key)
+	if err != nil {
+		return nil, err
+	}
+	defer udpV5.LocalNode().Database().Close()
+	defer udpV5.Close()
+
+	rollupCfg := &rollup.Config{L2ChainID: cfg.L2ChainID}
+	iter := enode.Filter(udpV5.RandomNodes(), FilterEnodes(log, rollupCfg))
+	walkCtx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+	go func() {
+		<-walkCtx.Done()
+		iter.Close()
+	}()
+
+	var (
+		mu      sync.Mutex
+		results []*CrawledNode
+		wg      sync.WaitGroup
+	)
+	seen := make(map[enode.ID]struct{})
+	sem := make(chan struct{}, cfg.Concurrency)
+	for iter.Next() {
+		node := iter.Node()
+		if _, ok := seen[node.ID()]; ok {
+			continue
+		}
+		seen[node.ID()] = struct{}{}
+		log.Debug("discovered node", "id", node.ID(), "enr", node)
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res := handshake(ctx, h, cfg, node)
+			log.Info("crawled node", "id", res.NodeID, "peer", res.PeerID, "reachable", res.Reachable,
+				"agent", res.AgentVersion, "err", res.Error)
+			mu.Lock()
+			results = append(results, res)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].NodeID < results[j].NodeID })
+	return results, ctx.Err()
+}
+
+func crawlDiscovery(log log.Logger, cfg *CrawlConfig, key *decredSecp.PrivateKey) (*discover.UDPv5, error) {
+	priv := key.ToECDSA()
+	// use the geth curve definition. Same crypto, but geth needs to detect it as *their* definition of the curve.
+	priv.Curve = gcrypto.S256()
+	db, err := enode.OpenDB("") // "" = memory db
+	if err != nil {
+		return nil, fmt.Errorf("failed to open discovery db: %w", err)
+	}
+	// The crawler does not advertise an "opstack" entry, so other nodes do not try to connect to it.
+	localNode := enode.NewLocalNode(db, priv)
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: cfg.ListenIP})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open discovery socket: %w", err)
+	}
+	localNode.SetFallbackUDP(conn.LocalAddr().(*net.UDPAddr).Port)
+	udpV5, err := discover.ListenV5(conn, localNode, discover.Config{
+		PrivateKey:   priv,
+		Bootnodes:    cfg.Bootnodes,
+		Log:          log,
+		ValidSchemes: enode.ValidSchemes,
+	})
+	if err != nil {
+		conn.Close()
+		db.Close()
+		return nil, fmt.Errorf("failed to start discovery: %w", err)
+	}
+	return udpV5, nil
+}
+
+// handshake connects to the node, and records what the node reports through the libp2p identify protocol.
+func handshake(ctx context.Context, h host.Host, cfg *CrawlConfig, node *enode.Node) *CrawledNode {
+	res := &CrawledNode{
+		NodeID:    node.ID().String(),
+		ENR:       node.String(),
+		Addresses: []string{},
+		Protocols: []string{},
+	}
+	var dat OpStackENRData
+	if err := node.Load(&dat); err == nil {
+		res.ChainID = dat.chainID
+		res.Version = dat.version
+	}
+	info, _, err := enrToAddrInfo(node)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.PeerID = info.ID.String()
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.HandshakeTimeout)
+	defer cancel()
+	if err := h.Connect(ctx, *info); err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	defer func() {
+		_ = h.Network().ClosePeer(info.ID)
+	}()
+	if ids, ok := h.(interface{ IDService() identify.IDService }); ok {
+		for _, conn := range h.Network().ConnsToPeer(info.ID) {
+			select {
+			case <-ids.IDService().IdentifyWait(conn):
+			case <-ctx.Done():
+				res.Error = fmt.Sprintf("identify: %v", ctx.Err())
+				return res
+			}
+		}
+	}
+	res.Reachable = true
+
+	pstore := h.Peerstore()
+	if v, err := pstore.Get(info.ID, "AgentVersion"); err == nil {
+		res.AgentVersion, _ = v.(string)
+	}
+	if v, err := pstore.Get(info.ID, "ProtocolVersion"); err == nil {
+		res.ProtocolVersion, _ = v.(string)
+	}
+	for _, addr := range pstore.Addrs(info.ID) {
+		res.Addresses = append(res.Addresses, addr.String())
+	}
+	sort.Strings(res.Addresses)
+	protocols, err := pstore.GetProtocols(info.ID)
+	if err == nil {
+		for _, p := range protocols {
+			res.Protocols = append(res.Protocols, string(p))
+		}
+	}
+	sort.Strings(res.Protocols)
+	res.PayloadByNumber = slices.Contains(protocols, PayloadByNumberProtocolID(cfg.L2ChainID))
+	return res
+}
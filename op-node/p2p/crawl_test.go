@@ -0,0 +1,96 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestCrawl(t *testing.T) {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	discDB, err := enode.OpenDB("") // "" = memory db
+	require.NoError(t, err)
+	rollupCfg := &rollup.Config{L2ChainID: big.NewInt(901)}
+
+	conf := Config{
+		Priv:               priv.(*crypto.Secp256k1PrivateKey),
+		AdvertiseIP:        net.IP{127, 0, 0, 1},
+		ListenIP:           net.IP{127, 0, 0, 1},
+		HostMux:            []libp2p.Option{YamuxC(), MplexC()},
+		HostSecurity:       []libp2p.Option{NoiseC(), TlsC()},
+		PeersLo:            1,
+		PeersHi:            10,
+		PeersGrace:         time.Second * 10,
+		UserAgent:          "optimism-testing",
+		TimeoutNegotiation: time.Second * 2,
+		TimeoutAccept:      time.Second * 2,
+		TimeoutDial:        time.Second * 2,
+		Store:              sync.MutexWrap(ds.NewMapDatastore()),
+		DiscoveryDB:        discDB,
+		EnableReqRespSync:  true,
+	}
+	servePayload := mockPayloadFn(func(n uint64) (*eth.ExecutionPayload, error) {
+		return nil, ethereum.NotFound
+	})
+	node, err := NewNodeP2P(context.Background(), rollupCfg, testlog.Logger(t, log.LvlError), &conf, &mockGossipIn{},
+		servePayload, &testutils.MockRuntimeConfig{}, metrics.NoopMetrics, false)
+	require.NoError(t, err)
+	defer node.Close()
+
+	t.Run("OtherChain", func(t *testing.T) {
+		res, err := Crawl(context.Background(), testlog.Logger(t, log.LvlInfo), &CrawlConfig{
+			L2ChainID:        big.NewInt(902),
+			Bootnodes:        []*enode.Node{node.Dv5Udp().Self()},
+			ListenIP:         net.IP{127, 0, 0, 1},
+			Duration:         2 * time.Second,
+			HandshakeTimeout: 5 * time.Second,
+			Concurrency:      2,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("Census", func(t *testing.T) {
+		res, err := Crawl(context.Background(), testlog.Logger(t, log.LvlInfo), &CrawlConfig{
+			L2ChainID:        rollupCfg.L2ChainID,
+			Bootnodes:        []*enode.Node{node.Dv5Udp().Self()},
+			ListenIP:         net.IP{127, 0, 0, 1},
+			Duration:         2 * time.Second,
+			HandshakeTimeout: 5 * time.Second,
+			Concurrency:      2,
+			UserAgent:        "crawler-testing",
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		crawled := res[0]
+		require.Empty(t, crawled.Error)
+		require.True(t, crawled.Reachable)
+		require.Equal(t, node.Dv5Udp().Self().ID().String(), crawled.NodeID)
+		require.Equal(t, node.Host().ID().String(), crawled.PeerID)
+		require.Equal(t, uint64(901), crawled.ChainID)
+		require.Equal(t, "optimism-testing", crawled.AgentVersion)
+		require.NotEmpty(t, crawled.Addresses)
+		require.Contains(t, crawled.Protocols, string(PayloadByNumberProtocolID(rollupCfg.L2ChainID)))
+		require.True(t, crawled.PayloadByNumber)
+	})
+}
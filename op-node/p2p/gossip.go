@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -58,12 +59,15 @@ type GossipSetupConfigurables interface {
 }
 
 type GossipRuntimeConfig interface {
-	P2PSequencerAddress() common.Address
+	// P2PSequencerAddresses returns the set of addresses that may sign gossiped blocks.
+	P2PSequencerAddresses() []common.Address
 }
 
 //go:generate mockery --name GossipMetricer
 type GossipMetricer interface {
 	RecordGossipEvent(evType int32)
+	// RecordGossipBlockSigner records the address that signed an accepted gossiped block
+	RecordGossipBlockSigner(signer common.Address)
 }
 
 func blocksTopicV1(cfg *rollup.Config) string {
@@ -243,7 +247,7 @@ func (sb *seenBlocks) markSeen(h common.Hash) {
 	sb.blockHashes = append(sb.blockHashes, h)
 }
 
-func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, blockVersion eth.BlockVersion) pubsub.ValidatorEx {
+func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, m GossipMetricer, blockVersion eth.BlockVersion) pubsub.ValidatorEx {
 
 	// Seen block hashes per block height
 	// uint64 -> *seenBlocks
@@ -281,7 +285,7 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 		signatureBytes, payloadBytes := data[:65], data[65:]
 
 		// [REJECT] if the signature by the sequencer is not valid
-		result := verifyBlockSignature(log, cfg, runCfg, id, signatureBytes, payloadBytes)
+		signer, result := verifyBlockSignature(log, cfg, runCfg, id, signatureBytes, payloadBytes)
 		if result != pubsub.ValidationAccept {
 			return result
 		}
@@ -352,39 +356,44 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 		// but validator concurrency is limited anyway)
 		seen.markSeen(payload.BlockHash)
 
+		log.Debug("accepted gossiped block", "id", payload.ID(), "signer", signer, "peer", id)
+		m.RecordGossipBlockSigner(signer)
+
 		// remember the decoded payload for later usage in topic subscriber.
 		message.ValidatorData = &payload
 		return pubsub.ValidationAccept
 	}
 }
 
-func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, id peer.ID, signatureBytes []byte, payloadBytes []byte) pubsub.ValidationResult {
+// verifyBlockSignature returns the address that signed the block, and whether it is one of the allowed signers.
+func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, id peer.ID, signatureBytes []byte, payloadBytes []byte) (common.Address, pubsub.ValidationResult) {
 	signingHash, err := BlockSigningHash(cfg, payloadBytes)
 	if err != nil {
 		log.Warn("failed to compute block signing hash", "err", err, "peer", id)
-		return pubsub.ValidationReject
+		return common.Address{}, pubsub.ValidationReject
 	}
 
 	pub, err := crypto.SigToPub(signingHash[:], signatureBytes)
 	if err != nil {
 		log.Warn("invalid block signature", "err", err, "peer", id)
-		return pubsub.ValidationReject
+		return common.Address{}, pubsub.ValidationReject
 	}
 	addr := crypto.PubkeyToAddress(*pub)
 
 	// In the future we may load & validate block metadata before checking the signature.
-	// And then check the signer based on the metadata, to support e.g. multiple p2p signers at the same time.
-	// For now we only have one signer at a time and thus check the address directly.
+	// For now any signer of the configured set is accepted at any time, e.g. every sequencer of a failover cluster.
 	// This means we may drop old payloads upon key rotation,
 	// but this can be recovered from like any other missed unsafe payload.
-	if expected := runCfg.P2PSequencerAddress(); expected == (common.Address{}) {
+	expected := runCfg.P2PSequencerAddresses()
+	if len(expected) == 0 {
 		log.Warn("no configured p2p sequencer address, ignoring gossiped block", "peer", id, "addr", addr)
-		return pubsub.ValidationIgnore
-	} else if addr != expected {
-		log.Warn("unexpected block author", "err", err, "peer", id, "addr", addr, "expected", expected)
-		return pubsub.ValidationReject
+		return addr, pubsub.ValidationIgnore
+	}
+	if !slices.Contains(expected, addr) {
+		log.Warn("unexpected block author", "peer", id, "addr", addr, "expected", expected)
+		return addr, pubsub.ValidationReject
 	}
-	return pubsub.ValidationAccept
+	return addr, pubsub.ValidationAccept
 }
 
 type GossipIn interface {
@@ -500,11 +509,11 @@ func (p *publisher) Close() error {
 	return errors.Join(e1, e2)
 }
 
-func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn) (GossipOut, error) {
+func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, m GossipMetricer, gossipIn GossipIn) (GossipOut, error) {
 	p2pCtx, p2pCancel := context.WithCancel(context.Background())
 
 	v1Logger := log.New("topic", "blocksV1")
-	blocksV1Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv1", v1Logger, BuildBlocksValidator(v1Logger, cfg, runCfg, eth.BlockV1)))
+	blocksV1Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv1", v1Logger, BuildBlocksValidator(v1Logger, cfg, runCfg, m, eth.BlockV1)))
 	blocksV1, err := newBlockTopic(p2pCtx, blocksTopicV1(cfg), ps, v1Logger, gossipIn, blocksV1Validator)
 	if err != nil {
 		p2pCancel()
@@ -512,7 +521,7 @@ func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Con
 	}
 
 	v2Logger := log.New("topic", "blocksV2")
-	blocksV2Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv2", v2Logger, BuildBlocksValidator(v2Logger, cfg, runCfg, eth.BlockV2)))
+	blocksV2Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv2", v2Logger, BuildBlocksValidator(v2Logger, cfg, runCfg, m, eth.BlockV2)))
 	blocksV2, err := newBlockTopic(p2pCtx, blocksTopicV2(cfg), ps, v2Logger, gossipIn, blocksV2Validator)
 	if err != nil {
 		p2pCancel()
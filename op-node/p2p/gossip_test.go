@@ -70,8 +70,22 @@ func TestVerifyBlockSignature(t *testing.T) {
 		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
 		require.NoError(t, err)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		addr, result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
 		require.Equal(t, pubsub.ValidationAccept, result)
+		require.Equal(t, runCfg.P2PSeqAddress, addr)
+	})
+
+	t.Run("SignerSet", func(t *testing.T) {
+		runCfg := &testutils.MockRuntimeConfig{
+			P2PSeqAddress:   common.HexToAddress("0x1234"),
+			P2PSeqAddresses: []common.Address{common.HexToAddress("0x5678"), crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey)},
+		}
+		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
+		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
+		require.NoError(t, err)
+		addr, result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		require.Equal(t, pubsub.ValidationAccept, result)
+		require.Equal(t, crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey), addr)
 	})
 
 	t.Run("WrongSigner", func(t *testing.T) {
@@ -79,14 +93,15 @@ func TestVerifyBlockSignature(t *testing.T) {
 		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
 		require.NoError(t, err)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		addr, result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
 		require.Equal(t, pubsub.ValidationReject, result)
+		require.Equal(t, crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey), addr)
 	})
 
 	t.Run("InvalidSignature", func(t *testing.T) {
 		runCfg := &testutils.MockRuntimeConfig{P2PSeqAddress: crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey)}
 		sig := make([]byte, 65)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig, msg)
+		_, result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig, msg)
 		require.Equal(t, pubsub.ValidationReject, result)
 	})
 
@@ -95,7 +110,7 @@ func TestVerifyBlockSignature(t *testing.T) {
 		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
 		require.NoError(t, err)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		_, result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
 		require.Equal(t, pubsub.ValidationIgnore, result)
 	})
 }
@@ -119,6 +134,16 @@ func createSignedP2Payload(payload *eth.ExecutionPayload, signer Signer, l2Chain
 	return snappy.Encode(nil, data), nil
 }
 
+// signerRecorder records the signers of the accepted gossiped blocks
+type signerRecorder struct {
+	GossipMetricer
+	signers []common.Address
+}
+
+func (r *signerRecorder) RecordGossipBlockSigner(signer common.Address) {
+	r.signers = append(r.signers, signer)
+}
+
 // TestBlockValidator does some very basic tests of the p2p block validation logic
 func TestBlockValidator(t *testing.T) {
 	// Params Set 1: Create the validation function
@@ -130,8 +155,10 @@ func TestBlockValidator(t *testing.T) {
 	runCfg := &testutils.MockRuntimeConfig{P2PSeqAddress: crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey)}
 	signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 
-	// valFnV1 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), rollupCfg, runCfg, eth.BlockV1)
-	valFnV2 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), cfg, runCfg, eth.BlockV2)
+	m := new(signerRecorder)
+
+	// valFnV1 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), rollupCfg, runCfg, m, eth.BlockV1)
+	valFnV2 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), cfg, runCfg, m, eth.BlockV2)
 
 	// Params Set 2: Call the validation function
 	peerID := peer.ID("foo")
@@ -147,6 +174,7 @@ func TestBlockValidator(t *testing.T) {
 	message := &pubsub.Message{Message: &pubsub_pb.Message{Data: data}}
 	res := valFnV2(context.TODO(), peerID, message)
 	require.Equal(t, res, pubsub.ValidationAccept)
+	require.Equal(t, []common.Address{runCfg.P2PSeqAddress}, m.signers, "the signer of the accepted block is recorded")
 
 	// Invalid because non-empty withdrawals when Canyon is active
 	payload = eth.ExecutionPayload{
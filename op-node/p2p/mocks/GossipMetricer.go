@@ -2,13 +2,21 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	common "github.com/ethereum/go-ethereum/common"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // GossipMetricer is an autogenerated mock type for the GossipMetricer type
 type GossipMetricer struct {
 	mock.Mock
 }
 
+// RecordGossipBlockSigner provides a mock function with given fields: signer
+func (_m *GossipMetricer) RecordGossipBlockSigner(signer common.Address) {
+	_m.Called(signer)
+}
+
 // RecordGossipEvent provides a mock function with given fields: evType
 func (_m *GossipMetricer) RecordGossipEvent(evType int32) {
 	_m.Called(evType)
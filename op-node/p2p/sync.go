@@ -17,6 +17,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
+	msmux "github.com/multiformats/go-multistream"
 	"golang.org/x/time/rate"
 
 	"github.com/ethereum/go-ethereum"
@@ -65,6 +66,10 @@ func PayloadByNumberProtocolID(l2ChainID *big.Int) protocol.ID {
 	return protocol.ID(fmt.Sprintf("/opstack/req/payload_by_number/%d/0", l2ChainID))
 }
 
+func PayloadByHashProtocolID(l2ChainID *big.Int) protocol.ID {
+	return protocol.ID(fmt.Sprintf("/opstack/req/payload_by_hash/%d/0", l2ChainID))
+}
+
 type requestHandlerFn func(ctx context.Context, log log.Logger, stream network.Stream)
 
 func MakeStreamHandler(resourcesCtx context.Context, log log.Logger, fn requestHandlerFn) network.StreamHandler {
@@ -85,7 +90,7 @@ type newStreamFn func(ctx context.Context, peerId peer.ID, protocolId ...protoco
 type receivePayloadFn func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error
 
 type rangeRequest struct {
-	start uint64
+	start eth.L2BlockRef
 	end   eth.L2BlockRef
 }
 
@@ -96,6 +101,9 @@ type syncResult struct {
 
 type peerRequest struct {
 	num uint64
+	// hash is set if the block is requested by hash rather than by number.
+	// The num is then the expected number of the block.
+	hash common.Hash
 
 	complete *atomic.Bool
 }
@@ -108,6 +116,7 @@ type inFlightCheck struct {
 
 type SyncClientMetrics interface {
 	ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ClientPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration)
 	PayloadsQuarantineSize(n int)
 }
 
@@ -137,8 +146,13 @@ type SyncPeerScorer interface {
 //   - Requests for data that's already in the quarantine are not repeated
 //   - Data already in the quarantine that is trusted is attempted to be promoted.
 //
+// - Once a block is promoted, its parent is requested by hash, if it is not in the quarantine already.
+//   - The parent-hash walk continues until it joins the local unsafe chain at the start of the range.
+//   - If a by-number result conflicts with the parent-hash we are waiting for at that height,
+//     the parent is requested by hash, instead of waiting for the conflicting block to be evicted.
+//
 // - Peers each have their own routine for processing requests.
-//   - They fetch the requested block by number, parse and validate it, and then send it back to the main loop
+//   - They fetch the requested block by number or hash, parse and validate it, and then send it back to the main loop
 //   - If peers fail to fetch or process it, or fail to send it back to the main loop within timeout,
 //     then the doRequest returns an error. It then marks the in-flight request as completed.
 //
@@ -191,6 +205,7 @@ type SyncClient struct {
 
 	newStreamFn     newStreamFn
 	payloadByNumber protocol.ID
+	payloadByHash   protocol.ID
 
 	peersLock sync.Mutex
 	// syncing worker per peer
@@ -211,6 +226,11 @@ type SyncClient struct {
 	// inFlight requests are not repeated
 	inFlight map[uint64]*atomic.Bool
 
+	// localHead is the start of the latest range request: the local unsafe chain we sync towards.
+	localHead eth.L2BlockRef
+	// awaiting tracks the trusted parent-hash we are looking for, by block number.
+	awaiting map[uint64]common.Hash
+
 	requests       chan rangeRequest
 	peerRequests   chan peerRequest
 	inFlightChecks chan inFlightCheck
@@ -244,9 +264,11 @@ func NewSyncClient(log log.Logger, cfg *rollup.Config, newStream newStreamFn, rc
 		appScorer:       appScorer,
 		newStreamFn:     newStream,
 		payloadByNumber: PayloadByNumberProtocolID(cfg.L2ChainID),
+		payloadByHash:   PayloadByHashProtocolID(cfg.L2ChainID),
 		peers:           make(map[peer.ID]context.CancelFunc),
 		quarantineByNum: make(map[uint64]common.Hash),
 		inFlight:        make(map[uint64]*atomic.Bool),
+		awaiting:        make(map[uint64]common.Hash),
 		requests:        make(chan rangeRequest), // blocking
 		peerRequests:    make(chan peerRequest, 128),
 		results:         make(chan syncResult, 128),
@@ -320,7 +342,7 @@ func (s *SyncClient) RequestL2Range(ctx context.Context, start, end eth.L2BlockR
 	}
 	// synchronize requests with the main loop for state access
 	select {
-	case s.requests <- rangeRequest{start: start.Number, end: end}:
+	case s.requests <- rangeRequest{start: start, end: end}:
 		return nil
 	case <-ctx.Done():
 		return fmt.Errorf("too busy with P2P results/requests: %w", ctx.Err())
@@ -380,6 +402,7 @@ func (s *SyncClient) onRangeRequest(ctx context.Context, req rangeRequest) {
 	// add req head to trusted set of blocks
 	s.trusted.Add(req.end.Hash, struct{}{})
 	s.trusted.Add(req.end.ParentHash, struct{}{})
+	s.localHead = req.start
 
 	log := s.log.New("target", req.start, "end", req.end)
 
@@ -389,11 +412,20 @@ func (s *SyncClient) onRangeRequest(ctx context.Context, req rangeRequest) {
 			delete(s.inFlight, k)
 		}
 	}
+	// forget about the parent-hashes we were looking for at or below the local chain
+	for k := range s.awaiting {
+		if k <= req.start.Number {
+			delete(s.awaiting, k)
+		}
+	}
+	if req.end.Number > req.start.Number+1 {
+		s.awaiting[req.end.Number-1] = req.end.ParentHash
+	}
 
 	// Now try to fetch lower numbers than current end, to traverse back towards the updated start.
 	for i := uint64(0); ; i++ {
 		num := req.end.Number - 1 - i
-		if num <= req.start {
+		if num <= req.start.Number {
 			return
 		}
 		// check if we have something in quarantine already
@@ -467,17 +499,53 @@ func (s *SyncClient) promote(ctx context.Context, res syncResult) {
 		s.log.Debug("promoted new p2p-synced block to main", "id", res.payload.ID())
 	}
 
+	delete(s.awaiting, uint64(res.payload.BlockNumber))
+
 	// Mark parent block as trusted, so that we can promote it once we receive it / find it
 	s.trusted.Add(res.payload.ParentHash, struct{}{})
 
-	// Try to promote the parent block too, if any: previous unverifiable data may now be canonical
-	s.tryPromote(res.payload.ParentHash)
-
+	if s.quarantine.Contains(res.payload.ParentHash) {
+		// Try to promote the parent block too, if any: previous unverifiable data may now be canonical
+		s.tryPromote(res.payload.ParentHash)
+		return
+	}
 	// In case we don't have the parent, and what we have in quarantine is wrong,
-	// clear what we buffered in favor of fetching something else.
+	// clear what we buffered in favor of fetching the parent by hash.
 	if h, ok := s.quarantineByNum[uint64(res.payload.BlockNumber)-1]; ok {
 		s.quarantine.Remove(h)
 	}
+	s.requestParent(res.payload)
+}
+
+// requestParent walks back the parent-hashes of a promoted payload, until the local unsafe chain is reached.
+func (s *SyncClient) requestParent(payload *eth.ExecutionPayload) {
+	num := uint64(payload.BlockNumber)
+	if num == 0 || num-1 <= s.localHead.Number {
+		if num-1 == s.localHead.Number && payload.ParentHash != s.localHead.Hash {
+			s.log.Warn("P2P synced chain does not build on local unsafe head",
+				"id", payload.ID(), "parent", payload.ParentHash, "local_head", s.localHead)
+		}
+		return
+	}
+	s.awaiting[num-1] = payload.ParentHash
+	if complete, ok := s.inFlight[num-1]; ok && !complete.Load() {
+		// If the in-flight request returns a different block, then the parent is requested by hash.
+		s.log.Debug("parent block request still in-flight", "num", num-1)
+		return
+	}
+	s.requestByHash(num-1, payload.ParentHash)
+}
+
+// requestByHash schedules a request for the given block hash, without blocking the main loop.
+func (s *SyncClient) requestByHash(num uint64, h common.Hash) {
+	pr := peerRequest{num: num, hash: h, complete: new(atomic.Bool)}
+	select {
+	case s.peerRequests <- pr:
+		s.log.Debug("Scheduling P2P block request by hash", "num", num, "hash", h)
+		s.inFlight[num] = pr.complete
+	default:
+		s.log.Debug("no peers ready to handle block request by hash", "num", num, "hash", h)
+	}
 }
 
 // onResult is exclusively called by the main loop, and has thus direct access to the request bookkeeping state.
@@ -493,6 +561,12 @@ func (s *SyncClient) onResult(ctx context.Context, res syncResult) {
 	// If we know this block is canonical, then promote it
 	if s.trusted.Contains(res.payload.BlockHash) {
 		s.promote(ctx, res)
+		return
+	}
+	// If we know which block we want at this height, and this is not it, then request it by hash
+	if h, ok := s.awaiting[uint64(res.payload.BlockNumber)]; ok && h != res.payload.BlockHash {
+		s.log.Debug("received conflicting block, requesting trusted block by hash", "id", res.payload.ID(), "trusted", h)
+		s.requestByHash(uint64(res.payload.BlockNumber), h)
 	}
 }
 
@@ -529,11 +603,17 @@ func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
 			// We already established the peer is available w.r.t. rate-limiting,
 			// and this is the only loop over this peer, so we can request now.
 			start := time.Now()
-			err := s.doRequest(ctx, id, pr.num)
+			err := s.doRequest(ctx, id, pr)
+			if errors.Is(err, msmux.ErrNotSupported[protocol.ID]{}) {
+				// Peers that do not support the protocol are not penalized, other peers may serve the request.
+				pr.complete.Store(true)
+				log.Debug("peer does not support p2p sync request", "num", pr.num, "hash", pr.hash)
+				continue
+			}
 			if err != nil {
 				// mark as complete if there's an error: we are not sending any result and can complete immediately.
 				pr.complete.Store(true)
-				log.Warn("failed p2p sync request", "num", pr.num, "err", err)
+				log.Warn("failed p2p sync request", "num", pr.num, "hash", pr.hash, "err", err)
 				s.appScorer.onResponseError(id)
 				// If we hit an error, then count it as many requests.
 				// We'd like to avoid making more requests for a while, to back off.
@@ -541,7 +621,7 @@ func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
 					return
 				}
 			} else {
-				log.Debug("completed p2p sync request", "num", pr.num)
+				log.Debug("completed p2p sync request", "num", pr.num, "hash", pr.hash)
 				s.appScorer.onValidResponse(id)
 			}
 			took := time.Since(start)
@@ -554,7 +634,11 @@ func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
 					resultCode = 1
 				}
 			}
-			s.metrics.ClientPayloadByNumberEvent(pr.num, resultCode, took)
+			if pr.hash != (common.Hash{}) {
+				s.metrics.ClientPayloadByHashEvent(pr.num, resultCode, took)
+			} else {
+				s.metrics.ClientPayloadByNumberEvent(pr.num, resultCode, took)
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -571,10 +655,16 @@ func (r requestResultErr) ResultCode() byte {
 	return byte(r)
 }
 
-func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, expectedBlockNum uint64) error {
+func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, pr peerRequest) error {
+	expectedBlockNum := pr.num
+	byHash := pr.hash != (common.Hash{})
+	protocolID := s.payloadByNumber
+	if byHash {
+		protocolID = s.payloadByHash
+	}
 	// open stream to peer
 	reqCtx, reqCancel := context.WithTimeout(ctx, streamTimeout)
-	str, err := s.newStreamFn(reqCtx, id, s.payloadByNumber)
+	str, err := s.newStreamFn(reqCtx, id, protocolID)
 	reqCancel()
 	if err != nil {
 		return fmt.Errorf("failed to open stream: %w", err)
@@ -582,7 +672,11 @@ func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, expectedBlockNum
 	defer str.Close()
 	// set write timeout (if available)
 	_ = str.SetWriteDeadline(time.Now().Add(clientWriteRequestTimeout))
-	if err := binary.Write(str, binary.LittleEndian, expectedBlockNum); err != nil {
+	if byHash {
+		if _, err := str.Write(pr.hash[:]); err != nil {
+			return fmt.Errorf("failed to write request (%s): %w", pr.hash, err)
+		}
+	} else if err := binary.Write(str, binary.LittleEndian, expectedBlockNum); err != nil {
 		return fmt.Errorf("failed to write request (%d): %w", expectedBlockNum, err)
 	}
 	if err := str.CloseWrite(); err != nil {
@@ -638,6 +732,9 @@ func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, expectedBlockNum
 	if err := verifyBlock(&res, expectedBlockNum); err != nil {
 		return fmt.Errorf("received execution payload is invalid: %w", err)
 	}
+	if byHash && res.BlockHash != pr.hash {
+		return fmt.Errorf("received execution payload %s, but expected block %s", res.BlockHash, pr.hash)
+	}
 	select {
 	case s.results <- syncResult{payload: &res, peer: id}:
 	case <-ctx.Done():
@@ -666,10 +763,12 @@ type peerStat struct {
 
 type L2Chain interface {
 	PayloadByNumber(ctx context.Context, number uint64) (*eth.ExecutionPayload, error)
+	PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error)
 }
 
 type ReqRespServerMetrics interface {
 	ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerPayloadByHashEvent(num uint64, resultCode byte, duration time.Duration)
 }
 
 type ReqRespServer struct {
@@ -720,13 +819,7 @@ func (srv *ReqRespServer) HandleSyncRequest(ctx context.Context, log log.Logger,
 	resultCode := byte(0)
 	if err != nil {
 		log.Warn("failed to serve p2p sync request", "req", req, "err", err)
-		if errors.Is(err, ethereum.NotFound) {
-			resultCode = 1
-		} else if errors.Is(err, invalidRequestErr) {
-			resultCode = 2
-		} else {
-			resultCode = 3
-		}
+		resultCode = syncResultCode(err)
 		// try to write error code, so the other peer can understand the reason for failure.
 		_, _ = stream.Write([]byte{resultCode})
 	} else {
@@ -735,37 +828,44 @@ func (srv *ReqRespServer) HandleSyncRequest(ctx context.Context, log log.Logger,
 	srv.metrics.ServerPayloadByNumberEvent(req, 0, time.Since(start))
 }
 
-var invalidRequestErr = errors.New("invalid request")
+// HandleSyncByHashRequest is a stream handler function to register the L2 unsafe payloads by hash alt-sync protocol.
+// The response format is the same as that of HandleSyncRequest.
+//
+// The caller must Close the stream.
+func (srv *ReqRespServer) HandleSyncByHashRequest(ctx context.Context, log log.Logger, stream network.Stream) {
+	start := time.Now()
 
-func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.Stream) (uint64, error) {
-	peerId := stream.Conn().RemotePeer()
+	ctx, cancel := context.WithTimeout(ctx, maxThrottleDelay)
+	req, num, err := srv.handleSyncByHashRequest(ctx, stream)
+	cancel()
 
-	// take a token from the global rate-limiter,
-	// to make sure there's not too much concurrent server work between different peers.
-	if err := srv.globalRequestsRL.Wait(ctx); err != nil {
-		return 0, fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
+	resultCode := byte(0)
+	if err != nil {
+		log.Warn("failed to serve p2p sync request by hash", "req", req, "err", err)
+		resultCode = syncResultCode(err)
+		_, _ = stream.Write([]byte{resultCode})
+	} else {
+		log.Debug("successfully served sync response by hash", "req", req, "num", num)
 	}
+	srv.metrics.ServerPayloadByHashEvent(num, resultCode, time.Since(start))
+}
 
-	// find rate limiting data of peer, or add otherwise
-	srv.peerStatsLock.Lock()
-	ps, _ := srv.peerRateLimits.Get(peerId)
-	if ps == nil {
-		ps = &peerStat{
-			Requests: rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst),
-		}
-		srv.peerRateLimits.Add(peerId, ps)
-		ps.Requests.Reserve() // count the hit, but make it delay the next request rather than immediately waiting
+var invalidRequestErr = errors.New("invalid request")
+
+func syncResultCode(err error) byte {
+	if errors.Is(err, ethereum.NotFound) {
+		return 1
+	} else if errors.Is(err, invalidRequestErr) {
+		return 2
 	} else {
-		// Only wait if it's an existing peer, otherwise the instant rate-limit Wait call always errors.
+		return 3
+	}
+}
 
-		// If the requester thinks we're taking too long, then it's their problem and they can disconnect.
-		// We'll disconnect ourselves only when failing to read/write,
-		// if the work is invalid (range validation), or when individual sub tasks timeout.
-		if err := ps.Requests.Wait(ctx); err != nil {
-			return 0, fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
-		}
+func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.Stream) (uint64, error) {
+	if err := srv.rateLimit(ctx, stream.Conn().RemotePeer()); err != nil {
+		return 0, err
 	}
-	srv.peerStatsLock.Unlock()
 
 	// Set read deadline, if available
 	_ = stream.SetReadDeadline(time.Now().Add(serverReadRequestTimeout))
@@ -799,7 +899,79 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 			return req, fmt.Errorf("failed to retrieve payload to serve to peer: %w", err)
 		}
 	}
+	return req, writeSyncResponse(stream, payload)
+}
+
+func (srv *ReqRespServer) handleSyncByHashRequest(ctx context.Context, stream network.Stream) (common.Hash, uint64, error) {
+	if err := srv.rateLimit(ctx, stream.Conn().RemotePeer()); err != nil {
+		return common.Hash{}, 0, err
+	}
+
+	// Set read deadline, if available
+	_ = stream.SetReadDeadline(time.Now().Add(serverReadRequestTimeout))
+
+	// Read the request
+	var req common.Hash
+	if _, err := io.ReadFull(stream, req[:]); err != nil {
+		return common.Hash{}, 0, fmt.Errorf("failed to read requested block hash: %w", err)
+	}
+	if err := stream.CloseRead(); err != nil {
+		return req, 0, fmt.Errorf("failed to close reading-side of a P2P sync request call: %w", err)
+	}
 
+	payload, err := srv.l2.PayloadByHash(ctx, req)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return req, 0, fmt.Errorf("peer requested unknown block by hash: %w", err)
+		} else {
+			return req, 0, fmt.Errorf("failed to retrieve payload to serve to peer: %w", err)
+		}
+	}
+	// Some L2 sources signal unknown blocks with a nil payload instead of a NotFound error
+	if payload == nil {
+		return req, 0, fmt.Errorf("peer requested unknown block by hash: %w", ethereum.NotFound)
+	}
+	num := uint64(payload.BlockNumber)
+	// Blocks before genesis are not served, the same as by number
+	if num < srv.cfg.Genesis.L2.Number {
+		return req, num, fmt.Errorf("cannot serve request for L2 block %d before genesis %d: %w", num, srv.cfg.Genesis.L2.Number, invalidRequestErr)
+	}
+	return req, num, writeSyncResponse(stream, payload)
+}
+
+// rateLimit waits for the global and per-peer rate-limits of the server.
+func (srv *ReqRespServer) rateLimit(ctx context.Context, peerId peer.ID) error {
+	// take a token from the global rate-limiter,
+	// to make sure there's not too much concurrent server work between different peers.
+	if err := srv.globalRequestsRL.Wait(ctx); err != nil {
+		return fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
+	}
+
+	// find rate limiting data of peer, or add otherwise
+	srv.peerStatsLock.Lock()
+	ps, _ := srv.peerRateLimits.Get(peerId)
+	if ps == nil {
+		ps = &peerStat{
+			Requests: rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst),
+		}
+		srv.peerRateLimits.Add(peerId, ps)
+		ps.Requests.Reserve() // count the hit, but make it delay the next request rather than immediately waiting
+	} else {
+		// Only wait if it's an existing peer, otherwise the instant rate-limit Wait call always errors.
+
+		// If the requester thinks we're taking too long, then it's their problem and they can disconnect.
+		// We'll disconnect ourselves only when failing to read/write,
+		// if the work is invalid (range validation), or when individual sub tasks timeout.
+		if err := ps.Requests.Wait(ctx); err != nil {
+			srv.peerStatsLock.Unlock()
+			return fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
+		}
+	}
+	srv.peerStatsLock.Unlock()
+	return nil
+}
+
+func writeSyncResponse(stream network.Stream, payload *eth.ExecutionPayload) error {
 	// We set write deadline, if available, to safely write without blocking on a throttling peer connection
 	_ = stream.SetWriteDeadline(time.Now().Add(serverWriteChunkTimeout))
 
@@ -807,14 +979,14 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 	// 1:5 - version: 0
 	var tmp [5]byte
 	if _, err := stream.Write(tmp[:]); err != nil {
-		return req, fmt.Errorf("failed to write response header data: %w", err)
+		return fmt.Errorf("failed to write response header data: %w", err)
 	}
 	w := snappy.NewBufferedWriter(stream)
 	if _, err := payload.MarshalSSZ(w); err != nil {
-		return req, fmt.Errorf("failed to write payload to sync response: %w", err)
+		return fmt.Errorf("failed to write payload to sync response: %w", err)
 	}
 	if err := w.Close(); err != nil {
-		return req, fmt.Errorf("failed to finishing writing payload to sync response: %w", err)
+		return fmt.Errorf("failed to finishing writing payload to sync response: %w", err)
 	}
-	return req, nil
+	return nil
 }
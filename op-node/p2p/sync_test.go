@@ -29,8 +29,28 @@ func (fn mockPayloadFn) PayloadByNumber(_ context.Context, number uint64) (*eth.
 	return fn(number)
 }
 
+func (fn mockPayloadFn) PayloadByHash(_ context.Context, _ common.Hash) (*eth.ExecutionPayload, error) {
+	return nil, ethereum.NotFound
+}
+
 var _ L2Chain = mockPayloadFn(nil)
 
+// mockL2Chain serves payloads by number from one chain, and by hash from all known payloads.
+type mockL2Chain struct {
+	byNumber mockPayloadFn
+	byHash   func(h common.Hash) (*eth.ExecutionPayload, error)
+}
+
+func (m *mockL2Chain) PayloadByNumber(_ context.Context, number uint64) (*eth.ExecutionPayload, error) {
+	return m.byNumber(number)
+}
+
+func (m *mockL2Chain) PayloadByHash(_ context.Context, h common.Hash) (*eth.ExecutionPayload, error) {
+	return m.byHash(h)
+}
+
+var _ L2Chain = (*mockL2Chain)(nil)
+
 type syncTestData struct {
 	sync.RWMutex
 	payloads map[uint64]*eth.ExecutionPayload
@@ -289,6 +309,122 @@ func TestMultiPeerSync(t *testing.T) {
 	}
 }
 
+func TestSyncByHashAfterReorg(t *testing.T) {
+	t.Parallel() // Takes a while, but can run in parallel
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	// Fork the chain after block 10: the server still has the old chain as canonical,
+	// but the gossiped tip, and thus the sync target, is on the fork.
+	fork := make(map[common.Hash]*eth.ExecutionPayload)
+	parent, _ := payloads.getPayload(10)
+	var forkTip *eth.ExecutionPayload
+	for i := uint64(11); i <= 20; i++ {
+		payload := &eth.ExecutionPayload{
+			ParentHash:   parent.BlockHash,
+			FeeRecipient: common.Address{0xff},
+			BlockNumber:  eth.Uint64Quantity(i),
+			Timestamp:    eth.Uint64Quantity(cfg.Genesis.L2Time + i*cfg.BlockTime),
+		}
+		payload.BlockHash, _ = payload.CheckBlockHash()
+		fork[payload.BlockHash] = payload
+		parent = payload
+		forkTip = payload
+	}
+
+	var requestedByHash sync.Map
+	l2 := &mockL2Chain{
+		byNumber: func(n uint64) (*eth.ExecutionPayload, error) {
+			p, ok := payloads.getPayload(n)
+			if !ok {
+				return nil, ethereum.NotFound
+			}
+			return p, nil
+		},
+		byHash: func(h common.Hash) (*eth.ExecutionPayload, error) {
+			requestedByHash.Store(h, struct{}{})
+			p, ok := fork[h]
+			if !ok {
+				return nil, ethereum.NotFound
+			}
+			return p, nil
+		},
+	}
+
+	received := make(chan *eth.ExecutionPayload, 100)
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		received <- payload
+		return nil
+	})
+
+	mnet, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB := hosts[0], hosts[1]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := NewReqRespServer(cfg, l2, metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleSyncRequest))
+	hostA.SetStreamHandler(PayloadByHashProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleSyncByHashRequest))
+
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostB.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+	cl.AddPeer(hostA.ID())
+	cl.Start()
+	defer cl.Close()
+
+	end := eth.L2BlockRef{
+		Hash:       forkTip.BlockHash,
+		Number:     uint64(forkTip.BlockNumber),
+		ParentHash: forkTip.ParentHash,
+		Time:       uint64(forkTip.Timestamp),
+	}
+	require.NoError(t, cl.RequestL2Range(ctx, payloads.getBlockRef(10), end))
+
+	// The by-number results conflict with the fork, the client walks back the parent-hashes of the fork instead.
+	timeout := time.After(30 * time.Second)
+	for i := uint64(19); i > 10; i-- {
+		select {
+		case p := <-received:
+			require.Equal(t, i, uint64(p.BlockNumber), "expecting payloads in order")
+			exp, ok := fork[p.BlockHash]
+			require.True(t, ok, "expecting payload of the fork")
+			require.Equal(t, exp.ParentHash, p.ParentHash)
+		case <-timeout:
+			t.Fatalf("did not receive fork block %d in time", i)
+		}
+	}
+	// The walk stops at the local chain: block 10 is not requested by hash.
+	_, ok := requestedByHash.Load(payloads.getBlockRef(10).Hash)
+	require.False(t, ok, "should not request the local head")
+	require.Zero(t, len(received), "no other payloads expected")
+}
+
+func TestRateLimitReleasesPeerStatsOnTimeout(t *testing.T) {
+	cfg, _ := setupSyncTestData(1)
+	srv := NewReqRespServer(cfg, mockPayloadFn(nil), metrics.NoopMetrics)
+	peerID := peer.ID("foo")
+
+	// the first request of a peer is not delayed
+	require.NoError(t, srv.rateLimit(context.Background(), peerID))
+
+	// the peer exhausted its rate-limit, and the request times out waiting for it
+	ps, _ := srv.peerRateLimits.Get(peerID)
+	ps.Requests.SetBurst(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, srv.rateLimit(ctx, peerID))
+
+	// the timed out request doesn't block the requests of other peers
+	require.True(t, srv.peerStatsLock.TryLock(), "peer stats must be unlocked")
+	srv.peerStatsLock.Unlock()
+	require.NoError(t, srv.rateLimit(context.Background(), peer.ID("bar")))
+}
+
 func TestNetworkNotifyAddPeerAndRemovePeer(t *testing.T) {
 	t.Parallel()
 	log := testlog.Logger(t, log.LvlDebug)
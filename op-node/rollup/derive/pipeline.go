@@ -7,12 +7,16 @@ import (
 	"io"
 
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
+var tracer = tracing.Tracer("op-node/rollup/derive")
+
 type Metrics interface {
 	RecordL1Ref(name string, ref eth.L1BlockRef)
 	RecordL2Ref(name string, ref eth.L2BlockRef)
@@ -190,6 +194,24 @@ func (dp *DerivationPipeline) UnsafeL2SyncTarget() eth.L2BlockRef {
 // An error is expected when the underlying source closes.
 // When Step returns nil, it should be called again, to continue the derivation process.
 func (dp *DerivationPipeline) Step(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "derive.Step")
+	err := dp.step(ctx)
+	origin := dp.Origin()
+	span.SetAttributes(
+		attribute.Int64("l1.origin.number", int64(origin.Number)),
+		attribute.String("l1.origin.hash", origin.Hash.String()),
+		attribute.Bool("derive.resetting", dp.resetting < len(dp.stages)),
+	)
+	if err == io.EOF {
+		// io.EOF signals that the pipeline has no more data to process, it is not a failure.
+		span.End()
+	} else {
+		tracing.EndSpan(span, err)
+	}
+	return err
+}
+
+func (dp *DerivationPipeline) step(ctx context.Context) error {
 	defer dp.metrics.RecordL1Ref("l1_derived", dp.Origin())
 
 	// if any stages need to be reset, do that first.
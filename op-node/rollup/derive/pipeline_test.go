@@ -1,9 +1,83 @@
 package derive
 
-import "github.com/ethereum-optimism/optimism/op-service/testutils"
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	tracingTest "github.com/ethereum-optimism/optimism/op-service/tracing/test"
+)
 
 var _ Engine = (*testutils.MockEngine)(nil)
 
 var _ L1Fetcher = (*testutils.MockL1Source)(nil)
 
 var _ Metrics = (*testutils.TestDerivationMetrics)(nil)
+
+type fakeResetStage struct {
+	err error
+}
+
+func (s *fakeResetStage) Reset(ctx context.Context, base eth.L1BlockRef, baseCfg eth.SystemConfig) error {
+	return s.err
+}
+
+type fakeEngineQueue struct {
+	EngineQueueStage
+	origin  eth.L1BlockRef
+	stepErr error
+}
+
+func (e *fakeEngineQueue) Origin() eth.L1BlockRef {
+	return e.origin
+}
+
+func (e *fakeEngineQueue) SystemConfig() eth.SystemConfig {
+	return eth.SystemConfig{}
+}
+
+func (e *fakeEngineQueue) Step(ctx context.Context) error {
+	return e.stepErr
+}
+
+func TestDerivationPipelineStepSpans(t *testing.T) {
+	c := tracingTest.NewCollector(t)
+	svc := c.StartService(t)
+
+	stage := &fakeResetStage{err: errors.New("reset failed")}
+	eng := &fakeEngineQueue{origin: testutils.RandomBlockRef(rand.New(rand.NewSource(1234)))}
+	dp := &DerivationPipeline{
+		log:     testlog.Logger(t, log.LvlError),
+		stages:  []ResettableStage{stage},
+		eng:     eng,
+		metrics: &testutils.TestDerivationMetrics{},
+	}
+	ctx := context.Background()
+	require.Error(t, dp.Step(ctx))
+	stage.err = io.EOF
+	require.NoError(t, dp.Step(ctx), "stage completed resetting")
+	require.NoError(t, dp.Step(ctx), "engine queue stepped")
+	require.NoError(t, svc.Stop(context.Background()))
+
+	spans := c.Spans("derive.Step")
+	require.Len(t, spans, 3)
+	for _, span := range spans {
+		require.Equal(t, int64(eng.origin.Number), tracingTest.Attribute(span, "l1.origin.number").GetIntValue())
+		require.Equal(t, eng.origin.Hash.String(), tracingTest.Attribute(span, "l1.origin.hash").GetStringValue())
+	}
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, spans[0].Status.GetCode(), "failed steps set the error status")
+	require.True(t, tracingTest.Attribute(spans[0], "derive.resetting").GetBoolValue())
+	require.Equal(t, tracepb.Status_STATUS_CODE_UNSET, spans[1].Status.GetCode())
+	require.False(t, tracingTest.Attribute(spans[1], "derive.resetting").GetBoolValue())
+	require.Equal(t, tracepb.Status_STATUS_CODE_UNSET, spans[2].Status.GetCode())
+}
@@ -10,8 +10,10 @@ import (
 	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
@@ -68,6 +70,11 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		return nil, fmt.Errorf("failed to create the sync config: %w", err)
 	}
 
+	unsafeBlockSigners, err := NewUnsafeBlockSignersConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load unsafe block signers: %w", err)
+	}
+
 	haltOption := ctx.String(flags.RollupHalt.Name)
 	if haltOption == "none" {
 		haltOption = ""
@@ -89,10 +96,12 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 			ListenPort: ctx.Int(flags.MetricsPortFlag.Name),
 		},
 		Pprof:                       oppprof.ReadCLIConfig(ctx),
+		Tracing:                     tracing.ReadCLIConfig(ctx),
 		P2P:                         p2pConfig,
 		P2PSigner:                   p2pSignerSetup,
 		L1EpochPollInterval:         ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
 		RuntimeConfigReloadInterval: ctx.Duration(flags.RuntimeConfigReloadIntervalFlag.Name),
+		UnsafeBlockSigners:          unsafeBlockSigners,
 		Heartbeat: node.HeartbeatConfig{
 			Enabled: ctx.Bool(flags.HeartbeatEnabledFlag.Name),
 			Moniker: ctx.String(flags.HeartbeatMonikerFlag.Name),
@@ -114,6 +123,21 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 	return cfg, nil
 }
 
+func NewUnsafeBlockSignersConfig(ctx *cli.Context) (node.UnsafeBlockSignersConfig, error) {
+	var addrs []common.Address
+	for _, addr := range ctx.StringSlice(flags.UnsafeBlockSignersName) {
+		addr = strings.TrimSpace(addr)
+		if !common.IsHexAddress(addr) {
+			return node.UnsafeBlockSignersConfig{}, fmt.Errorf("invalid unsafe block signer address: %q", addr)
+		}
+		addrs = append(addrs, common.HexToAddress(addr))
+	}
+	return node.UnsafeBlockSignersConfig{
+		Addresses: addrs,
+		Override:  ctx.Bool(flags.UnsafeBlockSignersOverrideName),
+	}, nil
+}
+
 func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 	return &node.L1EndpointConfig{
 		L1NodeAddr:       ctx.String(flags.L1NodeAddr.Name),
@@ -123,6 +147,7 @@ func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 		BatchSize:        ctx.Int(flags.L1RPCMaxBatchSize.Name),
 		HttpPollInterval: ctx.Duration(flags.L1HTTPPollInterval.Name),
 		MaxConcurrency:   ctx.Int(flags.L1RPCMaxConcurrency.Name),
+		FallbackConfig:   client.ReadFallbackCLIConfig(ctx),
 	}
 }
 
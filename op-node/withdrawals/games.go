@@ -0,0 +1,108 @@
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+// gameStatusChallengerWins is the status of a dispute game that was resolved in favor of the challenger.
+// Withdrawals can never be finalized against such games.
+const gameStatusChallengerWins = 1
+
+// faultProofsPortalMajorVersion is the first major version of the OptimismPortal that proves withdrawals against
+// dispute games. Prior versions read the output to prove against from the L2OutputOracle.
+const faultProofsPortalMajorVersion = 3
+
+// GameSearchLimit bounds the number of most recently created games that FindLatestGame searches.
+var GameSearchLimit = 1000
+
+var ErrNoSuitableGame = errors.New("no suitable dispute game found")
+
+// IsFaultProofsPortal returns whether the portal proves withdrawals against dispute games, based on its version.
+func IsFaultProofsPortal(ctx context.Context, portal *bindings.OptimismPortalCaller) (bool, error) {
+	version, err := portal.Version(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to get portal version: %w", err)
+	}
+	major, _, _ := strings.Cut(version, ".")
+	majorVersion, err := strconv.Atoi(major)
+	if err != nil {
+		return false, fmt.Errorf("invalid portal version %q: %w", version, err)
+	}
+	return majorVersion >= faultProofsPortalMajorVersion, nil
+}
+
+// DisputeGame is a dispute game created through the DisputeGameFactory.
+type DisputeGame struct {
+	Index         *big.Int
+	GameType      uint8
+	Proxy         common.Address
+	L2BlockNumber *big.Int
+	RootClaim     common.Hash
+	Status        uint8
+}
+
+// FindLatestGame searches the DisputeGameFactory, from the most recently created game backwards, for the latest game of
+// the given type that proposes an output at or after the given L2 block and was not won by a challenger. The search
+// stops at the first such game, and covers at most the GameSearchLimit most recently created games.
+// The l1Cl is used to query the games themselves.
+func FindLatestGame(ctx context.Context, disputeGameFactoryContract *bindings.DisputeGameFactoryCaller, l1Cl bind.ContractCaller, gameType uint8, l2BlockNumber *big.Int) (*DisputeGame, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	gameCount, err := disputeGameFactoryContract.GameCount(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game count: %w", err)
+	}
+	oldest := new(big.Int).Sub(gameCount, big.NewInt(int64(GameSearchLimit)))
+	if oldest.Sign() < 0 {
+		oldest.SetUint64(0)
+	}
+	for i := new(big.Int).Sub(gameCount, common.Big1); i.Cmp(oldest) >= 0; i.Sub(i, common.Big1) {
+		gameAtIndex, err := disputeGameFactoryContract.GameAtIndex(opts, i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game %v: %w", i, err)
+		}
+		if gameAtIndex.GameType != gameType {
+			continue
+		}
+		game, err := bindings.NewFaultDisputeGameCaller(gameAtIndex.Proxy, l1Cl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind game %v: %w", gameAtIndex.Proxy, err)
+		}
+		gameL2BlockNumber, err := game.L2BlockNumber(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L2 block number of game %v: %w", gameAtIndex.Proxy, err)
+		}
+		if gameL2BlockNumber.Cmp(l2BlockNumber) < 0 {
+			continue
+		}
+		status, err := game.Status(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get status of game %v: %w", gameAtIndex.Proxy, err)
+		}
+		if status == gameStatusChallengerWins {
+			continue
+		}
+		rootClaim, err := game.RootClaim(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get root claim of game %v: %w", gameAtIndex.Proxy, err)
+		}
+		return &DisputeGame{
+			Index:         new(big.Int).Set(i),
+			GameType:      gameAtIndex.GameType,
+			Proxy:         gameAtIndex.Proxy,
+			L2BlockNumber: gameL2BlockNumber,
+			RootClaim:     rootClaim,
+			Status:        status,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: type %v, L2 block %v, in the latest %v games", ErrNoSuitableGame, gameType, l2BlockNumber, GameSearchLimit)
+}
@@ -0,0 +1,173 @@
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+var factoryAddr = common.Address{0xdd}
+
+type stubGame struct {
+	gameType      uint8
+	l2BlockNumber uint64
+	rootClaim     common.Hash
+	status        uint8
+}
+
+// stubL1 answers the DisputeGameFactory and FaultDisputeGame calls made when searching for games.
+// The proxy of each game is its index in the factory, plus one.
+type stubL1 struct {
+	t     *testing.T
+	games []stubGame
+}
+
+func (s *stubL1) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (s *stubL1) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if *call.To == factoryAddr {
+		factoryABI, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+		require.NoError(s.t, err)
+		method, err := factoryABI.MethodById(call.Data[:4])
+		require.NoError(s.t, err)
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		require.NoError(s.t, err)
+		switch method.Name {
+		case "gameCount":
+			return method.Outputs.Pack(big.NewInt(int64(len(s.games))))
+		case "gameAtIndex":
+			i := args[0].(*big.Int).Uint64()
+			return method.Outputs.Pack(s.games[i].gameType, uint64(0), common.BigToAddress(new(big.Int).SetUint64(i+1)))
+		}
+		s.t.Fatalf("unexpected factory call to %s", method.Name)
+	}
+
+	i := new(big.Int).SetBytes(call.To[:]).Uint64() - 1
+	if i >= uint64(len(s.games)) {
+		return nil, errors.New("unknown game")
+	}
+	game := s.games[i]
+	gameABI, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(s.t, err)
+	method, err := gameABI.MethodById(call.Data[:4])
+	require.NoError(s.t, err)
+	switch method.Name {
+	case "l2BlockNumber":
+		return method.Outputs.Pack(new(big.Int).SetUint64(game.l2BlockNumber))
+	case "status":
+		return method.Outputs.Pack(game.status)
+	case "rootClaim":
+		return method.Outputs.Pack(game.rootClaim)
+	}
+	s.t.Fatalf("unexpected game call to %s", method.Name)
+	return nil, nil
+}
+
+func TestFindLatestGame(t *testing.T) {
+	l1 := &stubL1{t: t, games: []stubGame{
+		{gameType: 0, l2BlockNumber: 100, rootClaim: common.Hash{0x01}},
+		{gameType: 0, l2BlockNumber: 200, rootClaim: common.Hash{0x02}, status: 2},
+		{gameType: 1, l2BlockNumber: 300, rootClaim: common.Hash{0x03}},
+		{gameType: 0, l2BlockNumber: 300, rootClaim: common.Hash{0x04}, status: gameStatusChallengerWins},
+		{gameType: 0, l2BlockNumber: 150, rootClaim: common.Hash{0x05}},
+	}}
+	factory, err := bindings.NewDisputeGameFactoryCaller(factoryAddr, l1)
+	require.NoError(t, err)
+
+	find := func(gameType uint8, l2BlockNumber uint64) (*DisputeGame, error) {
+		return FindLatestGame(context.Background(), factory, l1, gameType, new(big.Int).SetUint64(l2BlockNumber))
+	}
+
+	t.Run("Latest", func(t *testing.T) {
+		game, err := find(0, 120)
+		require.NoError(t, err)
+		require.Equal(t, &DisputeGame{
+			Index:         big.NewInt(4),
+			GameType:      0,
+			Proxy:         common.BigToAddress(big.NewInt(5)),
+			L2BlockNumber: big.NewInt(150),
+			RootClaim:     common.Hash{0x05},
+		}, game)
+	})
+
+	t.Run("SkipChallengerWins", func(t *testing.T) {
+		game, err := find(0, 151)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1), game.Index)
+		require.Equal(t, common.Hash{0x02}, game.RootClaim)
+	})
+
+	t.Run("GameType", func(t *testing.T) {
+		game, err := find(1, 10)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(2), game.Index)
+	})
+
+	t.Run("NotCovered", func(t *testing.T) {
+		_, err := find(0, 201)
+		require.ErrorIs(t, err, ErrNoSuitableGame)
+		_, err = find(2, 0)
+		require.ErrorIs(t, err, ErrNoSuitableGame)
+	})
+
+	t.Run("SearchLimit", func(t *testing.T) {
+		defer func(limit int) { GameSearchLimit = limit }(GameSearchLimit)
+		GameSearchLimit = 2
+		_, err := find(0, 151)
+		require.ErrorIs(t, err, ErrNoSuitableGame, "covering game is beyond the search limit")
+		game, err := find(0, 120)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(4), game.Index)
+	})
+
+	t.Run("NoGames", func(t *testing.T) {
+		empty := &stubL1{t: t}
+		factory, err := bindings.NewDisputeGameFactoryCaller(factoryAddr, empty)
+		require.NoError(t, err)
+		_, err = FindLatestGame(context.Background(), factory, empty, 0, common.Big0)
+		require.ErrorIs(t, err, ErrNoSuitableGame)
+	})
+}
+
+// stubPortal answers the version call of the OptimismPortal
+type stubPortal struct {
+	t       *testing.T
+	version string
+}
+
+func (s *stubPortal) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (s *stubPortal) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	require.NoError(s.t, err)
+	method, err := portalABI.MethodById(call.Data[:4])
+	require.NoError(s.t, err)
+	require.Equal(s.t, "version", method.Name)
+	return method.Outputs.Pack(s.version)
+}
+
+func TestIsFaultProofsPortal(t *testing.T) {
+	for version, faultProofs := range map[string]bool{"1.10.0": false, "2.5.0": false, "3.0.0": true, "3.8.0-beta.1": true} {
+		portal, err := bindings.NewOptimismPortalCaller(common.Address{0xaa}, &stubPortal{t: t, version: version})
+		require.NoError(t, err)
+		isFaultProofs, err := IsFaultProofsPortal(context.Background(), portal)
+		require.NoError(t, err)
+		require.Equal(t, faultProofs, isFaultProofs, version)
+	}
+
+	portal, err := bindings.NewOptimismPortalCaller(common.Address{0xaa}, &stubPortal{t: t, version: "invalid"})
+	require.NoError(t, err)
+	_, err = IsFaultProofsPortal(context.Background(), portal)
+	require.Error(t, err)
+}
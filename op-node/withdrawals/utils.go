@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 )
 
 var MessagePassedTopic = crypto.Keccak256Hash([]byte("MessagePassed(uint256,address,address,uint256,uint256,bytes,bytes32)"))
@@ -28,6 +29,10 @@ type ReceiptClient interface {
 	TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error)
 }
 
+type HeaderClient interface {
+	HeaderByNumber(context.Context, *big.Int) (*types.Header, error)
+}
+
 // ProvenWithdrawalParameters is the set of parameters to pass to the ProveWithdrawalTransaction
 // and FinalizeWithdrawalTransaction functions
 type ProvenWithdrawalParameters struct {
@@ -46,6 +51,52 @@ type ProvenWithdrawalParameters struct {
 // The header provided is very important. It should be a block (timestamp) for which there is a submitted output in the L2 Output Oracle
 // contract. If not, the withdrawal will fail as it the storage proof cannot be verified if there is no submitted state root.
 func ProveWithdrawalParameters(ctx context.Context, proofCl ProofClient, l2ReceiptCl ReceiptClient, txHash common.Hash, header *types.Header, l2OutputOracleContract *bindings.L2OutputOracleCaller) (ProvenWithdrawalParameters, error) {
+	// Fetch the L2OutputIndex from the L2 Output Oracle caller (on L1)
+	l2OutputIndex, err := l2OutputOracleContract.GetL2OutputIndexAfter(&bind.CallOpts{}, header.Number)
+	if err != nil {
+		return ProvenWithdrawalParameters{}, fmt.Errorf("failed to get l2OutputIndex: %w", err)
+	}
+	return ProveWithdrawalParametersForBlock(ctx, proofCl, l2ReceiptCl, txHash, header, l2OutputIndex)
+}
+
+// ProveWithdrawalParametersFaultProofs queries L1 & L2 to generate all withdrawal parameters and proof necessary to prove a
+// withdrawal on L1, for chains that propose outputs through the DisputeGameFactory instead of the L2 Output Oracle.
+// The proof is built against the latest game of the given type which covers the withdrawal and has not been lost by its
+// proposer. The L2OutputIndex of the returned parameters is the index of that game in the factory, which only a fault
+// proofs portal accepts, see IsFaultProofsPortal.
+func ProveWithdrawalParametersFaultProofs(ctx context.Context, proofCl ProofClient, l2ReceiptCl ReceiptClient, l2HeaderCl HeaderClient, txHash common.Hash, disputeGameFactoryContract *bindings.DisputeGameFactoryCaller, l1Cl bind.ContractCaller, gameType uint8) (ProvenWithdrawalParameters, error) {
+	receipt, err := l2ReceiptCl.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return ProvenWithdrawalParameters{}, err
+	}
+	game, err := FindLatestGame(ctx, disputeGameFactoryContract, l1Cl, gameType, receipt.BlockNumber)
+	if err != nil {
+		return ProvenWithdrawalParameters{}, err
+	}
+	header, err := l2HeaderCl.HeaderByNumber(ctx, game.L2BlockNumber)
+	if err != nil {
+		return ProvenWithdrawalParameters{}, fmt.Errorf("failed to get L2 header %v: %w", game.L2BlockNumber, err)
+	}
+	params, err := ProveWithdrawalParametersForBlock(ctx, proofCl, l2ReceiptCl, txHash, header, game.Index)
+	if err != nil {
+		return ProvenWithdrawalParameters{}, err
+	}
+	// The portal checks the proof against the root claim of the game, so make sure it matches before submitting it
+	outputRoot, err := rollup.ComputeL2OutputRoot(&params.OutputRootProof)
+	if err != nil {
+		return ProvenWithdrawalParameters{}, err
+	}
+	if common.Hash(outputRoot) != game.RootClaim {
+		return ProvenWithdrawalParameters{}, fmt.Errorf("output root %v of L2 block %v does not match root claim %v of game %v",
+			outputRoot, game.L2BlockNumber, game.RootClaim, game.Index)
+	}
+	return params, nil
+}
+
+// ProveWithdrawalParametersForBlock queries L2 to generate all withdrawal parameters and proof necessary to prove a withdrawal
+// on L1 against the output of the given header. The l2OutputIndex is the index of the output (or dispute game) on L1 that
+// commits to the header.
+func ProveWithdrawalParametersForBlock(ctx context.Context, proofCl ProofClient, l2ReceiptCl ReceiptClient, txHash common.Hash, header *types.Header, l2OutputIndex *big.Int) (ProvenWithdrawalParameters, error) {
 	// Transaction receipt
 	receipt, err := l2ReceiptCl.TransactionReceipt(ctx, txHash)
 	if err != nil {
@@ -70,11 +121,6 @@ func ProveWithdrawalParameters(ctx context.Context, proofCl ProofClient, l2Recei
 		return ProvenWithdrawalParameters{}, err
 	}
 
-	// Fetch the L2OutputIndex from the L2 Output Oracle caller (on L1)
-	l2OutputIndex, err := l2OutputOracleContract.GetL2OutputIndexAfter(&bind.CallOpts{}, header.Number)
-	if err != nil {
-		return ProvenWithdrawalParameters{}, fmt.Errorf("failed to get l2OutputIndex: %w", err)
-	}
 	// TODO: Could skip this step, but it's nice to double check it
 	err = VerifyProof(header.Root, p)
 	if err != nil {
@@ -7,10 +7,12 @@ import (
 	"github.com/urfave/cli/v2"
 
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -24,7 +26,7 @@ var (
 	// Required Flags
 	L1EthRpcFlag = &cli.StringFlag{
 		Name:    "l1-eth-rpc",
-		Usage:   "HTTP provider URL for L1",
+		Usage:   "HTTP provider URL for L1. A comma-separated list enables the fallback L1 client, which switches to another endpoint if the active one is unhealthy.",
 		EnvVars: prefixEnvVars("L1_ETH_RPC"),
 	}
 	RollupRpcFlag = &cli.StringFlag{
@@ -50,6 +52,17 @@ var (
 		Usage:   "Allow the proposer to submit proposals for L2 blocks derived from non-finalized L1 blocks.",
 		EnvVars: prefixEnvVars("ALLOW_NON_FINALIZED"),
 	}
+	VerifyRollupRpcFlag = &cli.StringFlag{
+		Name:    "verify-rollup-rpc",
+		Usage:   "HTTP provider URL for an independent rollup node. Outputs are only proposed if this node reports the same output root.",
+		EnvVars: prefixEnvVars("VERIFY_ROLLUP_RPC"),
+	}
+	VerifyL2EthRpcFlag = &cli.StringFlag{
+		Name: "verify-l2-eth-rpc",
+		Usage: "HTTP provider URL for an L2 execution engine. Outputs are only proposed if the output root computed locally " +
+			"from the L2ToL1MessagePasser proof at the proposed block matches.",
+		EnvVars: prefixEnvVars("VERIFY_L2_ETH_RPC"),
+	}
 	DisputeGameFactoryAddressFlag = &cli.StringFlag{
 		Name:    "dgf-address",
 		Usage:   "Address of the DisputeGameFactory contract",
@@ -69,6 +82,20 @@ var (
 		EnvVars: prefixEnvVars("DG_TYPE"),
 		Hidden:  true,
 	}
+	DisputeGameTypesFlag = &cli.StringSliceFlag{
+		Name: "dg-types",
+		Usage: "Dispute game types to create via the configured DisputeGameFactory, each with its own proposal interval, " +
+			"in the form <type>:<interval> (e.g. 0:1h). Replaces the dg-type and proposal-interval flags.",
+		EnvVars: prefixEnvVars("DG_TYPES"),
+		Hidden:  true,
+	}
+	PermissionedGameTypeFlag = &cli.UintFlag{
+		Name: "dg-permissioned-type",
+		Usage: "Dispute game type to create instead of a configured dispute game type, as long as the DisputeGameFactory " +
+			"has no implementation of the configured type, e.g. the permissioned game type before permissionless games are enabled.",
+		EnvVars: prefixEnvVars("DG_PERMISSIONED_TYPE"),
+		Hidden:  true,
+	}
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
 )
@@ -83,9 +110,13 @@ var optionalFlags = []cli.Flag{
 	PollIntervalFlag,
 	AllowNonFinalizedFlag,
 	L2OutputHDPathFlag,
+	VerifyRollupRpcFlag,
+	VerifyL2EthRpcFlag,
 	DisputeGameFactoryAddressFlag,
 	ProposalIntervalFlag,
 	DisputeGameTypeFlag,
+	DisputeGameTypesFlag,
+	PermissionedGameTypeFlag,
 }
 
 func init() {
@@ -93,7 +124,9 @@ func init() {
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, tracing.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, client.FallbackCLIFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
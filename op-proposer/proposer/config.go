@@ -2,15 +2,21 @@ package proposer
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -20,9 +26,12 @@ import (
 type CLIConfig struct {
 	/* Required Params */
 
-	// L1EthRpc is the HTTP provider URL for L1.
+	// L1EthRpc is the HTTP provider URL for L1. A comma-separated list enables the fallback L1 client.
 	L1EthRpc string
 
+	// L1FallbackConfig configures the switching between the L1 endpoints, if multiple are given.
+	L1FallbackConfig client.FallbackConfig
+
 	// RollupRpc is the HTTP provider URL for the rollup node. A comma-separated list enables the active rollup provider.
 	RollupRpc string
 
@@ -37,6 +46,15 @@ type CLIConfig struct {
 	// for L2 blocks derived from non-finalized L1 data.
 	AllowNonFinalized bool
 
+	// VerifyRollupRpc is the HTTP provider URL for an independent rollup node to cross-check outputs against.
+	VerifyRollupRpc string
+
+	// VerifyL2EthRpc is the HTTP provider URL for an L2 execution engine to compute output roots locally.
+	VerifyL2EthRpc string
+
+	// VerifyL2ClientConfig configures the client of the VerifyL2EthRpc.
+	VerifyL2ClientConfig sources.EthClientConfig
+
 	TxMgrConfig txmgr.CLIConfig
 
 	RPCConfig oprpc.CLIConfig
@@ -47,6 +65,8 @@ type CLIConfig struct {
 
 	PprofConfig oppprof.CLIConfig
 
+	TracingConfig tracing.CLIConfig
+
 	// DGFAddress is the DisputeGameFactory contract address.
 	DGFAddress string
 
@@ -55,6 +75,83 @@ type CLIConfig struct {
 
 	// DisputeGameType is the type of dispute game to create when submitting an output proposal.
 	DisputeGameType uint8
+
+	// DisputeGameTypes are the dispute game types to create, each in the form <type>:<interval>.
+	// If set, they replace the DisputeGameType and ProposalInterval.
+	DisputeGameTypes []string
+
+	// PermissionedGameType is the dispute game type to create instead of a configured dispute game type,
+	// as long as the DisputeGameFactory has no implementation of the configured type. Nil disables the fallback.
+	PermissionedGameType *uint8
+}
+
+// DefaultVerifyL2ClientConfig returns the default config of the client of the VerifyL2EthRpc.
+// It only fetches block headers and proofs, so small caches suffice.
+func DefaultVerifyL2ClientConfig() sources.EthClientConfig {
+	return sources.EthClientConfig{
+		MaxRequestsPerBatch:   20,
+		MaxConcurrentRequests: 10,
+		ReceiptsCacheSize:     10,
+		TransactionsCacheSize: 10,
+		HeadersCacheSize:      10,
+		PayloadsCacheSize:     10,
+		TrustRPC:              false,
+		MustBePostMerge:       true,
+		RPCProviderKind:       sources.RPCKindStandard,
+		MethodResetDuration:   time.Minute,
+	}
+}
+
+// DisputeGameConfig configures the proposals of a single dispute game type.
+type DisputeGameConfig struct {
+	// GameType is the type of dispute game to create.
+	GameType uint8
+	// ProposalInterval is the delay between submitting L2 output proposals for this game type.
+	ProposalInterval time.Duration
+}
+
+// DisputeGames returns the configured dispute game types. Without any DisputeGameTypes,
+// a single game type is derived from the DisputeGameType and ProposalInterval.
+func (c *CLIConfig) DisputeGames() ([]DisputeGameConfig, error) {
+	if len(c.DisputeGameTypes) == 0 {
+		if c.ProposalInterval == 0 {
+			return nil, nil
+		}
+		return []DisputeGameConfig{{GameType: c.DisputeGameType, ProposalInterval: c.ProposalInterval}}, nil
+	}
+	games := make([]DisputeGameConfig, 0, len(c.DisputeGameTypes))
+	seen := make(map[uint8]bool)
+	for _, entry := range c.DisputeGameTypes {
+		game, err := parseDisputeGameConfig(entry)
+		if err != nil {
+			return nil, err
+		}
+		if seen[game.GameType] {
+			return nil, fmt.Errorf("duplicate dispute game type %d", game.GameType)
+		}
+		seen[game.GameType] = true
+		games = append(games, game)
+	}
+	return games, nil
+}
+
+func parseDisputeGameConfig(entry string) (DisputeGameConfig, error) {
+	typeStr, intervalStr, ok := strings.Cut(strings.TrimSpace(entry), ":")
+	if !ok {
+		return DisputeGameConfig{}, fmt.Errorf("invalid dispute game type %q, expected <type>:<interval>", entry)
+	}
+	gameType, err := strconv.ParseUint(typeStr, 10, 8)
+	if err != nil {
+		return DisputeGameConfig{}, fmt.Errorf("invalid dispute game type %q: %w", entry, err)
+	}
+	interval, err := time.ParseDuration(intervalStr)
+	if err != nil {
+		return DisputeGameConfig{}, fmt.Errorf("invalid proposal interval in dispute game type %q: %w", entry, err)
+	}
+	if interval <= 0 {
+		return DisputeGameConfig{}, fmt.Errorf("proposal interval of dispute game type %q must be positive", entry)
+	}
+	return DisputeGameConfig{GameType: uint8(gameType), ProposalInterval: interval}, nil
 }
 
 func (c *CLIConfig) Check() error {
@@ -67,25 +164,54 @@ func (c *CLIConfig) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.TracingConfig.Check(); err != nil {
+		return err
+	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
+	if strings.Contains(c.L1EthRpc, ",") {
+		if err := c.L1FallbackConfig.Check(); err != nil {
+			return err
+		}
+	}
+
+	if c.VerifyL2EthRpc != "" {
+		if err := c.VerifyL2ClientConfig.Check(); err != nil {
+			return fmt.Errorf("invalid verification L2 client config: %w", err)
+		}
+	}
 
 	if c.DGFAddress != "" && c.L2OOAddress != "" {
 		return errors.New("both the `DisputeGameFactory` and `L2OutputOracle` addresses were provided")
 	}
-	if c.DGFAddress != "" && c.ProposalInterval == 0 {
+	if len(c.DisputeGameTypes) != 0 && c.ProposalInterval != 0 {
+		return errors.New("both the `DisputeGameTypes` and the `ProposalInterval` were provided")
+	}
+	games, err := c.DisputeGames()
+	if err != nil {
+		return err
+	}
+	if c.DGFAddress != "" && len(games) == 0 {
 		return errors.New("the `DisputeGameFactory` address was provided but the `ProposalInterval` was not set")
 	}
-	if c.ProposalInterval != 0 && c.DGFAddress == "" {
+	if len(games) != 0 && c.DGFAddress == "" {
 		return errors.New("the `ProposalInterval` was provided but the `DisputeGameFactory` address was not set")
 	}
+	if c.PermissionedGameType != nil && c.DGFAddress == "" {
+		return errors.New("the `PermissionedGameType` was provided but the `DisputeGameFactory` address was not set")
+	}
 
 	return nil
 }
 
 // NewConfig parses the Config from the provided flags or environment variables.
 func NewConfig(ctx *cli.Context) *CLIConfig {
+	var permissionedGameType *uint8
+	if ctx.IsSet(flags.PermissionedGameTypeFlag.Name) {
+		gameType := uint8(ctx.Uint(flags.PermissionedGameTypeFlag.Name))
+		permissionedGameType = &gameType
+	}
 	return &CLIConfig{
 		// Required Flags
 		L1EthRpc:     ctx.String(flags.L1EthRpcFlag.Name),
@@ -94,13 +220,19 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		PollInterval: ctx.Duration(flags.PollIntervalFlag.Name),
 		TxMgrConfig:  txmgr.ReadCLIConfig(ctx),
 		// Optional Flags
-		AllowNonFinalized: ctx.Bool(flags.AllowNonFinalizedFlag.Name),
-		RPCConfig:         oprpc.ReadCLIConfig(ctx),
-		LogConfig:         oplog.ReadCLIConfig(ctx),
-		MetricsConfig:     opmetrics.ReadCLIConfig(ctx),
-		PprofConfig:       oppprof.ReadCLIConfig(ctx),
-		DGFAddress:        ctx.String(flags.DisputeGameFactoryAddressFlag.Name),
-		ProposalInterval:  ctx.Duration(flags.ProposalIntervalFlag.Name),
-		DisputeGameType:   uint8(ctx.Uint(flags.DisputeGameTypeFlag.Name)),
+		AllowNonFinalized:    ctx.Bool(flags.AllowNonFinalizedFlag.Name),
+		VerifyRollupRpc:      ctx.String(flags.VerifyRollupRpcFlag.Name),
+		VerifyL2EthRpc:       ctx.String(flags.VerifyL2EthRpcFlag.Name),
+		L1FallbackConfig:     client.ReadFallbackCLIConfig(ctx),
+		RPCConfig:            oprpc.ReadCLIConfig(ctx),
+		LogConfig:            oplog.ReadCLIConfig(ctx),
+		MetricsConfig:        opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:          oppprof.ReadCLIConfig(ctx),
+		TracingConfig:        tracing.ReadCLIConfig(ctx),
+		DGFAddress:           ctx.String(flags.DisputeGameFactoryAddressFlag.Name),
+		ProposalInterval:     ctx.Duration(flags.ProposalIntervalFlag.Name),
+		DisputeGameType:      uint8(ctx.Uint(flags.DisputeGameTypeFlag.Name)),
+		DisputeGameTypes:     ctx.StringSlice(flags.DisputeGameTypesFlag.Name),
+		PermissionedGameType: permissionedGameType,
 	}
 }
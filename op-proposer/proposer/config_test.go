@@ -0,0 +1,135 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+func TestDisputeGames(t *testing.T) {
+	t.Run("Legacy", func(t *testing.T) {
+		cfg := &CLIConfig{DisputeGameType: 1, ProposalInterval: time.Hour}
+		games, err := cfg.DisputeGames()
+		require.NoError(t, err)
+		require.Equal(t, []DisputeGameConfig{{GameType: 1, ProposalInterval: time.Hour}}, games)
+	})
+
+	t.Run("NoInterval", func(t *testing.T) {
+		cfg := &CLIConfig{DisputeGameType: 1}
+		games, err := cfg.DisputeGames()
+		require.NoError(t, err)
+		require.Empty(t, games)
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		cfg := &CLIConfig{DisputeGameTypes: []string{"0:1h", " 255:30m"}}
+		games, err := cfg.DisputeGames()
+		require.NoError(t, err)
+		require.Equal(t, []DisputeGameConfig{
+			{GameType: 0, ProposalInterval: time.Hour},
+			{GameType: 255, ProposalInterval: 30 * time.Minute},
+		}, games)
+	})
+
+	for _, entry := range []string{"0", "256:1h", "x:1h", "0:1", "0:0s", "0:-1m"} {
+		entry := entry
+		t.Run("Invalid-"+entry, func(t *testing.T) {
+			cfg := &CLIConfig{DisputeGameTypes: []string{entry}}
+			_, err := cfg.DisputeGames()
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		cfg := &CLIConfig{DisputeGameTypes: []string{"0:1h", "0:2h"}}
+		_, err := cfg.DisputeGames()
+		require.ErrorContains(t, err, "duplicate")
+	})
+}
+
+func TestCheckDisputeGames(t *testing.T) {
+	validConfig := func() *CLIConfig {
+		return &CLIConfig{
+			DGFAddress:    "0x0000000000000000000000000000000000000001",
+			TxMgrConfig:   txmgr.NewCLIConfig("fake", txmgr.DefaultBatcherFlagValues),
+			RPCConfig:     rpc.DefaultCLIConfig(),
+			LogConfig:     log.DefaultCLIConfig(),
+			MetricsConfig: metrics.DefaultCLIConfig(),
+			PprofConfig:   oppprof.DefaultCLIConfig(),
+		}
+	}
+
+	cfg := validConfig()
+	require.ErrorContains(t, cfg.Check(), "`ProposalInterval` was not set")
+
+	cfg = validConfig()
+	cfg.DisputeGameTypes = []string{"0:1h", "1:1h"}
+	require.NoError(t, cfg.Check())
+
+	cfg = validConfig()
+	cfg.ProposalInterval = time.Hour
+	require.NoError(t, cfg.Check())
+
+	cfg.DisputeGameTypes = []string{"0:1h"}
+	require.ErrorContains(t, cfg.Check(), "both the `DisputeGameTypes` and the `ProposalInterval`")
+
+	cfg = validConfig()
+	cfg.DGFAddress = ""
+	cfg.DisputeGameTypes = []string{"0:1h"}
+	require.ErrorContains(t, cfg.Check(), "`DisputeGameFactory` address was not set")
+
+	permissioned := uint8(1)
+	cfg = validConfig()
+	cfg.DisputeGameTypes = []string{"0:1h"}
+	cfg.PermissionedGameType = &permissioned
+	require.NoError(t, cfg.Check())
+
+	cfg.DGFAddress = ""
+	cfg.DisputeGameTypes = nil
+	cfg.L2OOAddress = "0x0000000000000000000000000000000000000002"
+	require.ErrorContains(t, cfg.Check(), "`PermissionedGameType` was provided but the `DisputeGameFactory` address was not set")
+}
+
+func TestCheckL1FallbackConfig(t *testing.T) {
+	cfg := &CLIConfig{
+		L1EthRpc:         "http://a",
+		L2OOAddress:      "0x0000000000000000000000000000000000000001",
+		TxMgrConfig:      txmgr.NewCLIConfig("fake", txmgr.DefaultBatcherFlagValues),
+		RPCConfig:        rpc.DefaultCLIConfig(),
+		LogConfig:        log.DefaultCLIConfig(),
+		MetricsConfig:    metrics.DefaultCLIConfig(),
+		PprofConfig:      oppprof.DefaultCLIConfig(),
+		L1FallbackConfig: client.FallbackConfig{},
+	}
+	require.NoError(t, cfg.Check(), "fallback config is unused with a single L1 endpoint")
+
+	cfg.L1EthRpc = "http://a,http://b"
+	require.ErrorContains(t, cfg.Check(), "fallback check interval")
+
+	cfg.L1FallbackConfig = client.DefaultFallbackConfig()
+	require.NoError(t, cfg.Check())
+}
+
+func TestCheckVerifyL2ClientConfig(t *testing.T) {
+	cfg := &CLIConfig{
+		L2OOAddress:    "0x0000000000000000000000000000000000000001",
+		TxMgrConfig:    txmgr.NewCLIConfig("fake", txmgr.DefaultBatcherFlagValues),
+		RPCConfig:      rpc.DefaultCLIConfig(),
+		LogConfig:      log.DefaultCLIConfig(),
+		MetricsConfig:  metrics.DefaultCLIConfig(),
+		PprofConfig:    oppprof.DefaultCLIConfig(),
+		VerifyL2EthRpc: "http://l2",
+	}
+	require.ErrorContains(t, cfg.Check(), "invalid verification L2 client config")
+
+	cfg.VerifyL2ClientConfig = DefaultVerifyL2ClientConfig()
+	require.NoError(t, cfg.Check())
+}
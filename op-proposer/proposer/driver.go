@@ -54,6 +54,9 @@ type DriverSetup struct {
 
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
+
+	// Verifier optionally cross-checks outputs against independent sources before they are proposed.
+	Verifier *OutputVerifier
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -131,6 +134,10 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 }
 
 func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup DriverSetup) (*L2OutputSubmitter, error) {
+	if len(setup.Cfg.DisputeGames) == 0 {
+		cancel()
+		return nil, errors.New("no dispute game types configured")
+	}
 	dgfCaller, err := bindings.NewDisputeGameFactoryCaller(*setup.Cfg.DisputeGameFactoryAddr, setup.L1Client)
 	if err != nil {
 		cancel()
@@ -299,6 +306,12 @@ func (l *L2OutputSubmitter) fetchOutput(ctx context.Context, block *big.Int) (*e
 			"allow_non_finalized", l.Cfg.AllowNonFinalized)
 		return nil, false, nil
 	}
+	if l.Verifier != nil {
+		if err := l.Verifier.Verify(ctx, output); err != nil {
+			l.Log.Error("refusing to propose unverified output", "l2_proposal", output.BlockRef, "err", err)
+			return nil, false, err
+		}
+	}
 	return output, true, nil
 }
 
@@ -317,12 +330,16 @@ func proposeL2OutputTxData(abi *abi.ABI, output *eth.OutputResponse) ([]byte, er
 		new(big.Int).SetUint64(output.Status.CurrentL1.Number))
 }
 
-func (l *L2OutputSubmitter) ProposeL2OutputDGFTxData(output *eth.OutputResponse) ([]byte, *big.Int, error) {
-	bond, err := l.dgfContract.InitBonds(&bind.CallOpts{}, l.Cfg.DisputeGameType)
+// ProposeL2OutputDGFTxData creates the transaction data for the DisputeGameFactory's `create` function,
+// and returns the bond that the factory requires for creating a game of the given type.
+func (l *L2OutputSubmitter) ProposeL2OutputDGFTxData(ctx context.Context, gameType uint8, output *eth.OutputResponse) ([]byte, *big.Int, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	bond, err := l.dgfContract.InitBonds(&bind.CallOpts{Context: cCtx}, gameType)
 	if err != nil {
 		return nil, nil, err
 	}
-	data, err := proposeL2OutputDGFTxData(l.dgfABI, l.Cfg.DisputeGameType, output)
+	data, err := proposeL2OutputDGFTxData(l.dgfABI, gameType, output)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -331,7 +348,44 @@ func (l *L2OutputSubmitter) ProposeL2OutputDGFTxData(output *eth.OutputResponse)
 
 // proposeL2OutputDGFTxData creates the transaction data for the DisputeGameFactory's `create` function
 func proposeL2OutputDGFTxData(abi *abi.ABI, gameType uint8, output *eth.OutputResponse) ([]byte, error) {
-	return abi.Pack("create", gameType, output.OutputRoot, math.U256Bytes(new(big.Int).SetUint64(output.BlockRef.Number)))
+	return abi.Pack("create", gameType, output.OutputRoot, dgfExtraData(output))
+}
+
+// dgfExtraData returns the extra data of a dispute game proposing the given output, i.e. the L2 block number.
+func dgfExtraData(output *eth.OutputResponse) []byte {
+	return math.U256Bytes(new(big.Int).SetUint64(output.BlockRef.Number))
+}
+
+// gameExists checks whether the DisputeGameFactory already contains a game of the given type
+// with the same root claim and L2 block as the output.
+func (l *L2OutputSubmitter) gameExists(ctx context.Context, gameType uint8, output *eth.OutputResponse) (bool, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	game, err := l.dgfContract.Games(&bind.CallOpts{Context: cCtx}, gameType, output.OutputRoot, dgfExtraData(output))
+	if err != nil {
+		return false, err
+	}
+	return game.Proxy != (common.Address{}), nil
+}
+
+// proposalGameType returns the dispute game type to create for the configured game type. As long as the
+// DisputeGameFactory has no implementation of the configured type, this is the PermissionedGameType, if set.
+func (l *L2OutputSubmitter) proposalGameType(ctx context.Context, gameType uint8) (uint8, error) {
+	if l.Cfg.PermissionedGameType == nil || *l.Cfg.PermissionedGameType == gameType {
+		return gameType, nil
+	}
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	impl, err := l.dgfContract.GameImpls(&bind.CallOpts{Context: cCtx}, gameType)
+	if err != nil {
+		return 0, err
+	}
+	if impl != (common.Address{}) {
+		return gameType, nil
+	}
+	l.Log.Warn("Dispute game type has no implementation, proposing the permissioned game type instead",
+		"game_type", gameType, "permissioned_game_type", *l.Cfg.PermissionedGameType)
+	return *l.Cfg.PermissionedGameType, nil
 }
 
 // We wait until l1head advances beyond blocknum. This is used to make sure proposal tx won't
@@ -362,41 +416,16 @@ func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64)
 	return nil
 }
 
-// sendTransaction creates & sends transactions through the underlying transaction manager.
-func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse) error {
+// sendTransaction sends the proposal transaction of the output through the underlying transaction manager.
+func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse, candidate txmgr.TxCandidate) error {
 	err := l.waitForL1Head(ctx, output.Status.HeadL1.Number+1)
 	if err != nil {
 		return err
 	}
 
-	var receipt *types.Receipt
-	if l.Cfg.DisputeGameFactoryAddr != nil {
-		data, bond, err := l.ProposeL2OutputDGFTxData(output)
-		if err != nil {
-			return err
-		}
-		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
-			TxData:   data,
-			To:       l.Cfg.DisputeGameFactoryAddr,
-			GasLimit: 0,
-			Value:    bond,
-		})
-		if err != nil {
-			return err
-		}
-	} else {
-		data, err := l.ProposeL2OutputTxData(output)
-		if err != nil {
-			return err
-		}
-		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
-			TxData:   data,
-			To:       l.Cfg.L2OutputOracleAddr,
-			GasLimit: 0,
-		})
-		if err != nil {
-			return err
-		}
+	receipt, err := l.Txmgr.Send(ctx, candidate)
+	if err != nil {
+		return err
 	}
 
 	if receipt.Status == types.ReceiptStatusFailed {
@@ -433,15 +462,37 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 				break
 			}
 
-			l.proposeOutput(ctx, output)
+			data, err := l.ProposeL2OutputTxData(output)
+			if err != nil {
+				l.Log.Error("Failed to create proposal transaction", "err", err, "l2blocknum", output.BlockRef.Number)
+				break
+			}
+			l.proposeOutput(ctx, output, txmgr.TxCandidate{
+				TxData:   data,
+				To:       l.Cfg.L2OutputOracleAddr,
+				GasLimit: 0,
+			})
 		case <-l.done:
 			return
 		}
 	}
 }
 
+// loopDGF proposes outputs for every configured dispute game type, each at its own interval.
 func (l *L2OutputSubmitter) loopDGF(ctx context.Context) {
-	ticker := time.NewTicker(l.Cfg.ProposalInterval)
+	var wg sync.WaitGroup
+	for _, game := range l.Cfg.DisputeGames {
+		wg.Add(1)
+		go func(game DisputeGameConfig) {
+			defer wg.Done()
+			l.loopDGFGame(ctx, game)
+		}(game)
+	}
+	wg.Wait()
+}
+
+func (l *L2OutputSubmitter) loopDGFGame(ctx context.Context, game DisputeGameConfig) {
+	ticker := time.NewTicker(game.ProposalInterval)
 	defer ticker.Stop()
 	for {
 		select {
@@ -456,18 +507,44 @@ func (l *L2OutputSubmitter) loopDGF(ctx context.Context) {
 				break
 			}
 
-			l.proposeOutput(ctx, output)
+			gameType, err := l.proposalGameType(ctx, game.GameType)
+			if err != nil {
+				l.Log.Error("Failed to check the dispute game implementation", "err", err, "game_type", game.GameType)
+				break
+			}
+
+			exists, err := l.gameExists(ctx, gameType, output)
+			if err != nil {
+				l.Log.Error("Failed to check for existing dispute game", "err", err, "game_type", gameType, "l2blocknum", output.BlockRef.Number)
+				break
+			}
+			if exists {
+				l.Log.Info("Skipping proposal, dispute game already exists", "game_type", gameType, "l2blocknum", output.BlockRef.Number, "output_root", output.OutputRoot)
+				break
+			}
+
+			data, bond, err := l.ProposeL2OutputDGFTxData(ctx, gameType, output)
+			if err != nil {
+				l.Log.Error("Failed to create proposal transaction", "err", err, "game_type", gameType, "l2blocknum", output.BlockRef.Number)
+				break
+			}
+			l.proposeOutput(ctx, output, txmgr.TxCandidate{
+				TxData:   data,
+				To:       l.Cfg.DisputeGameFactoryAddr,
+				GasLimit: 0,
+				Value:    bond,
+			})
 		case <-l.done:
 			return
 		}
 	}
 }
 
-func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse) {
+func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse, candidate txmgr.TxCandidate) {
 	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
-	if err := l.sendTransaction(cCtx, output); err != nil {
+	if err := l.sendTransaction(cCtx, output, candidate); err != nil {
 		l.Log.Error("Failed to send proposal transaction",
 			"err", err,
 			"l1blocknum", output.Status.CurrentL1.Number,
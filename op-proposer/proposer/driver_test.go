@@ -0,0 +1,148 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// stubDGF answers the DisputeGameFactory calls made by the proposer.
+type stubDGF struct {
+	t     *testing.T
+	games map[string]common.Address
+	bonds map[uint8]*big.Int
+	impls map[uint8]common.Address
+}
+
+func (s *stubDGF) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	panic("unimplemented")
+}
+
+func (s *stubDGF) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (s *stubDGF) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	dgfABI, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(s.t, err)
+	method, err := dgfABI.MethodById(call.Data[:4])
+	require.NoError(s.t, err)
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(s.t, err)
+	switch method.Name {
+	case "games":
+		proxy := s.games[gameKey(args[0].(uint8), args[1].([32]byte), args[2].([]byte))]
+		return method.Outputs.Pack(proxy, uint64(0))
+	case "initBonds":
+		bond, ok := s.bonds[args[0].(uint8)]
+		if !ok {
+			bond = new(big.Int)
+		}
+		return method.Outputs.Pack(bond)
+	case "gameImpls":
+		return method.Outputs.Pack(s.impls[args[0].(uint8)])
+	default:
+		s.t.Fatalf("unexpected call to %s", method.Name)
+		return nil, nil
+	}
+}
+
+func gameKey(gameType uint8, rootClaim [32]byte, extraData []byte) string {
+	return string(append(append([]byte{gameType}, rootClaim[:]...), extraData...))
+}
+
+func setupDGFSubmitter(t *testing.T, dgf *stubDGF) *L2OutputSubmitter {
+	dgfCaller, err := bindings.NewDisputeGameFactoryCaller(common.Address{0xdd}, dgf)
+	require.NoError(t, err)
+	dgfABI, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	return &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:      testlog.Logger(t, log.LvlDebug),
+			Cfg:      ProposerConfig{NetworkTimeout: time.Second},
+			L1Client: dgf,
+		},
+		dgfContract: dgfCaller,
+		dgfABI:      dgfABI,
+	}
+}
+
+func TestGameExists(t *testing.T) {
+	output := &eth.OutputResponse{
+		OutputRoot: eth.Bytes32{0xaa},
+		BlockRef:   eth.L2BlockRef{Number: 42},
+	}
+	dgf := &stubDGF{t: t, games: map[string]common.Address{
+		gameKey(1, output.OutputRoot, dgfExtraData(output)): {0x01},
+	}}
+	l := setupDGFSubmitter(t, dgf)
+
+	exists, err := l.gameExists(context.Background(), 1, output)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// same output, different game type
+	exists, err = l.gameExists(context.Background(), 0, output)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// same root claim, different L2 block
+	other := &eth.OutputResponse{OutputRoot: output.OutputRoot, BlockRef: eth.L2BlockRef{Number: 43}}
+	exists, err = l.gameExists(context.Background(), 1, other)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestProposeL2OutputDGFTxDataBond(t *testing.T) {
+	dgf := &stubDGF{t: t, bonds: map[uint8]*big.Int{
+		0: big.NewInt(100),
+		1: big.NewInt(200),
+	}}
+	l := setupDGFSubmitter(t, dgf)
+	output := &eth.OutputResponse{OutputRoot: eth.Bytes32{0xaa}, BlockRef: eth.L2BlockRef{Number: 42}}
+
+	for gameType, expected := range dgf.bonds {
+		data, bond, err := l.ProposeL2OutputDGFTxData(context.Background(), gameType, output)
+		require.NoError(t, err)
+		require.Equal(t, expected, bond)
+		expectedData, err := proposeL2OutputDGFTxData(l.dgfABI, gameType, output)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(expectedData, data))
+	}
+}
+
+func TestProposalGameType(t *testing.T) {
+	dgf := &stubDGF{t: t, impls: map[uint8]common.Address{
+		0: {0x01},
+		1: {0x02},
+	}}
+	l := setupDGFSubmitter(t, dgf)
+
+	// without a permissioned game type, the configured type is always proposed
+	gameType, err := l.proposalGameType(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, uint8(2), gameType)
+
+	permissioned := uint8(1)
+	l.Cfg.PermissionedGameType = &permissioned
+
+	gameType, err = l.proposalGameType(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), gameType, "implemented game type is proposed")
+
+	gameType, err = l.proposalGameType(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, permissioned, gameType, "falls back to the permissioned game type")
+}
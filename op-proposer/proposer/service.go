@@ -13,11 +13,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-proposer/proposer/rpc"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -31,12 +34,14 @@ type ProposerConfig struct {
 	PollInterval   time.Duration
 	NetworkTimeout time.Duration
 
-	// How frequently to post L2 outputs when the DisputeGameFactory is configured
-	ProposalInterval time.Duration
-
 	L2OutputOracleAddr     *common.Address
 	DisputeGameFactoryAddr *common.Address
-	DisputeGameType        uint8
+	// The dispute game types to create, and how frequently to post L2 outputs for each of them,
+	// when the DisputeGameFactory is configured
+	DisputeGames []DisputeGameConfig
+	// PermissionedGameType is created instead of a dispute game type without implementation
+	// in the DisputeGameFactory. Nil disables the fallback.
+	PermissionedGameType *uint8
 
 	// AllowNonFinalized enables the proposal of safe, but non-finalized L2 blocks.
 	// The L1 block-hash embedded in the proposal TX is checked and should ensure the proposal
@@ -51,17 +56,24 @@ type ProposerService struct {
 
 	ProposerConfig
 
-	TxManager      txmgr.TxManager
-	L1Client       *ethclient.Client
+	TxManager txmgr.TxManager
+	L1Client  *ethclient.Client
+	// l1RPC is the client of the L1 endpoints, which the L1Client sends its requests with
+	l1RPC          client.RPC
 	RollupProvider dial.RollupProvider
 
+	// Optional clients used to verify outputs before they are proposed
+	VerifyRollupClient *sources.RollupClient
+	VerifyL2Client     *sources.EthClient
+
 	driver *L2OutputSubmitter
 
 	Version string
 
-	pprofService *oppprof.Service
-	metricsSrv   *httputil.HTTPServer
-	rpcServer    *oprpc.Server
+	pprofService   *oppprof.Service
+	tracingService *tracing.Service
+	metricsSrv     *httputil.HTTPServer
+	rpcServer      *oprpc.Server
 
 	balanceMetricer io.Closer
 
@@ -84,13 +96,18 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	ps.Log = log
 
 	ps.initMetrics(cfg)
+	if err := ps.initTracing(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
 
 	ps.PollInterval = cfg.PollInterval
 	ps.NetworkTimeout = cfg.TxMgrConfig.NetworkTimeout
 	ps.AllowNonFinalized = cfg.AllowNonFinalized
 
 	ps.initL2ooAddress(cfg)
-	ps.initDGF(cfg)
+	if err := ps.initDGF(cfg); err != nil {
+		return fmt.Errorf("failed to init DisputeGameFactory config: %w", err)
+	}
 
 	if err := ps.initRPCClients(ctx, cfg); err != nil {
 		return err
@@ -118,11 +135,12 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 }
 
 func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) error {
-	l1Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.L1EthRpc)
+	l1Client, l1RPC, err := dial.DialEthClientWithFallback(ctx, dial.DefaultDialTimeout, ps.Log, cfg.L1EthRpc, cfg.L1FallbackConfig)
 	if err != nil {
 		return fmt.Errorf("failed to dial L1 RPC: %w", err)
 	}
 	ps.L1Client = l1Client
+	ps.l1RPC = l1RPC
 
 	var rollupProvider dial.RollupProvider
 	if strings.Contains(cfg.RollupRpc, ",") {
@@ -135,6 +153,25 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 		return fmt.Errorf("failed to build L2 endpoint provider: %w", err)
 	}
 	ps.RollupProvider = rollupProvider
+
+	if cfg.VerifyRollupRpc != "" {
+		verifyRollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.VerifyRollupRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial verification rollup RPC: %w", err)
+		}
+		ps.VerifyRollupClient = verifyRollupClient
+	}
+	if cfg.VerifyL2EthRpc != "" {
+		rpcCl, err := client.NewRPC(ctx, ps.Log, cfg.VerifyL2EthRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial verification L2 RPC: %w", err)
+		}
+		verifyL2Client, err := sources.NewEthClient(rpcCl, ps.Log, nil, &cfg.VerifyL2ClientConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create verification L2 client: %w", err)
+		}
+		ps.VerifyL2Client = verifyL2Client
+	}
 	return nil
 }
 
@@ -155,7 +192,12 @@ func (ps *ProposerService) initBalanceMonitor(cfg *CLIConfig) {
 }
 
 func (ps *ProposerService) initTxManager(cfg *CLIConfig) error {
-	txManager, err := txmgr.NewSimpleTxManager("proposer", ps.Log, ps.Metrics, cfg.TxMgrConfig)
+	// share the L1 client, the L1 RPC flag may hold multiple endpoints
+	txConfig, err := txmgr.NewConfigWithClient(cfg.TxMgrConfig, ps.Log, ps.L1Client)
+	if err != nil {
+		return err
+	}
+	txManager, err := txmgr.NewSimpleTxManagerFromConfig("proposer", ps.Log, ps.Metrics, txConfig)
 	if err != nil {
 		return err
 	}
@@ -163,6 +205,14 @@ func (ps *ProposerService) initTxManager(cfg *CLIConfig) error {
 	return nil
 }
 
+func (ps *ProposerService) initTracing(ctx context.Context, cfg *CLIConfig) error {
+	ps.tracingService = tracing.New(ps.Log, cfg.TracingConfig, "op-proposer", ps.Version)
+	if err := ps.tracingService.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start tracing service: %w", err)
+	}
+	return nil
+}
+
 func (ps *ProposerService) initPProf(cfg *CLIConfig) error {
 	ps.pprofService = oppprof.New(
 		cfg.PprofConfig.ListenEnabled,
@@ -208,18 +258,35 @@ func (ps *ProposerService) initL2ooAddress(cfg *CLIConfig) {
 	ps.L2OutputOracleAddr = &l2ooAddress
 }
 
-func (ps *ProposerService) initDGF(cfg *CLIConfig) {
+func (ps *ProposerService) initDGF(cfg *CLIConfig) error {
 	dgfAddress, err := opservice.ParseAddress(cfg.DGFAddress)
 	if err != nil {
 		// Return no error & set no DGF related configuration fields.
-		return
+		return nil
+	}
+	games, err := cfg.DisputeGames()
+	if err != nil {
+		return err
 	}
 	ps.DisputeGameFactoryAddr = &dgfAddress
-	ps.ProposalInterval = cfg.ProposalInterval
-	ps.DisputeGameType = cfg.DisputeGameType
+	ps.DisputeGames = games
+	ps.PermissionedGameType = cfg.PermissionedGameType
+	return nil
 }
 
 func (ps *ProposerService) initDriver() error {
+	var verifier *OutputVerifier
+	if ps.VerifyRollupClient != nil || ps.VerifyL2Client != nil {
+		var rollupClient RollupClient
+		if ps.VerifyRollupClient != nil {
+			rollupClient = ps.VerifyRollupClient
+		}
+		var l2Client L2ProofClient
+		if ps.VerifyL2Client != nil {
+			l2Client = ps.VerifyL2Client
+		}
+		verifier = NewOutputVerifier(ps.Log, rollupClient, l2Client)
+	}
 	driver, err := NewL2OutputSubmitter(DriverSetup{
 		Log:            ps.Log,
 		Metr:           ps.Metrics,
@@ -227,6 +294,7 @@ func (ps *ProposerService) initDriver() error {
 		Txmgr:          ps.TxManager,
 		L1Client:       ps.L1Client,
 		RollupProvider: ps.RollupProvider,
+		Verifier:       verifier,
 	})
 	if err != nil {
 		return err
@@ -320,10 +388,28 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 		ps.L1Client.Close()
 	}
 
+	if ps.l1RPC != nil {
+		ps.l1RPC.Close()
+	}
+
 	if ps.RollupProvider != nil {
 		ps.RollupProvider.Close()
 	}
 
+	if ps.VerifyRollupClient != nil {
+		ps.VerifyRollupClient.Close()
+	}
+
+	if ps.VerifyL2Client != nil {
+		ps.VerifyL2Client.Close()
+	}
+
+	if ps.tracingService != nil {
+		if err := ps.tracingService.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop tracing: %w", err))
+		}
+	}
+
 	if result == nil {
 		ps.stopped.Store(true)
 		ps.Log.Info("L2Output Submitter stopped")
@@ -0,0 +1,101 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var ErrOutputMismatch = errors.New("output root mismatch")
+
+// L2ProofClient is the subset of the L2 execution client needed to compute output roots locally.
+type L2ProofClient interface {
+	InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error)
+	GetProof(ctx context.Context, address common.Address, storage []common.Hash, blockTag string) (*eth.AccountResult, error)
+}
+
+// OutputVerifier cross-checks the outputs of the primary rollup node against independent sources:
+// a second rollup node, and an output root computed locally from the L2 execution client.
+// Sources that are not configured are skipped.
+type OutputVerifier struct {
+	log    log.Logger
+	rollup RollupClient
+	l2     L2ProofClient
+}
+
+// NewOutputVerifier creates a new OutputVerifier. Either of the sources may be nil.
+func NewOutputVerifier(log log.Logger, rollupClient RollupClient, l2Client L2ProofClient) *OutputVerifier {
+	return &OutputVerifier{
+		log:    log,
+		rollup: rollupClient,
+		l2:     l2Client,
+	}
+}
+
+// Verify returns an error wrapping ErrOutputMismatch if any of the configured sources disagrees with the output.
+func (v *OutputVerifier) Verify(ctx context.Context, output *eth.OutputResponse) error {
+	if v.rollup != nil {
+		other, err := v.rollup.OutputAtBlock(ctx, output.BlockRef.Number)
+		if err != nil {
+			return fmt.Errorf("failed to fetch output from verification rollup node: %w", err)
+		}
+		if other.BlockRef.Hash != output.BlockRef.Hash || other.OutputRoot != output.OutputRoot {
+			v.log.Error("Verification rollup node disagrees on output",
+				"l2blocknum", output.BlockRef.Number,
+				"block_hash", output.BlockRef.Hash, "other_block_hash", other.BlockRef.Hash,
+				"output_root", output.OutputRoot, "other_output_root", other.OutputRoot)
+			return fmt.Errorf("%w: verification rollup node reported %s at block %d, expected %s",
+				ErrOutputMismatch, other.OutputRoot, output.BlockRef.Number, output.OutputRoot)
+		}
+	}
+	if v.l2 != nil {
+		outputRoot, err := v.computeOutputRoot(ctx, output.BlockRef)
+		if err != nil {
+			return fmt.Errorf("failed to compute output root locally: %w", err)
+		}
+		if outputRoot != output.OutputRoot {
+			v.log.Error("Locally computed output root disagrees with rollup node",
+				"l2blocknum", output.BlockRef.Number, "block_hash", output.BlockRef.Hash,
+				"output_root", output.OutputRoot, "computed_output_root", outputRoot)
+			return fmt.Errorf("%w: computed %s at block %d, expected %s",
+				ErrOutputMismatch, outputRoot, output.BlockRef.Number, output.OutputRoot)
+		}
+	}
+	return nil
+}
+
+// computeOutputRoot computes the output root of the given L2 block from the storage root of the
+// L2ToL1MessagePasser, which is verified against the state root of the block.
+// The block has to be canonical in the L2 execution client, or ErrOutputMismatch is returned.
+func (v *OutputVerifier) computeOutputRoot(ctx context.Context, ref eth.L2BlockRef) (eth.Bytes32, error) {
+	block, err := v.l2.InfoByNumber(ctx, ref.Number)
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to get L2 block %d: %w", ref.Number, err)
+	}
+	if block.Hash() != ref.Hash {
+		v.log.Error("L2 execution client disagrees on canonical block",
+			"l2blocknum", ref.Number, "block_hash", ref.Hash, "canonical_block_hash", block.Hash())
+		return eth.Bytes32{}, fmt.Errorf("%w: L2 block %d is %s, expected %s", ErrOutputMismatch, ref.Number, block.Hash(), ref.Hash)
+	}
+	proof, err := v.l2.GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, []common.Hash{}, ref.Hash.String())
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to get L2ToL1MessagePasser proof at block %s: %w", ref.Hash, err)
+	}
+	if err := proof.Verify(block.Root()); err != nil {
+		return eth.Bytes32{}, fmt.Errorf("invalid L2ToL1MessagePasser proof at block %s: %w", ref.Hash, err)
+	}
+	return rollup.ComputeL2OutputRoot(&bindings.TypesOutputRootProof{
+		Version:                  eth.OutputVersionV0,
+		StateRoot:                block.Root(),
+		MessagePasserStorageRoot: proof.StorageHash,
+		LatestBlockhash:          block.Hash(),
+	})
+}
@@ -0,0 +1,177 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type proofCollector struct {
+	nodes []hexutil.Bytes
+}
+
+func (p *proofCollector) Put(_ []byte, value []byte) error {
+	p.nodes = append(p.nodes, value)
+	return nil
+}
+
+func (p *proofCollector) Delete([]byte) error {
+	return errors.New("unsupported")
+}
+
+// stubL2 serves a single L2 block with a valid L2ToL1MessagePasser proof.
+type stubL2 struct {
+	block *testutils.MockBlockInfo
+	proof *eth.AccountResult
+}
+
+func newStubL2(t *testing.T) *stubL2 {
+	db := state.NewDatabase(rawdb.NewMemoryDatabase())
+	statedb, err := state.New(common.Hash{}, db, nil)
+	require.NoError(t, err)
+	statedb.SetNonce(predeploys.L2ToL1MessagePasserAddr, 1)
+	statedb.SetState(predeploys.L2ToL1MessagePasserAddr, common.Hash{0x01}, common.Hash{0x02})
+	statedb.SetNonce(common.Address{0xaa}, 1)
+	root, err := statedb.Commit(0, true)
+	require.NoError(t, err)
+
+	statedb, err = state.New(root, db, nil)
+	require.NoError(t, err)
+	tr, err := db.OpenTrie(root)
+	require.NoError(t, err)
+	var accountProof proofCollector
+	require.NoError(t, tr.Prove(crypto.Keccak256(predeploys.L2ToL1MessagePasserAddr.Bytes()), &accountProof))
+
+	return &stubL2{
+		block: &testutils.MockBlockInfo{
+			InfoHash:       common.Hash{0xbb},
+			InfoNum:        42,
+			InfoRoot:       root,
+			InfoParentHash: common.Hash{0xcc},
+		},
+		proof: &eth.AccountResult{
+			AccountProof: accountProof.nodes,
+			Address:      predeploys.L2ToL1MessagePasserAddr,
+			Balance:      (*hexutil.Big)(statedb.GetBalance(predeploys.L2ToL1MessagePasserAddr)),
+			CodeHash:     statedb.GetCodeHash(predeploys.L2ToL1MessagePasserAddr),
+			Nonce:        1,
+			StorageHash:  statedb.GetStorageRoot(predeploys.L2ToL1MessagePasserAddr),
+		},
+	}
+}
+
+func (s *stubL2) InfoByNumber(_ context.Context, number uint64) (eth.BlockInfo, error) {
+	if number != s.block.InfoNum {
+		return nil, errors.New("not found")
+	}
+	return s.block, nil
+}
+
+func (s *stubL2) GetProof(_ context.Context, address common.Address, _ []common.Hash, blockTag string) (*eth.AccountResult, error) {
+	if address != predeploys.L2ToL1MessagePasserAddr || blockTag != s.block.InfoHash.String() {
+		return nil, errors.New("not found")
+	}
+	return s.proof, nil
+}
+
+func (s *stubL2) output() *eth.OutputResponse {
+	return &eth.OutputResponse{
+		Version: eth.OutputVersionV0,
+		OutputRoot: eth.OutputRoot(&eth.OutputV0{
+			StateRoot:                eth.Bytes32(s.block.InfoRoot),
+			MessagePasserStorageRoot: eth.Bytes32(s.proof.StorageHash),
+			BlockHash:                s.block.InfoHash,
+		}),
+		BlockRef: eth.L2BlockRef{Hash: s.block.InfoHash, Number: s.block.InfoNum},
+	}
+}
+
+type stubRollup struct {
+	output *eth.OutputResponse
+}
+
+func (s *stubRollup) SyncStatus(context.Context) (*eth.SyncStatus, error) {
+	panic("unimplemented")
+}
+
+func (s *stubRollup) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if s.output.BlockRef.Number != blockNum {
+		return nil, errors.New("not found")
+	}
+	return s.output, nil
+}
+
+func TestOutputVerifier(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	l2 := newStubL2(t)
+
+	t.Run("NoSources", func(t *testing.T) {
+		v := NewOutputVerifier(logger, nil, nil)
+		require.NoError(t, v.Verify(context.Background(), &eth.OutputResponse{}))
+	})
+
+	t.Run("Computed", func(t *testing.T) {
+		v := NewOutputVerifier(logger, nil, l2)
+		require.NoError(t, v.Verify(context.Background(), l2.output()))
+
+		bad := l2.output()
+		bad.OutputRoot = eth.Bytes32{0x01}
+		require.ErrorIs(t, v.Verify(context.Background(), bad), ErrOutputMismatch)
+
+		unknown := l2.output()
+		unknown.BlockRef.Number = 43
+		err := v.Verify(context.Background(), unknown)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrOutputMismatch)
+	})
+
+	t.Run("NonCanonicalBlock", func(t *testing.T) {
+		v := NewOutputVerifier(logger, nil, l2)
+		// the output of another block at the same height, that the L2 execution client does not consider canonical
+		nonCanonical := l2.output()
+		nonCanonical.BlockRef.Hash = common.Hash{0x01}
+		nonCanonical.OutputRoot = eth.OutputRoot(&eth.OutputV0{
+			StateRoot:                eth.Bytes32(l2.block.InfoRoot),
+			MessagePasserStorageRoot: eth.Bytes32(l2.proof.StorageHash),
+			BlockHash:                nonCanonical.BlockRef.Hash,
+		})
+		err := v.Verify(context.Background(), nonCanonical)
+		require.ErrorIs(t, err, ErrOutputMismatch)
+		require.ErrorContains(t, err, "L2 block 42 is")
+	})
+
+	t.Run("InvalidProof", func(t *testing.T) {
+		l2 := newStubL2(t)
+		l2.proof.StorageHash = common.Hash{0x01}
+		v := NewOutputVerifier(logger, nil, l2)
+		err := v.Verify(context.Background(), l2.output())
+		require.ErrorContains(t, err, "invalid L2ToL1MessagePasser proof")
+	})
+
+	t.Run("RollupNode", func(t *testing.T) {
+		other := &stubRollup{output: l2.output()}
+		v := NewOutputVerifier(logger, other, l2)
+		require.NoError(t, v.Verify(context.Background(), l2.output()))
+
+		other.output = l2.output()
+		other.output.OutputRoot = eth.Bytes32{0x01}
+		require.ErrorIs(t, v.Verify(context.Background(), l2.output()), ErrOutputMismatch)
+
+		other.output = l2.output()
+		other.output.BlockRef.Hash = common.Hash{0x01}
+		require.ErrorIs(t, v.Verify(context.Background(), l2.output()), ErrOutputMismatch)
+	})
+}
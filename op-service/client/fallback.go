@@ -0,0 +1,588 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	fallbackCheckTimeout      = 10 * time.Second
+	fallbackResubscribeDelay  = time.Second
+	fallbackSubscriptionDepth = 16
+)
+
+var ErrNoEndpoints = errors.New("no endpoints available")
+
+// FallbackConfig configures the health checks and endpoint selection of a FallbackClient.
+type FallbackConfig struct {
+	// CheckInterval is the interval between health checks of all endpoints.
+	CheckInterval time.Duration
+	// MaxHeadAge is the maximum age of the latest head of an endpoint for it to be considered healthy.
+	// 0 disables the head freshness check.
+	MaxHeadAge time.Duration
+	// MaxHeadLag is the maximum number of blocks the active endpoint may lag behind
+	// the best healthy endpoint, before traffic is switched over to the best endpoint.
+	MaxHeadLag uint64
+	// ErrThreshold is the number of consecutive request errors after which
+	// the active endpoint is considered unhealthy.
+	ErrThreshold int
+	// Recover switches traffic back to a preferred endpoint once it is healthy again,
+	// and does not lag behind the best endpoint. Otherwise traffic stays on the active
+	// endpoint for as long as it is healthy.
+	Recover bool
+}
+
+// DefaultFallbackConfig returns the default FallbackConfig, tuned to an L1 with 12 second blocks.
+func DefaultFallbackConfig() FallbackConfig {
+	return FallbackConfig{
+		CheckInterval: 12 * time.Second,
+		MaxHeadAge:    time.Minute,
+		MaxHeadLag:    2,
+		ErrThreshold:  5,
+	}
+}
+
+func (cfg *FallbackConfig) Check() error {
+	if cfg.CheckInterval <= 0 {
+		return errors.New("fallback check interval must be positive")
+	}
+	if cfg.ErrThreshold < 1 {
+		return errors.New("fallback error threshold must be at least 1")
+	}
+	return nil
+}
+
+type fallbackDialer func(ctx context.Context, addr string) (RPC, error)
+
+type fallbackEndpoint struct {
+	addr string
+	rpc  RPC // nil if the endpoint couldn't be dialed yet
+
+	healthy  bool
+	head     uint64
+	errCount int
+}
+
+// FallbackClient is an RPC client that sends all traffic to the best of several endpoints,
+// and automatically fails over to another endpoint if the active one becomes unhealthy.
+//
+// Endpoints are health-checked periodically by the freshness of their latest head,
+// and the active endpoint is additionally considered unhealthy after a number of consecutive
+// request errors. The endpoints are given in order of preference: among equally good
+// endpoints, the first one is used.
+//
+// Subscriptions are kept alive across endpoint switches by resubscribing on the new endpoint.
+// newHeads subscriptions never observe a head lower than the last head they observed before a
+// switch, so that head tracking stays monotonic if the new endpoint is slightly behind.
+type FallbackClient struct {
+	lgr  log.Logger
+	cfg  FallbackConfig
+	dial fallbackDialer
+
+	mtx       sync.RWMutex
+	endpoints []*fallbackEndpoint
+	active    int
+	subs      map[*fallbackSubscription]struct{}
+
+	checkReqCh chan struct{}
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+var _ RPC = (*FallbackClient)(nil)
+
+// NewFallbackRPC dials all given addresses and returns a FallbackClient distributing traffic among them.
+// Endpoints that cannot be dialed initially are retried during the health checks,
+// but at least one endpoint has to be available.
+func NewFallbackRPC(ctx context.Context, lgr log.Logger, addrs []string, cfg FallbackConfig, opts ...RPCOption) (*FallbackClient, error) {
+	dial := func(ctx context.Context, addr string) (RPC, error) {
+		return NewRPC(ctx, lgr, addr, opts...)
+	}
+	// during health checks, only try to redial once, to not block the checks of the other endpoints.
+	redialOpts := append(append([]RPCOption{}, opts...), WithDialBackoff(1))
+	redial := func(ctx context.Context, addr string) (RPC, error) {
+		return NewRPC(ctx, lgr, addr, redialOpts...)
+	}
+	return newFallbackClient(ctx, lgr, addrs, cfg, dial, redial)
+}
+
+func newFallbackClient(ctx context.Context, lgr log.Logger, addrs []string, cfg FallbackConfig, dial, redial fallbackDialer) (*FallbackClient, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("empty address list, expected at least one address")
+	}
+	if err := cfg.Check(); err != nil {
+		return nil, fmt.Errorf("invalid fallback config: %w", err)
+	}
+
+	fc := &FallbackClient{
+		lgr:        lgr,
+		cfg:        cfg,
+		dial:       redial,
+		active:     -1,
+		subs:       make(map[*fallbackSubscription]struct{}),
+		checkReqCh: make(chan struct{}, 1),
+	}
+	for _, addr := range addrs {
+		ep := &fallbackEndpoint{addr: addr}
+		if c, err := dial(ctx, addr); err != nil {
+			lgr.Warn("Failed to dial fallback endpoint, will retry later", "addr", addr, "err", err)
+		} else {
+			ep.rpc = c
+			if fc.active < 0 {
+				fc.active = len(fc.endpoints)
+			}
+		}
+		fc.endpoints = append(fc.endpoints, ep)
+	}
+	if fc.active < 0 {
+		return nil, fmt.Errorf("failed to dial any of the %d endpoints: %w", len(addrs), ErrNoEndpoints)
+	}
+
+	// do not rely on the dial context, it may be short-lived.
+	fc.ctx, fc.cancel = context.WithCancel(context.Background())
+	fc.checkEndpoints(ctx)
+
+	fc.wg.Add(1)
+	go fc.loop()
+	return fc, nil
+}
+
+// Close stops the health checks, ends all subscriptions and closes all endpoint clients.
+func (fc *FallbackClient) Close() {
+	fc.cancel()
+	fc.wg.Wait()
+
+	fc.mtx.Lock()
+	subs := fc.subs
+	fc.subs = make(map[*fallbackSubscription]struct{})
+	fc.mtx.Unlock()
+	for sub := range subs {
+		sub.close(ErrSubscriberClosed)
+	}
+
+	for _, ep := range fc.endpoints {
+		if ep.rpc != nil {
+			ep.rpc.Close()
+		}
+	}
+}
+
+// ActiveAddr returns the address of the endpoint that currently receives all traffic.
+func (fc *FallbackClient) ActiveAddr() string {
+	fc.mtx.RLock()
+	defer fc.mtx.RUnlock()
+	return fc.endpoints[fc.active].addr
+}
+
+func (fc *FallbackClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	idx, c := fc.activeRPC()
+	err := c.CallContext(ctx, result, method, args...)
+	fc.recordResult(idx, err)
+	return err
+}
+
+func (fc *FallbackClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	idx, c := fc.activeRPC()
+	err := c.BatchCallContext(ctx, b)
+	fc.recordResult(idx, err)
+	return err
+}
+
+// EthSubscribe creates a new subscription on the active endpoint, that is moved over to
+// the new active endpoint whenever the endpoint is switched.
+func (fc *FallbackClient) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	select {
+	case <-fc.ctx.Done():
+		return nil, ErrSubscriberClosed
+	default:
+	}
+
+	sub := &fallbackSubscription{
+		fc:     fc,
+		target: channel,
+		args:   args,
+		errCh:  make(chan error, 1),
+		quit:   make(chan struct{}),
+	}
+	// Header subscriptions are received on an internal channel first, to keep them monotonic across switches.
+	switch ch := channel.(type) {
+	case chan<- *types.Header:
+		sub.headerCh = ch
+	case chan *types.Header:
+		sub.headerCh = ch
+	}
+	var in chan *types.Header
+	if sub.headerCh != nil {
+		in = make(chan *types.Header, fallbackSubscriptionDepth)
+		sub.target = (chan<- *types.Header)(in)
+	}
+
+	idx, c := fc.activeRPC()
+	if err := sub.subscribe(ctx, idx, c); err != nil {
+		return nil, err
+	}
+	if in != nil {
+		go sub.forwardHeads(in)
+	}
+
+	fc.mtx.Lock()
+	fc.subs[sub] = struct{}{}
+	fc.mtx.Unlock()
+	return sub, nil
+}
+
+func (fc *FallbackClient) activeRPC() (int, RPC) {
+	fc.mtx.RLock()
+	defer fc.mtx.RUnlock()
+	return fc.active, fc.endpoints[fc.active].rpc
+}
+
+// isEndpointErr returns whether err indicates a problem with the endpoint itself,
+// as opposed to an error response to a valid request.
+func isEndpointErr(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+func (fc *FallbackClient) recordResult(idx int, err error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	ep := fc.endpoints[idx]
+	if !isEndpointErr(err) {
+		ep.errCount = 0
+		return
+	}
+	ep.errCount++
+	if ep.errCount == fc.cfg.ErrThreshold && idx == fc.active {
+		fc.lgr.Warn("Active endpoint reached error threshold", "addr", ep.addr, "errors", ep.errCount, "err", err)
+		ep.healthy = false
+		fc.requestCheck()
+	}
+}
+
+func (fc *FallbackClient) recordSubscriptionErr(idx int, err error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	ep := fc.endpoints[idx]
+	fc.lgr.Warn("Subscription failed on endpoint", "addr", ep.addr, "err", err)
+	ep.healthy = false
+	fc.requestCheck()
+}
+
+// requestCheck schedules an immediate health check of all endpoints.
+func (fc *FallbackClient) requestCheck() {
+	select {
+	case fc.checkReqCh <- struct{}{}:
+	default:
+	}
+}
+
+func (fc *FallbackClient) loop() {
+	defer fc.wg.Done()
+	ticker := time.NewTicker(fc.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			fc.checkEndpoints(fc.ctx)
+		case <-fc.checkReqCh:
+			fc.checkEndpoints(fc.ctx)
+		case <-fc.ctx.Done():
+			return
+		}
+	}
+}
+
+type endpointStatus struct {
+	rpc     RPC
+	healthy bool
+	head    uint64
+}
+
+// checkEndpoints checks the health of all endpoints concurrently and then selects the best endpoint.
+func (fc *FallbackClient) checkEndpoints(ctx context.Context) {
+	fc.mtx.RLock()
+	statuses := make([]endpointStatus, len(fc.endpoints))
+	for i, ep := range fc.endpoints {
+		statuses[i].rpc = ep.rpc
+	}
+	fc.mtx.RUnlock()
+
+	var wg sync.WaitGroup
+	for i := range statuses {
+		wg.Add(1)
+		go func(addr string, status *endpointStatus) {
+			defer wg.Done()
+			fc.checkEndpoint(ctx, addr, status)
+		}(fc.endpoints[i].addr, &statuses[i])
+	}
+	wg.Wait()
+
+	fc.mtx.Lock()
+	for i, ep := range fc.endpoints {
+		ep.rpc = statuses[i].rpc
+		ep.healthy = statuses[i].healthy
+		ep.head = statuses[i].head
+		if ep.healthy {
+			ep.errCount = 0
+		}
+	}
+	switched := fc.selectEndpoint()
+	idx, c := fc.active, fc.endpoints[fc.active].rpc
+	subs := make([]*fallbackSubscription, 0, len(fc.subs))
+	for sub := range fc.subs {
+		subs = append(subs, sub)
+	}
+	fc.mtx.Unlock()
+
+	if switched {
+		for _, sub := range subs {
+			if err := sub.subscribe(fc.ctx, idx, c); err != nil {
+				fc.lgr.Error("Failed to resubscribe on new endpoint", "err", err)
+				go sub.retrySubscribe(nil)
+			}
+		}
+	}
+}
+
+func (fc *FallbackClient) checkEndpoint(ctx context.Context, addr string, status *endpointStatus) {
+	ctx, cancel := context.WithTimeout(ctx, fallbackCheckTimeout)
+	defer cancel()
+
+	if status.rpc == nil {
+		c, err := fc.dial(ctx, addr)
+		if err != nil {
+			fc.lgr.Debug("Failed to redial endpoint", "addr", addr, "err", err)
+			return
+		}
+		fc.lgr.Info("Dialed endpoint", "addr", addr)
+		status.rpc = c
+	}
+
+	var head *types.Header
+	if err := status.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false); err != nil {
+		fc.lgr.Warn("Endpoint health check failed", "addr", addr, "err", err)
+		return
+	} else if head == nil {
+		fc.lgr.Warn("Endpoint health check returned no head", "addr", addr)
+		return
+	}
+	status.head = head.Number.Uint64()
+	if age := time.Since(time.Unix(int64(head.Time), 0)); fc.cfg.MaxHeadAge != 0 && age > fc.cfg.MaxHeadAge {
+		fc.lgr.Warn("Endpoint head is stale", "addr", addr, "head", status.head, "age", age)
+		return
+	}
+	status.healthy = true
+}
+
+// selectEndpoint switches to the healthy endpoint with the highest head,
+// if the active endpoint is unhealthy or lags behind too much. If recovery is enabled, it switches back to
+// the first healthy endpoint that does not lag behind too much, if that is preferred over the active endpoint.
+// It returns whether the endpoint was switched. It must be called with the lock held.
+func (fc *FallbackClient) selectEndpoint() bool {
+	best := -1
+	for i, ep := range fc.endpoints {
+		if ep.healthy && (best < 0 || ep.head > fc.endpoints[best].head) {
+			best = i
+		}
+	}
+	if best < 0 {
+		fc.lgr.Error("No healthy endpoint available, keeping active endpoint", "addr", fc.endpoints[fc.active].addr)
+		return false
+	}
+	bestHead := fc.endpoints[best].head
+	next := fc.active
+	if active := fc.endpoints[fc.active]; !active.healthy || active.head+fc.cfg.MaxHeadLag < bestHead {
+		next = best
+	}
+	if fc.cfg.Recover {
+		for i, ep := range fc.endpoints[:next] {
+			if ep.healthy && ep.head+fc.cfg.MaxHeadLag >= bestHead {
+				next = i
+				break
+			}
+		}
+	}
+	if next == fc.active {
+		return false
+	}
+	active := fc.endpoints[fc.active]
+	fc.lgr.Warn("Switching active endpoint",
+		"from", active.addr, "from_healthy", active.healthy, "from_head", active.head,
+		"to", fc.endpoints[next].addr, "to_head", fc.endpoints[next].head)
+	fc.active = next
+	return true
+}
+
+// fallbackSubscription is a subscription of a FallbackClient, that is moved to the
+// active endpoint whenever the FallbackClient switches endpoints.
+type fallbackSubscription struct {
+	fc     *FallbackClient
+	target any // channel the underlying subscriptions deliver to
+	args   []any
+
+	// headerCh is the subscriber's channel of newHeads subscriptions, nil for other subscriptions.
+	headerCh chan<- *types.Header
+
+	mtx      sync.Mutex
+	sub      ethereum.Subscription // underlying subscription, nil if not subscribed
+	endpoint int                   // endpoint index of the underlying subscription
+	lastHead uint64                // number of the last forwarded head
+	minHead  uint64                // heads below this number are dropped, to stay monotonic after a switch
+	closed   bool
+
+	errCh    chan error
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+var _ ethereum.Subscription = (*fallbackSubscription)(nil)
+
+// subscribe replaces the underlying subscription with a new one on the given endpoint.
+func (fs *fallbackSubscription) subscribe(ctx context.Context, idx int, c RPC) error {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+	return fs.subscribeLocked(ctx, idx, c)
+}
+
+// resubscribe replaces the underlying subscription with a new one on the given endpoint,
+// unless the underlying subscription has been replaced since it was prev.
+func (fs *fallbackSubscription) resubscribe(ctx context.Context, prev ethereum.Subscription, idx int, c RPC) error {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+	if fs.sub != prev {
+		return nil
+	}
+	return fs.subscribeLocked(ctx, idx, c)
+}
+
+func (fs *fallbackSubscription) subscribeLocked(ctx context.Context, idx int, c RPC) error {
+	if fs.closed {
+		return nil
+	}
+	if fs.sub != nil {
+		fs.sub.Unsubscribe()
+		fs.sub = nil
+		if fs.endpoint != idx {
+			fs.minHead = fs.lastHead
+		}
+	}
+	sub, err := c.EthSubscribe(ctx, fs.target, fs.args...)
+	if err != nil {
+		return err
+	}
+	fs.sub, fs.endpoint = sub, idx
+	go fs.watch(sub, idx)
+	return nil
+}
+
+// watch reports a failure of the underlying subscription to the FallbackClient,
+// and resubscribes if the FallbackClient does not switch endpoints.
+func (fs *fallbackSubscription) watch(sub ethereum.Subscription, idx int) {
+	select {
+	case err, ok := <-sub.Err():
+		if !ok || err == nil {
+			return
+		}
+		fs.fc.recordSubscriptionErr(idx, err)
+		fs.retrySubscribe(sub)
+	case <-fs.quit:
+	}
+}
+
+// retrySubscribe resubscribes on the active endpoint until it succeeds, unless the
+// failed underlying subscription has been replaced in the meantime. failed is nil if resubscribing
+// failed before.
+func (fs *fallbackSubscription) retrySubscribe(failed ethereum.Subscription) {
+	for {
+		select {
+		case <-time.After(fallbackResubscribeDelay):
+		case <-fs.quit:
+			return
+		case <-fs.fc.ctx.Done():
+			return
+		}
+		idx, c := fs.fc.activeRPC()
+		err := fs.resubscribe(fs.fc.ctx, failed, idx, c)
+		if err == nil {
+			return
+		}
+		fs.fc.lgr.Warn("Failed to resubscribe", "err", err)
+	}
+}
+
+// forwardHeads forwards the heads of the underlying subscriptions to the subscriber,
+// dropping heads that would make the subscription go backwards after an endpoint switch.
+func (fs *fallbackSubscription) forwardHeads(in <-chan *types.Header) {
+	for {
+		select {
+		case head := <-in:
+			if !fs.acceptHead(head) {
+				continue
+			}
+			select {
+			case fs.headerCh <- head:
+			case <-fs.quit:
+				return
+			}
+		case <-fs.quit:
+			return
+		}
+	}
+}
+
+func (fs *fallbackSubscription) acceptHead(head *types.Header) bool {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+	num := head.Number.Uint64()
+	if num < fs.minHead {
+		return false
+	}
+	// the new endpoint caught up, regular reorgs are allowed again
+	fs.minHead = 0
+	fs.lastHead = num
+	return true
+}
+
+func (fs *fallbackSubscription) Unsubscribe() {
+	fs.close(nil)
+}
+
+func (fs *fallbackSubscription) Err() <-chan error {
+	return fs.errCh
+}
+
+func (fs *fallbackSubscription) close(err error) {
+	fs.quitOnce.Do(func() {
+		close(fs.quit)
+
+		fs.mtx.Lock()
+		fs.closed = true
+		if fs.sub != nil {
+			fs.sub.Unsubscribe()
+			fs.sub = nil
+		}
+		fs.mtx.Unlock()
+
+		fs.fc.mtx.Lock()
+		delete(fs.fc.subs, fs)
+		fs.fc.mtx.Unlock()
+
+		if err != nil {
+			fs.errCh <- err
+		}
+		close(fs.errCh)
+	})
+}
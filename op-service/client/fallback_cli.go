@@ -0,0 +1,64 @@
+package client
+
+import (
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+)
+
+const (
+	FallbackCheckIntervalFlagName  = "l1.fallback.check-interval"
+	FallbackMaxHeadAgeFlagName     = "l1.fallback.max-head-age"
+	FallbackMaxHeadLagFlagName     = "l1.fallback.max-head-lag"
+	FallbackErrThresholdFlagName   = "l1.fallback.error-threshold"
+	FallbackRecoverFlagName        = "l1.fallback.recover"
+	fallbackMultipleEndpointsUsage = " Only used if multiple L1 RPC URLs are given."
+)
+
+// FallbackCLIFlags returns the flags of the FallbackConfig of the L1 RPC client.
+func FallbackCLIFlags(envPrefix string) []cli.Flag {
+	defaults := DefaultFallbackConfig()
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:    FallbackCheckIntervalFlagName,
+			Usage:   "Interval between health checks of the L1 RPC endpoints." + fallbackMultipleEndpointsUsage,
+			Value:   defaults.CheckInterval,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FALLBACK_CHECK_INTERVAL"),
+		},
+		&cli.DurationFlag{
+			Name:    FallbackMaxHeadAgeFlagName,
+			Usage:   "Maximum age of the latest head of an L1 RPC endpoint for it to be healthy. 0 disables the check." + fallbackMultipleEndpointsUsage,
+			Value:   defaults.MaxHeadAge,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FALLBACK_MAX_HEAD_AGE"),
+		},
+		&cli.Uint64Flag{
+			Name:    FallbackMaxHeadLagFlagName,
+			Usage:   "Number of blocks the active L1 RPC endpoint may lag behind the best endpoint, before switching to the best endpoint." + fallbackMultipleEndpointsUsage,
+			Value:   defaults.MaxHeadLag,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FALLBACK_MAX_HEAD_LAG"),
+		},
+		&cli.IntFlag{
+			Name:    FallbackErrThresholdFlagName,
+			Usage:   "Number of consecutive request errors after which the active L1 RPC endpoint is unhealthy." + fallbackMultipleEndpointsUsage,
+			Value:   defaults.ErrThreshold,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FALLBACK_ERROR_THRESHOLD"),
+		},
+		&cli.BoolFlag{
+			Name:    FallbackRecoverFlagName,
+			Usage:   "Switch back to a preferred L1 RPC endpoint, earlier in the list, once it is healthy again." + fallbackMultipleEndpointsUsage,
+			Value:   defaults.Recover,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FALLBACK_RECOVER"),
+		},
+	}
+}
+
+// ReadFallbackCLIConfig reads the FallbackConfig from the flags of FallbackCLIFlags.
+func ReadFallbackCLIConfig(ctx *cli.Context) FallbackConfig {
+	return FallbackConfig{
+		CheckInterval: ctx.Duration(FallbackCheckIntervalFlagName),
+		MaxHeadAge:    ctx.Duration(FallbackMaxHeadAgeFlagName),
+		MaxHeadLag:    ctx.Uint64(FallbackMaxHeadLagFlagName),
+		ErrThreshold:  ctx.Int(FallbackErrThresholdFlagName),
+		Recover:       ctx.Bool(FallbackRecoverFlagName),
+	}
+}
@@ -0,0 +1,304 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var errEndpointDown = errors.New("endpoint down")
+
+type fallbackMockRPC struct {
+	mtx      sync.Mutex
+	head     uint64
+	headTime uint64
+	err      error
+	calls    int
+	closed   bool
+
+	heads event.Feed
+}
+
+func newFallbackMockRPC(head uint64) *fallbackMockRPC {
+	return &fallbackMockRPC{head: head, headTime: uint64(time.Now().Unix())}
+}
+
+func (m *fallbackMockRPC) setHead(head uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.head = head
+	m.headTime = uint64(time.Now().Unix())
+}
+
+func (m *fallbackMockRPC) setErr(err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.err = err
+}
+
+func (m *fallbackMockRPC) callCount() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.calls
+}
+
+func (m *fallbackMockRPC) Close() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.closed = true
+}
+
+func (m *fallbackMockRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.calls++
+	if m.err != nil {
+		return m.err
+	}
+	if method == "eth_getBlockByNumber" {
+		*result.(**types.Header) = &types.Header{Number: new(big.Int).SetUint64(m.head), Time: m.headTime}
+	}
+	return nil
+}
+
+func (m *fallbackMockRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.calls++
+	return m.err
+}
+
+func (m *fallbackMockRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return m.heads.Subscribe(channel), nil
+}
+
+func (m *fallbackMockRPC) sendHead(num uint64) {
+	m.heads.Send(&types.Header{Number: new(big.Int).SetUint64(num)})
+}
+
+func setupFallbackClient(t *testing.T, cfg FallbackConfig, rpcs ...*fallbackMockRPC) *FallbackClient {
+	addrs := make([]string, len(rpcs))
+	byAddr := make(map[string]*fallbackMockRPC)
+	for i, r := range rpcs {
+		addrs[i] = string(rune('a' + i))
+		byAddr[addrs[i]] = r
+	}
+	dial := func(_ context.Context, addr string) (RPC, error) {
+		return byAddr[addr], nil
+	}
+	fc, err := newFallbackClient(context.Background(), testlog.Logger(t, log.LvlDebug), addrs, cfg, dial, dial)
+	require.NoError(t, err)
+	t.Cleanup(fc.Close)
+	return fc
+}
+
+func testFallbackConfig() FallbackConfig {
+	cfg := DefaultFallbackConfig()
+	// only check on demand
+	cfg.CheckInterval = time.Hour
+	return cfg
+}
+
+func TestFallbackClient_PrefersFirstHealthyEndpoint(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(100)
+	fc := setupFallbackClient(t, testFallbackConfig(), a, b)
+	require.Equal(t, "a", fc.ActiveAddr())
+
+	require.NoError(t, fc.CallContext(context.Background(), nil, "eth_chainId"))
+	require.Equal(t, 2, a.callCount()) // health check + call
+	require.Equal(t, 1, b.callCount()) // health check
+}
+
+func TestFallbackClient_SwitchesOnHeadLag(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(100)
+	fc := setupFallbackClient(t, testFallbackConfig(), a, b)
+
+	// within the allowed lag
+	b.setHead(102)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "a", fc.ActiveAddr())
+
+	b.setHead(103)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+}
+
+func TestFallbackClient_SwitchesOnStaleHead(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(100)
+	fc := setupFallbackClient(t, testFallbackConfig(), a, b)
+
+	a.mtx.Lock()
+	a.headTime = uint64(time.Now().Add(-2 * time.Minute).Unix())
+	a.mtx.Unlock()
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+
+	// switching back only happens once the active endpoint becomes unhealthy or lags behind
+	a.setHead(100)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+}
+
+func TestFallbackClient_RecoversPreferredEndpoint(t *testing.T) {
+	a, b, c := newFallbackMockRPC(100), newFallbackMockRPC(100), newFallbackMockRPC(100)
+	cfg := testFallbackConfig()
+	cfg.Recover = true
+	fc := setupFallbackClient(t, cfg, a, b, c)
+
+	a.setErr(errEndpointDown)
+	b.setErr(errEndpointDown)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "c", fc.ActiveAddr())
+
+	// b is preferred over c once it is healthy again
+	b.setErr(nil)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+
+	// but not while it lags behind
+	a.setErr(nil)
+	a.setHead(90)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+
+	a.setHead(100)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "a", fc.ActiveAddr())
+}
+
+func TestFallbackClient_SwitchesOnErrors(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(100)
+	cfg := testFallbackConfig()
+	fc := setupFallbackClient(t, cfg, a, b)
+
+	// errors returned by a healthy endpoint don't count
+	a.setErr(&rpcError{})
+	for i := 0; i < cfg.ErrThreshold; i++ {
+		require.Error(t, fc.CallContext(context.Background(), nil, "eth_call"))
+	}
+	require.Equal(t, "a", fc.ActiveAddr())
+
+	a.setErr(errEndpointDown)
+	for i := 0; i < cfg.ErrThreshold; i++ {
+		require.ErrorIs(t, fc.CallContext(context.Background(), nil, "eth_chainId"), errEndpointDown)
+	}
+	require.Eventually(t, func() bool { return fc.ActiveAddr() == "b" }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, fc.CallContext(context.Background(), nil, "eth_chainId"))
+}
+
+func TestFallbackClient_KeepsActiveIfNoneHealthy(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(100)
+	fc := setupFallbackClient(t, testFallbackConfig(), a, b)
+
+	a.setErr(errEndpointDown)
+	b.setErr(errEndpointDown)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "a", fc.ActiveAddr())
+}
+
+func TestFallbackClient_RedialsEndpoints(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(200)
+	bUp := false
+	dial := func(_ context.Context, addr string) (RPC, error) {
+		if addr == "a" {
+			return a, nil
+		}
+		if !bUp {
+			return nil, errEndpointDown
+		}
+		return b, nil
+	}
+	fc, err := newFallbackClient(context.Background(), testlog.Logger(t, log.LvlDebug), []string{"a", "b"}, testFallbackConfig(), dial, dial)
+	require.NoError(t, err)
+	t.Cleanup(fc.Close)
+	require.Equal(t, "a", fc.ActiveAddr())
+
+	bUp = true
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+
+	_, err = newFallbackClient(context.Background(), testlog.Logger(t, log.LvlDebug), []string{"c"}, testFallbackConfig(),
+		func(context.Context, string) (RPC, error) { return nil, errEndpointDown }, nil)
+	require.ErrorIs(t, err, ErrNoEndpoints)
+}
+
+func TestFallbackClient_SubscriptionSurvivesSwitch(t *testing.T) {
+	a, b := newFallbackMockRPC(100), newFallbackMockRPC(98)
+	fc := setupFallbackClient(t, testFallbackConfig(), a, b)
+
+	heads := make(chan *types.Header, 10)
+	sub, err := fc.EthSubscribe(context.Background(), (chan<- *types.Header)(heads), "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	a.sendHead(100)
+	requireHead(t, heads, 100)
+
+	// switch to b, which is behind at first
+	a.setErr(errEndpointDown)
+	fc.checkEndpoints(context.Background())
+	require.Equal(t, "b", fc.ActiveAddr())
+
+	// the old endpoint is not followed anymore
+	require.Zero(t, a.heads.Send(&types.Header{Number: big.NewInt(101)}))
+
+	// heads behind the last observed head are dropped
+	b.sendHead(99)
+	b.sendHead(100)
+	requireHead(t, heads, 100)
+
+	// and reorgs are allowed again once the new endpoint caught up
+	b.sendHead(101)
+	b.sendHead(100)
+	requireHead(t, heads, 101)
+	requireHead(t, heads, 100)
+
+	sub.Unsubscribe()
+	_, ok := <-sub.Err()
+	require.False(t, ok)
+}
+
+func TestFallbackClient_CloseEndsSubscriptions(t *testing.T) {
+	a := newFallbackMockRPC(100)
+	addrs := []string{"a"}
+	dial := func(context.Context, string) (RPC, error) { return a, nil }
+	fc, err := newFallbackClient(context.Background(), testlog.Logger(t, log.LvlDebug), addrs, testFallbackConfig(), dial, dial)
+	require.NoError(t, err)
+
+	heads := make(chan *types.Header, 10)
+	sub, err := fc.EthSubscribe(context.Background(), heads, "newHeads")
+	require.NoError(t, err)
+
+	fc.Close()
+	require.ErrorIs(t, <-sub.Err(), ErrSubscriberClosed)
+	require.True(t, a.closed)
+
+	_, err = fc.EthSubscribe(context.Background(), heads, "newHeads")
+	require.ErrorIs(t, err, ErrSubscriberClosed)
+}
+
+func requireHead(t *testing.T, heads <-chan *types.Header, num uint64) {
+	select {
+	case head := <-heads:
+		require.Equal(t, num, head.Number.Uint64())
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for head %d", num)
+	}
+}
+
+type rpcError struct{}
+
+func (*rpcError) Error() string  { return "execution reverted" }
+func (*rpcError) ErrorCode() int { return 3 }
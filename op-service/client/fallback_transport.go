@@ -0,0 +1,153 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fallbackRPCURL is the URL of the rpc.Client of a FallbackClient. It is never dialed.
+const fallbackRPCURL = "http://fallback"
+
+// RPCClient returns a geth rpc.Client that sends all requests through the FallbackClient,
+// e.g. to use the FallbackClient with an ethclient.Client or contract bindings.
+// Subscriptions are not supported, like with any HTTP rpc.Client.
+// Closing the returned client does not close the FallbackClient.
+func (fc *FallbackClient) RPCClient(ctx context.Context) (*rpc.Client, error) {
+	httpClient := &http.Client{Transport: &fallbackTransport{fc: fc}}
+	return rpc.DialOptions(ctx, fallbackRPCURL, rpc.WithHTTPClient(httpClient))
+}
+
+type jsonrpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+// fallbackTransport serves the JSON-RPC over HTTP requests of a geth rpc.Client with a FallbackClient.
+// Errors of the endpoint are returned as transport errors, and error responses of the endpoint as
+// error responses, so that the rpc.Client returns the same errors as if it were connected to the endpoint.
+type fallbackTransport struct {
+	fc *FallbackClient
+}
+
+func (t *fallbackTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	var out any
+	if body = bytes.TrimSpace(body); len(body) > 0 && body[0] == '[' {
+		var msgs []jsonrpcRequest
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return nil, fmt.Errorf("invalid batch request: %w", err)
+		}
+		if out, err = t.batchCall(req.Context(), msgs); err != nil {
+			return nil, err
+		}
+	} else {
+		var msg jsonrpcRequest
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("invalid request: %w", err)
+		}
+		if out, err = t.call(req.Context(), msg); err != nil {
+			return nil, err
+		}
+	}
+
+	respBody, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+func (t *fallbackTransport) call(ctx context.Context, msg jsonrpcRequest) (*jsonrpcResponse, error) {
+	var result json.RawMessage
+	err := t.fc.CallContext(ctx, &result, msg.Method, rawParams(msg.Params)...)
+	return newJsonrpcResponse(msg.ID, result, err)
+}
+
+func (t *fallbackTransport) batchCall(ctx context.Context, msgs []jsonrpcRequest) ([]*jsonrpcResponse, error) {
+	results := make([]json.RawMessage, len(msgs))
+	batch := make([]rpc.BatchElem, len(msgs))
+	for i, msg := range msgs {
+		batch[i] = rpc.BatchElem{Method: msg.Method, Args: rawParams(msg.Params), Result: &results[i]}
+	}
+	if err := t.fc.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+	out := make([]*jsonrpcResponse, len(msgs))
+	for i, msg := range msgs {
+		resp, err := newJsonrpcResponse(msg.ID, results[i], batch[i].Error)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = resp
+	}
+	return out, nil
+}
+
+func rawParams(params []json.RawMessage) []any {
+	args := make([]any, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	return args
+}
+
+// newJsonrpcResponse returns the response to a call with the given result and error.
+// An error that is not an error response of the endpoint is returned as is.
+func newJsonrpcResponse(id json.RawMessage, result json.RawMessage, err error) (*jsonrpcResponse, error) {
+	resp := &jsonrpcResponse{Version: "2.0", ID: id}
+	var rpcErr rpc.Error
+	switch {
+	case err == nil:
+		resp.Result = result
+		if len(resp.Result) == 0 {
+			resp.Result = json.RawMessage("null")
+		}
+	case errors.As(err, &rpcErr):
+		resp.Error = &jsonrpcError{Code: rpcErr.ErrorCode(), Message: rpcErr.Error()}
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			resp.Error.Data = dataErr.ErrorData()
+		}
+	default:
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type revertError struct{}
+
+func (revertError) Error() string          { return "execution reverted" }
+func (revertError) ErrorCode() int         { return 3 }
+func (revertError) ErrorData() interface{} { return "0x1234" }
+
+// testEthAPI serves the eth namespace of an endpoint
+type testEthAPI struct {
+	chainID uint64
+}
+
+func (api *testEthAPI) ChainId() hexutil.Uint64 {
+	return hexutil.Uint64(api.chainID)
+}
+
+func (api *testEthAPI) GetBlockByNumber(number string, full bool) *types.Header {
+	return &types.Header{Number: big.NewInt(100), Difficulty: new(big.Int), Time: uint64(time.Now().Unix())}
+}
+
+func (api *testEthAPI) Call(args map[string]any, block string) (hexutil.Bytes, error) {
+	return nil, revertError{}
+}
+
+func newTestEndpoint(t *testing.T, chainID uint64) RPC {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &testEthAPI{chainID: chainID}))
+	t.Cleanup(server.Stop)
+	return NewBaseRPCClient(rpc.DialInProc(server))
+}
+
+func TestFallbackClient_RPCClient(t *testing.T) {
+	a, b := newTestEndpoint(t, 1), newTestEndpoint(t, 2)
+	endpoints := map[string]RPC{"a": a, "b": b}
+	dial := func(_ context.Context, addr string) (RPC, error) {
+		return endpoints[addr], nil
+	}
+	fc, err := newFallbackClient(context.Background(), testlog.Logger(t, log.LvlDebug), []string{"a", "b"}, testFallbackConfig(), dial, dial)
+	require.NoError(t, err)
+	t.Cleanup(fc.Close)
+
+	rpcClient, err := fc.RPCClient(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	ethClient := ethclient.NewClient(rpcClient)
+
+	chainID, err := ethClient.ChainID(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), chainID.Uint64())
+
+	header, err := ethClient.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), header.Number.Uint64())
+
+	// error responses keep their code and data
+	_, err = ethClient.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+	require.ErrorContains(t, err, "execution reverted")
+	var dataErr rpc.DataError
+	require.ErrorAs(t, err, &dataErr)
+	require.Equal(t, "0x1234", dataErr.ErrorData())
+
+	batch := []rpc.BatchElem{
+		{Method: "eth_chainId", Result: new(hexutil.Uint64)},
+		{Method: "eth_call", Args: []any{map[string]any{}, "latest"}, Result: new(hexutil.Bytes)},
+		{Method: "eth_unknown", Result: new(hexutil.Bytes)},
+	}
+	require.NoError(t, rpcClient.BatchCallContext(context.Background(), batch))
+	require.NoError(t, batch[0].Error)
+	require.Equal(t, hexutil.Uint64(1), *batch[0].Result.(*hexutil.Uint64))
+	require.ErrorContains(t, batch[1].Error, "execution reverted")
+	require.ErrorContains(t, batch[2].Error, "does not exist")
+
+	// requests follow the active endpoint
+	fc.mtx.Lock()
+	fc.active = 1
+	fc.mtx.Unlock()
+	chainID, err = ethClient.ChainID(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), chainID.Uint64())
+
+	// endpoint errors are returned as transport errors
+	b.Close()
+	_, err = ethClient.ChainID(context.Background())
+	require.ErrorIs(t, err, rpc.ErrClientQuit)
+}
@@ -9,9 +9,13 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/time/rate"
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
@@ -20,6 +24,8 @@ import (
 
 var httpRegex = regexp.MustCompile("^http(s)?://")
 
+var tracer = tracing.Tracer("op-service/client")
+
 type RPC interface {
 	Close()
 	CallContext(ctx context.Context, result any, method string, args ...any) error
@@ -163,14 +169,26 @@ func (b *BaseRPCClient) Close() {
 	b.c.Close()
 }
 
-func (b *BaseRPCClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
-	cCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+func (b *BaseRPCClient) CallContext(ctx context.Context, result any, method string, args ...any) (err error) {
+	ctx, span := tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.RPCSystemKey.String("jsonrpc"), semconv.RPCMethod(method)))
+	defer func() { tracing.EndSpan(span, err) }()
+	cCtx, cancel := context.WithTimeout(tracing.ContextWithRPCHeaders(ctx), 10*time.Second)
 	defer cancel()
 	return b.c.CallContext(cCtx, result, method, args...)
 }
 
-func (b *BaseRPCClient) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) error {
-	cCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+func (b *BaseRPCClient) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) (err error) {
+	methods := make([]string, len(batch))
+	for i, elem := range batch {
+		methods[i] = elem.Method
+	}
+	ctx, span := tracer.Start(ctx, "batch", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.RPCSystemKey.String("jsonrpc"),
+			attribute.Int("rpc.jsonrpc.batch_size", len(batch)),
+			attribute.StringSlice("rpc.jsonrpc.methods", methods)))
+	defer func() { tracing.EndSpan(span, err) }()
+	cCtx, cancel := context.WithTimeout(tracing.ContextWithRPCHeaders(ctx), 20*time.Second)
 	defer cancel()
 	return b.c.BatchCallContext(cCtx, batch)
 }
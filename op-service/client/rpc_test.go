@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+	tracingTest "github.com/ethereum-optimism/optimism/op-service/tracing/test"
+)
+
+type echoAPI struct{}
+
+func (echoAPI) Echo(v string) string {
+	return v
+}
+
+func newTracedClient(t *testing.T) *BaseRPCClient {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", echoAPI{}))
+	httpSrv := httptest.NewServer(tracing.NewRPCServerMiddleware(srv))
+	t.Cleanup(httpSrv.Close)
+	cl, err := rpc.Dial(httpSrv.URL)
+	require.NoError(t, err)
+	client := NewBaseRPCClient(cl)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func spanOfKind(t *testing.T, spans []*tracepb.Span, kind tracepb.Span_SpanKind) *tracepb.Span {
+	for _, span := range spans {
+		if span.Kind == kind {
+			return span
+		}
+	}
+	t.Fatalf("no span of kind %v", kind)
+	return nil
+}
+
+func TestBaseRPCClientSpans(t *testing.T) {
+	c := tracingTest.NewCollector(t)
+	svc := c.StartService(t)
+	client := newTracedClient(t)
+
+	ctx, root := tracing.Tracer("op-service/client").Start(context.Background(), "root")
+	var out string
+	require.NoError(t, client.CallContext(ctx, &out, "test_echo", "hello"))
+	require.Equal(t, "hello", out)
+	require.Error(t, client.CallContext(ctx, &out, "test_unknown"))
+	root.End()
+
+	var a, b string
+	batch := []rpc.BatchElem{
+		{Method: "test_echo", Args: []any{"a"}, Result: &a},
+		{Method: "test_echo", Args: []any{"b"}, Result: &b},
+	}
+	require.NoError(t, client.BatchCallContext(context.Background(), batch))
+	require.Equal(t, "a", a)
+	require.Equal(t, "b", b)
+	require.NoError(t, svc.Stop(context.Background()))
+
+	t.Run("Call", func(t *testing.T) {
+		rootSpan, _ := c.Span("root")
+		require.NotNil(t, rootSpan)
+		spans := c.Spans("test_echo")
+		clientSpan := spanOfKind(t, spans, tracepb.Span_SPAN_KIND_CLIENT)
+		serverSpan := spanOfKind(t, spans, tracepb.Span_SPAN_KIND_SERVER)
+		require.Equal(t, rootSpan.SpanId, clientSpan.ParentSpanId, "client span is a child of the span of the caller")
+		require.Equal(t, "test_echo", tracingTest.Attribute(clientSpan, "rpc.method").GetStringValue())
+		require.Equal(t, tracepb.Status_STATUS_CODE_UNSET, clientSpan.Status.GetCode())
+		require.Equal(t, clientSpan.TraceId, serverSpan.TraceId, "trace is propagated to the server")
+		require.Equal(t, clientSpan.SpanId, serverSpan.ParentSpanId)
+
+		failed := spanOfKind(t, c.Spans("test_unknown"), tracepb.Span_SPAN_KIND_CLIENT)
+		require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, failed.Status.GetCode(), "failed calls set the error status")
+	})
+
+	t.Run("BatchCall", func(t *testing.T) {
+		spans := c.Spans("batch")
+		clientSpan := spanOfKind(t, spans, tracepb.Span_SPAN_KIND_CLIENT)
+		serverSpan := spanOfKind(t, spans, tracepb.Span_SPAN_KIND_SERVER)
+		require.Equal(t, int64(2), tracingTest.Attribute(clientSpan, "rpc.jsonrpc.batch_size").GetIntValue())
+		methods := tracingTest.Attribute(clientSpan, "rpc.jsonrpc.methods").GetArrayValue().GetValues()
+		require.Len(t, methods, 2)
+		require.Equal(t, "test_echo", methods[0].GetStringValue())
+		require.Equal(t, clientSpan.TraceId, serverSpan.TraceId, "trace is propagated to the server")
+		require.Equal(t, clientSpan.SpanId, serverSpan.ParentSpanId)
+	})
+}
@@ -3,6 +3,7 @@ package dial
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/client"
@@ -33,6 +34,35 @@ func DialEthClientWithTimeout(ctx context.Context, timeout time.Duration, log lo
 	return ethclient.NewClient(c), nil
 }
 
+// DialEthClientWithFallback attempts to dial the L1 provider like DialEthClientWithTimeout,
+// but also accepts a comma-separated list of URLs. With multiple URLs, requests are sent to the
+// active endpoint of a client.FallbackClient, which switches endpoints as configured by fallbackCfg.
+// The returned client.RPC has to be closed instead of the ethclient.Client, to also close the endpoints.
+func DialEthClientWithFallback(ctx context.Context, timeout time.Duration, log log.Logger, urls string, fallbackCfg client.FallbackConfig) (*ethclient.Client, client.RPC, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	addrs := strings.Split(urls, ",")
+	if len(addrs) == 1 {
+		c, err := dialRPCClientWithBackoff(ctx, log, urls)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ethclient.NewClient(c), client.NewBaseRPCClient(c), nil
+	}
+
+	fallback, err := client.NewFallbackRPC(ctx, log, addrs, fallbackCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := fallback.RPCClient(ctx)
+	if err != nil {
+		fallback.Close()
+		return nil, nil, err
+	}
+	return ethclient.NewClient(c), fallback, nil
+}
+
 // DialRollupClientWithTimeout attempts to dial the RPC provider using the provided URL.
 // If the dial doesn't complete within timeout seconds, this method will return an error.
 func DialRollupClientWithTimeout(ctx context.Context, timeout time.Duration, log log.Logger, url string) (*sources.RollupClient, error) {
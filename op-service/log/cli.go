@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	"golang.org/x/term"
@@ -16,9 +18,12 @@ import (
 )
 
 const (
-	LevelFlagName  = "log.level"
-	FormatFlagName = "log.format"
-	ColorFlagName  = "log.color"
+	LevelFlagName          = "log.level"
+	ModuleLevelsFlagName   = "log.module-levels"
+	FormatFlagName         = "log.format"
+	ColorFlagName          = "log.color"
+	SampleIntervalFlagName = "log.sample.interval"
+	SampleLimitFlagName    = "log.sample.limit"
 )
 
 // CLIFlags creates flag definitions for the logging utils.
@@ -32,6 +37,12 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Value:   NewLvlFlagValue(log.LvlInfo),
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_LEVEL"),
 		},
+		&cli.GenericFlag{
+			Name:    ModuleLevelsFlagName,
+			Usage:   "Log levels per module, overriding the log level for the tagged loggers, e.g. 'p2p=debug,derive=warn'",
+			Value:   NewModuleLvlsFlagValue(nil),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_MODULE_LEVELS"),
+		},
 		&cli.GenericFlag{
 			Name:    FormatFlagName,
 			Usage:   "Format the log output. Supported formats: 'text', 'terminal', 'logfmt', 'json', 'json-pretty',",
@@ -43,6 +54,17 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Usage:   "Color the log output if in terminal mode",
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_COLOR"),
 		},
+		&cli.DurationFlag{
+			Name:    SampleIntervalFlagName,
+			Usage:   "Interval to limit repeated identical log messages in, see " + SampleLimitFlagName,
+			Value:   time.Minute,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_SAMPLE_INTERVAL"),
+		},
+		&cli.IntFlag{
+			Name:    SampleLimitFlagName,
+			Usage:   "Maximum number of identical log messages to output per sample interval, the rest is summarized. Disabled if 0",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_SAMPLE_LIMIT"),
+		},
 	}
 }
 
@@ -79,6 +101,58 @@ func (fv *LvlFlagValue) Clone() any {
 
 var _ cliapp.CloneableGeneric = (*LvlFlagValue)(nil)
 
+// ModuleLvlsFlagValue is a value type for cli.GenericFlag to parse and validate per-module log-level values.
+// The format is a comma-separated list of module=level pairs.
+type ModuleLvlsFlagValue map[string]log.Lvl
+
+func NewModuleLvlsFlagValue(lvls map[string]log.Lvl) *ModuleLvlsFlagValue {
+	v := make(ModuleLvlsFlagValue, len(lvls))
+	for k, lvl := range lvls {
+		v[k] = lvl
+	}
+	return &v
+}
+
+func (fv *ModuleLvlsFlagValue) Set(value string) error {
+	lvls := make(ModuleLvlsFlagValue)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		module, lvlStr, ok := strings.Cut(pair, "=")
+		if !ok || module == "" {
+			return fmt.Errorf("invalid module log level %q, expected module=level", pair)
+		}
+		lvl, err := log.LvlFromString(strings.ToLower(lvlStr))
+		if err != nil {
+			return fmt.Errorf("invalid log level of module %q: %w", module, err)
+		}
+		lvls[module] = lvl
+	}
+	*fv = lvls
+	return nil
+}
+
+func (fv ModuleLvlsFlagValue) String() string {
+	pairs := make([]string, 0, len(fv))
+	for module, lvl := range fv {
+		pairs = append(pairs, module+"="+lvl.String())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (fv ModuleLvlsFlagValue) ModuleLvls() map[string]log.Lvl {
+	return *NewModuleLvlsFlagValue(fv)
+}
+
+func (fv *ModuleLvlsFlagValue) Clone() any {
+	return NewModuleLvlsFlagValue(*fv)
+}
+
+var _ cliapp.CloneableGeneric = (*ModuleLvlsFlagValue)(nil)
+
 // FormatType defines a type of log format.
 // Supported formats: 'text', 'terminal', 'logfmt', 'json', 'json-pretty'
 type FormatType string
@@ -153,6 +227,14 @@ type CLIConfig struct {
 	Level  log.Lvl
 	Color  bool
 	Format FormatType
+
+	// ModuleLevels overrides Level for the loggers tagged with the module, see ForModule.
+	ModuleLevels map[string]log.Lvl
+
+	// Identical log messages beyond SampleLimit per SampleInterval are suppressed.
+	// Sampling is disabled if the limit is 0.
+	SampleInterval time.Duration
+	SampleLimit    int
 }
 
 // AppOut returns an io.Writer to write app output to, like logs.
@@ -164,12 +246,19 @@ func AppOut(ctx *cli.Context) io.Writer {
 	return ctx.App.Writer
 }
 
-// NewLogHandler creates a new configured handler, compatible as LvlSetter for log-level changes during runtime.
+// NewLogHandler creates a new configured handler, compatible as LvlSetter and ModuleLvlSetter
+// for log-level changes during runtime.
 func NewLogHandler(wr io.Writer, cfg CLIConfig) log.Handler {
 	handler := log.StreamHandler(wr, cfg.Format.Formatter(cfg.Color))
 	handler = log.SyncHandler(handler)
-	handler = NewDynamicLogHandler(cfg.Level, handler)
-	return handler
+	if cfg.SampleLimit > 0 && cfg.SampleInterval > 0 {
+		handler = NewSamplingHandler(handler, cfg.SampleInterval, cfg.SampleLimit)
+	}
+	dynamic := NewDynamicLogHandler(cfg.Level, handler)
+	for module, lvl := range cfg.ModuleLevels {
+		dynamic.SetModuleLogLevel(module, lvl)
+	}
+	return dynamic
 }
 
 // NewLogger creates a new configured logger.
@@ -207,5 +296,10 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	if ctx.IsSet(ColorFlagName) {
 		cfg.Color = ctx.Bool(ColorFlagName)
 	}
+	if lvls, ok := ctx.Generic(ModuleLevelsFlagName).(*ModuleLvlsFlagValue); ok {
+		cfg.ModuleLevels = lvls.ModuleLvls()
+	}
+	cfg.SampleLimit = ctx.Int(SampleLimitFlagName)
+	cfg.SampleInterval = ctx.Duration(SampleIntervalFlagName)
 	return cfg
 }
@@ -0,0 +1,24 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func TestModuleLvlsFlagValue(t *testing.T) {
+	v := NewModuleLvlsFlagValue(nil)
+	require.NoError(t, v.Set("p2p=debug, derive=WARN,"))
+	require.Equal(t, map[string]log.Lvl{"p2p": log.LvlDebug, "derive": log.LvlWarn}, v.ModuleLvls())
+	require.Equal(t, "derive=warn,p2p=dbug", v.String())
+
+	cpy := v.Clone().(*ModuleLvlsFlagValue)
+	require.NoError(t, cpy.Set("txmgr=trace"))
+	require.Equal(t, map[string]log.Lvl{"p2p": log.LvlDebug, "derive": log.LvlWarn}, v.ModuleLvls(), "clone is independent")
+
+	require.ErrorContains(t, v.Set("p2p"), "expected module=level")
+	require.ErrorContains(t, v.Set("=debug"), "expected module=level")
+	require.ErrorContains(t, v.Set("p2p=loud"), "invalid log level of module")
+}
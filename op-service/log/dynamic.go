@@ -1,15 +1,51 @@
 package log
 
-import "github.com/ethereum/go-ethereum/log"
+import (
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ModuleKey is the log context key that tags log records with the module that produced them.
+const ModuleKey = "module"
+
+// ForModule returns a logger that tags its records with the given module,
+// so the log level of the module can be configured separately.
+func ForModule(l log.Logger, module string) log.Logger {
+	return l.New(ModuleKey, module)
+}
+
+// recordModule returns the innermost module tag of the record, if any.
+func recordModule(r *log.Record) (string, bool) {
+	var module string
+	var ok bool
+	for i := 0; i+1 < len(r.Ctx); i += 2 {
+		if k, isStr := r.Ctx[i].(string); isStr && k == ModuleKey {
+			module, ok = r.Ctx[i+1].(string)
+		}
+	}
+	return module, ok
+}
 
 type LvlSetter interface {
 	SetLogLevel(lvl log.Lvl)
 }
 
+// ModuleLvlSetter is implemented by log handlers that support log levels per module.
+type ModuleLvlSetter interface {
+	SetModuleLogLevel(module string, lvl log.Lvl)
+	// ResetModuleLogLevel makes the module follow the global log level again.
+	ResetModuleLogLevel(module string)
+	ModuleLogLevels() map[string]log.Lvl
+}
+
 // DynamicLogHandler allow runtime-configuration of the log handler.
 type DynamicLogHandler struct {
 	log.Handler // embedded, to expose any extra methods the underlying handler might provide
 	maxLvl      log.Lvl
+
+	// moduleLvls is replaced, never modified, so it can be read without locking
+	moduleLvls atomic.Pointer[map[string]log.Lvl]
 }
 
 func NewDynamicLogHandler(lvl log.Lvl, h log.Handler) *DynamicLogHandler {
@@ -23,8 +59,54 @@ func (d *DynamicLogHandler) SetLogLevel(lvl log.Lvl) {
 	d.maxLvl = lvl
 }
 
+func (d *DynamicLogHandler) SetModuleLogLevel(module string, lvl log.Lvl) {
+	d.updateModuleLvls(func(lvls map[string]log.Lvl) {
+		lvls[module] = lvl
+	})
+}
+
+func (d *DynamicLogHandler) ResetModuleLogLevel(module string) {
+	d.updateModuleLvls(func(lvls map[string]log.Lvl) {
+		delete(lvls, module)
+	})
+}
+
+func (d *DynamicLogHandler) ModuleLogLevels() map[string]log.Lvl {
+	out := make(map[string]log.Lvl)
+	if lvls := d.moduleLvls.Load(); lvls != nil {
+		for k, v := range *lvls {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func (d *DynamicLogHandler) updateModuleLvls(fn func(lvls map[string]log.Lvl)) {
+	for {
+		prev := d.moduleLvls.Load()
+		next := make(map[string]log.Lvl)
+		if prev != nil {
+			for k, v := range *prev {
+				next[k] = v
+			}
+		}
+		fn(next)
+		if d.moduleLvls.CompareAndSwap(prev, &next) {
+			return
+		}
+	}
+}
+
 func (d *DynamicLogHandler) Log(r *log.Record) error {
-	if r.Lvl > d.maxLvl { // lower log level values are more critical
+	maxLvl := d.maxLvl
+	if lvls := d.moduleLvls.Load(); lvls != nil && len(*lvls) > 0 {
+		if module, ok := recordModule(r); ok {
+			if lvl, ok := (*lvls)[module]; ok {
+				maxLvl = lvl
+			}
+		}
+	}
+	if r.Lvl > maxLvl { // lower log level values are more critical
 		return nil
 	}
 	return d.Handler.Log(r) // process the log
@@ -42,3 +42,47 @@ func TestDynamicLogHandler_SetLogLevel(t *testing.T) {
 	require.Equal(t, records[4].Msg, "visible warning")
 	require.Equal(t, records[5].Msg, "another error")
 }
+
+func TestDynamicLogHandler_SetModuleLogLevel(t *testing.T) {
+	var records []*log.Record
+	h := log.FuncHandler(func(r *log.Record) error {
+		records = append(records, r)
+		return nil
+	})
+	d := NewDynamicLogHandler(log.LvlInfo, h)
+	logger := log.New()
+	logger.SetHandler(d)
+	p2p := ForModule(logger, "p2p")
+	derive := ForModule(logger, "derive")
+
+	p2p.Debug("p2p debug hidden")       // n
+	derive.Debug("derive debug hidden") // n
+
+	// only increase the log level of p2p
+	logger.GetHandler().(ModuleLvlSetter).SetModuleLogLevel("p2p", log.LvlDebug)
+	p2p.Debug("p2p debug visible")         // y
+	derive.Debug("derive debug hidden")    // n
+	logger.Debug("untagged debug hidden")  // n
+	derive.Info("derive info visible")     // y
+	p2p.New("peer", "x").Debug("inherits") // y
+
+	// decrease the log level of derive below the global level
+	logger.GetHandler().(ModuleLvlSetter).SetModuleLogLevel("derive", log.LvlError)
+	derive.Info("derive info hidden")  // n
+	logger.Info("untagged info shown") // y
+	require.Equal(t, map[string]log.Lvl{"p2p": log.LvlDebug, "derive": log.LvlError},
+		logger.GetHandler().(ModuleLvlSetter).ModuleLogLevels())
+
+	// reset p2p to follow the global level again
+	logger.GetHandler().(ModuleLvlSetter).ResetModuleLogLevel("p2p")
+	p2p.Debug("p2p debug hidden again") // n
+	p2p.Info("p2p info visible")        // y
+
+	require.Len(t, records, 5)
+	require.Equal(t, "p2p debug visible", records[0].Msg)
+	require.Equal(t, "derive info visible", records[1].Msg)
+	require.Equal(t, "inherits", records[2].Msg)
+	require.Equal(t, "untagged info shown", records[3].Msg)
+	require.Equal(t, "p2p info visible", records[4].Msg)
+	require.Equal(t, []any{ModuleKey, "p2p"}, records[4].Ctx)
+}
@@ -0,0 +1,107 @@
+package log
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type sampleKey struct {
+	lvl log.Lvl
+	msg string
+}
+
+type sampleWindow struct {
+	start      time.Time
+	count      int
+	suppressed int
+	// last suppressed record, to report the module and level of the suppressed messages
+	last *log.Record
+}
+
+// SamplingHandler caps the number of identical log messages that are processed per interval.
+// Messages are identical if they have the same level and message, regardless of the context.
+// Once an interval ends, a summary with the number of suppressed messages is logged.
+// Summaries are logged lazily, when the next record is processed, so no background routine is needed.
+type SamplingHandler struct {
+	log.Handler
+	interval time.Duration
+	limit    int
+	now      func() time.Time
+
+	mu        sync.Mutex
+	windows   map[sampleKey]*sampleWindow
+	lastSweep time.Time
+}
+
+// NewSamplingHandler creates a handler that processes at most limit identical messages per interval.
+func NewSamplingHandler(h log.Handler, interval time.Duration, limit int) *SamplingHandler {
+	return &SamplingHandler{
+		Handler:  h,
+		interval: interval,
+		limit:    limit,
+		now:      time.Now,
+		windows:  make(map[sampleKey]*sampleWindow),
+	}
+}
+
+func (s *SamplingHandler) Log(r *log.Record) error {
+	now := s.now()
+	key := sampleKey{lvl: r.Lvl, msg: r.Msg}
+
+	s.mu.Lock()
+	var summaries []*log.Record
+	// Sweep the expired windows at most once per interval, to summarize messages that stopped repeating.
+	if now.Sub(s.lastSweep) >= s.interval {
+		for k, w := range s.windows {
+			if now.Sub(w.start) >= s.interval {
+				if w.suppressed > 0 {
+					summaries = append(summaries, s.summary(k, w, now))
+				}
+				delete(s.windows, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	w, ok := s.windows[key]
+	if !ok || now.Sub(w.start) >= s.interval {
+		if ok && w.suppressed > 0 {
+			summaries = append(summaries, s.summary(key, w, now))
+		}
+		w = &sampleWindow{start: now}
+		s.windows[key] = w
+	}
+	w.count++
+	pass := w.count <= s.limit
+	if !pass {
+		w.suppressed++
+		w.last = r
+	}
+	s.mu.Unlock()
+
+	for _, summary := range summaries {
+		if err := s.Handler.Log(summary); err != nil {
+			return err
+		}
+	}
+	if !pass {
+		return nil
+	}
+	return s.Handler.Log(r)
+}
+
+func (s *SamplingHandler) summary(key sampleKey, w *sampleWindow, now time.Time) *log.Record {
+	// not "msg", which collides with the message of the record in structured formats
+	ctx := []any{"suppressed_msg", key.msg, "suppressed", w.suppressed, "interval", s.interval}
+	if module, ok := recordModule(w.last); ok {
+		ctx = append([]any{ModuleKey, module}, ctx...)
+	}
+	return &log.Record{
+		Time:     now,
+		Lvl:      key.lvl,
+		Msg:      "Suppressed repeated log messages",
+		Ctx:      ctx,
+		KeyNames: w.last.KeyNames,
+	}
+}
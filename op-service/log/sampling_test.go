@@ -0,0 +1,88 @@
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func TestSamplingHandler(t *testing.T) {
+	var records []*log.Record
+	h := log.FuncHandler(func(r *log.Record) error {
+		records = append(records, r)
+		return nil
+	})
+	now := time.Unix(1000, 0)
+	s := NewSamplingHandler(h, time.Minute, 2)
+	s.now = func() time.Time { return now }
+	logger := log.New()
+	logger.SetHandler(s)
+
+	for i := 0; i < 5; i++ {
+		ForModule(logger, "p2p").Warn("flood", "i", i)
+	}
+	logger.Info("other")
+	logger.Error("flood") // different level, different message
+	require.Len(t, records, 2+1+1)
+	require.Equal(t, []any{ModuleKey, "p2p", "i", 0}, records[0].Ctx)
+	require.Equal(t, []any{ModuleKey, "p2p", "i", 1}, records[1].Ctx)
+	require.Equal(t, "other", records[2].Msg)
+
+	// once the interval passes, the suppressed messages are summarized, and the message is output again
+	now = now.Add(time.Minute)
+	records = nil
+	logger.Warn("flood", "i", 5)
+	require.Len(t, records, 2)
+	require.Equal(t, "Suppressed repeated log messages", records[0].Msg)
+	require.Equal(t, log.LvlWarn, records[0].Lvl)
+	require.Equal(t, []any{ModuleKey, "p2p", "suppressed_msg", "flood", "suppressed", 3, "interval", time.Minute}, records[0].Ctx)
+	require.Equal(t, "flood", records[1].Msg)
+
+	// messages that stop repeating are summarized when any other message is logged after the interval
+	for i := 0; i < 3; i++ {
+		logger.Warn("burst")
+	}
+	now = now.Add(2 * time.Minute)
+	records = nil
+	logger.Info("other")
+	require.Len(t, records, 2)
+	require.Equal(t, "Suppressed repeated log messages", records[0].Msg)
+	require.Equal(t, []any{"suppressed_msg", "burst", "suppressed", 1, "interval", time.Minute}, records[0].Ctx)
+	require.Equal(t, "other", records[1].Msg)
+}
+
+func TestSamplingHandlerJSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Unix(1000, 0)
+	s := NewSamplingHandler(log.StreamHandler(&buf, log.JSONFormat()), time.Minute, 1)
+	s.now = func() time.Time { return now }
+	logger := log.New()
+	logger.SetHandler(s)
+
+	for i := 0; i < 3; i++ {
+		logger.Warn("flood")
+	}
+	now = now.Add(time.Minute)
+	logger.Info("other")
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, lines, 3)
+	require.Equal(t, "flood", lines[0]["msg"])
+	require.Equal(t, "Suppressed repeated log messages", lines[1]["msg"])
+	require.Equal(t, "flood", lines[1]["suppressed_msg"])
+	require.Equal(t, float64(2), lines[1]["suppressed"])
+	require.Equal(t, "other", lines[2]["msg"])
+}
@@ -49,3 +49,58 @@ func (n *CommonAdminAPI) SetLogLevel(ctx context.Context, lvlStr string) error {
 	lvlSetter.SetLogLevel(lvl)
 	return nil
 }
+
+// SetModuleLogLevel sets the log level of the loggers tagged with the given module, see oplog.ForModule.
+func (n *CommonAdminAPI) SetModuleLogLevel(ctx context.Context, module string, lvlStr string) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setModuleLogLevel")
+	defer recordDur()
+
+	lvl, err := log.LvlFromString(lvlStr)
+	if err != nil {
+		return err
+	}
+	lvlSetter, err := n.moduleLvlSetter()
+	if err != nil {
+		return err
+	}
+	lvlSetter.SetModuleLogLevel(module, lvl)
+	return nil
+}
+
+// ResetModuleLogLevel makes the loggers tagged with the given module follow the global log level again.
+func (n *CommonAdminAPI) ResetModuleLogLevel(ctx context.Context, module string) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_resetModuleLogLevel")
+	defer recordDur()
+
+	lvlSetter, err := n.moduleLvlSetter()
+	if err != nil {
+		return err
+	}
+	lvlSetter.ResetModuleLogLevel(module)
+	return nil
+}
+
+// ModuleLogLevels returns the log levels that are set per module.
+func (n *CommonAdminAPI) ModuleLogLevels(ctx context.Context) (map[string]string, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_moduleLogLevels")
+	defer recordDur()
+
+	lvlSetter, err := n.moduleLvlSetter()
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]string)
+	for module, lvl := range lvlSetter.ModuleLogLevels() {
+		out[module] = lvl.String()
+	}
+	return out, nil
+}
+
+func (n *CommonAdminAPI) moduleLvlSetter() (oplog.ModuleLvlSetter, error) {
+	h := n.log.GetHandler()
+	lvlSetter, ok := h.(oplog.ModuleLvlSetter)
+	if !ok {
+		return nil, fmt.Errorf("log handler type %T cannot change module log levels", h)
+	}
+	return lvlSetter, nil
+}
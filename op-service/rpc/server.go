@@ -17,6 +17,7 @@ import (
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
 var wildcardHosts = []string{"*"}
@@ -168,6 +169,7 @@ func (b *Server) Start() error {
 	for _, middleware := range b.middlewares {
 		nodeHdlr = middleware(nodeHdlr)
 	}
+	nodeHdlr = tracing.NewRPCServerMiddleware(nodeHdlr)
 	nodeHdlr = node.NewHTTPHandlerStack(nodeHdlr, b.corsHosts, b.vHosts, b.jwtSecret)
 
 	mux := http.NewServeMux()
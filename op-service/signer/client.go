@@ -3,7 +3,6 @@ package signer
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -20,24 +19,24 @@ import (
 )
 
 type SignerClient struct {
-	client *rpc.Client
-	status string
-	logger log.Logger
+	client  *rpc.Client
+	certMan *certman.CertMan
+	status  string
+	logger  log.Logger
 }
 
 func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConfig) (*SignerClient, error) {
 	var httpClient *http.Client
+	var cm *certman.CertMan
 	if tlsConfig.TLSCaCert != "" {
 		logger.Info("tlsConfig specified, loading tls config")
-		caCert, err := os.ReadFile(tlsConfig.TLSCaCert)
-		if err != nil {
+		if _, err := os.Stat(tlsConfig.TLSCaCert); err != nil {
 			return nil, fmt.Errorf("failed to read tls.ca: %w", err)
 		}
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
 
-		// certman watches for newer client certifictes and automatically reloads them
-		cm, err := certman.New(logger, tlsConfig.TLSCert, tlsConfig.TLSKey)
+		// certman watches for newer ca and client certificates and automatically reloads them
+		var err error
+		cm, err = certman.NewWithCA(logger, tlsConfig.TLSCaCert, tlsConfig.TLSCert, tlsConfig.TLSKey)
 		if err != nil {
 			logger.Error("failed to read tls cert or key", "err", err)
 			return nil, err
@@ -46,15 +45,19 @@ func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConf
 			logger.Error("failed to start certman watcher", "err", err)
 			return nil, err
 		}
+		if cm.CAPool() == nil {
+			cm.Stop()
+			return nil, fmt.Errorf("failed to load tls.ca from %s", tlsConfig.TLSCaCert)
+		}
 
 		httpClient = &http.Client{
 			Transport: &http.Transport{
 				TLSClientConfig: &tls.Config{
 					MinVersion: tls.VersionTLS13,
-					RootCAs:    caCertPool,
-					GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
-						return cm.GetCertificate(nil)
-					},
+					// The server certificate is verified against the reloadable ca in VerifyConnection instead.
+					InsecureSkipVerify:   true,
+					VerifyConnection:     cm.VerifyConnection,
+					GetClientCertificate: cm.GetClientCertificate,
 				},
 			},
 		}
@@ -65,13 +68,17 @@ func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConf
 
 	rpcClient, err := rpc.DialOptions(context.Background(), endpoint, rpc.WithHTTPClient(httpClient))
 	if err != nil {
+		if cm != nil {
+			cm.Stop()
+		}
 		return nil, err
 	}
 
-	signer := &SignerClient{logger: logger, client: rpcClient}
+	signer := &SignerClient{logger: logger, client: rpcClient, certMan: cm}
 	// Check if reachable
 	version, err := signer.pingVersion()
 	if err != nil {
+		signer.Close()
 		return nil, err
 	}
 	signer.status = fmt.Sprintf("ok [version=%v]", version)
@@ -82,6 +89,14 @@ func NewSignerClientFromConfig(logger log.Logger, config CLIConfig) (*SignerClie
 	return NewSignerClient(logger, config.Endpoint, config.TLSConfig)
 }
 
+// Close closes the underlying RPC client and stops watching the TLS certificates.
+func (s *SignerClient) Close() {
+	s.client.Close()
+	if s.certMan != nil {
+		s.certMan.Stop()
+	}
+}
+
 func (s *SignerClient) pingVersion() (string, error) {
 	var v string
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
@@ -0,0 +1,205 @@
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/signer"
+	signerTest "github.com/ethereum-optimism/optimism/op-service/signer/test"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+// testPKI holds a CA with a server and a client certificate issued by it.
+type testPKI struct {
+	caPEM  []byte
+	server tls.Certificate
+	pool   *x509.CertPool
+
+	clientCertPEM, clientKeyPEM []byte
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(template *x509.Certificate) (certPEM, keyPEM []byte) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template.NotBefore = caTemplate.NotBefore
+		template.NotAfter = caTemplate.NotAfter
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	}
+
+	serverCertPEM, serverKeyPEM := issue(&x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "signer"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	server, err := tls.X509KeyPair(serverCertPEM, serverKeyPEM)
+	require.NoError(t, err)
+	clientCertPEM, clientKeyPEM := issue(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	return &testPKI{
+		caPEM:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		server:        server,
+		pool:          pool,
+		clientCertPEM: clientCertPEM,
+		clientKeyPEM:  clientKeyPEM,
+	}
+}
+
+// writeClientFiles writes the CA certificate and the client key pair to the files of the TLS config.
+func (p *testPKI) writeClientFiles(t *testing.T, cfg optls.CLIConfig) {
+	require.NoError(t, os.WriteFile(cfg.TLSCaCert, p.caPEM, 0o600))
+	require.NoError(t, os.WriteFile(cfg.TLSCert, p.clientCertPEM, 0o600))
+	require.NoError(t, os.WriteFile(cfg.TLSKey, p.clientKeyPEM, 0o600))
+}
+
+func signTestTx(t *testing.T, client *signer.SignerClient, from common.Address) (*types.Transaction, error) {
+	chainID := big.NewInt(10)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21_000,
+		To:        &common.Address{0xaa},
+		Value:     big.NewInt(3),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	signed, err := client.SignTransaction(ctx, chainID, from, tx)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+	require.Equal(t, tx.Value(), signed.Value())
+	return signed, nil
+}
+
+func TestSignerClient(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	server, _ := signerTest.NewMockSigner(t, key)
+	server.Start()
+	defer server.Close()
+
+	client, err := signer.NewSignerClient(testlog.Logger(t, log.LvlInfo), server.URL, optls.CLIConfig{})
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = signTestTx(t, client, from)
+	require.NoError(t, err)
+}
+
+func TestSignerClientTLSRotation(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	var mu sync.Mutex
+	pki := newTestPKI(t)
+	server, _ := signerTest.NewMockSigner(t, key)
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS13,
+				Certificates: []tls.Certificate{pki.server},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    pki.pool,
+			}, nil
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	tlsCfg := optls.CLIConfig{
+		TLSCaCert: filepath.Join(dir, "ca.crt"),
+		TLSCert:   filepath.Join(dir, "tls.crt"),
+		TLSKey:    filepath.Join(dir, "tls.key"),
+	}
+	pki.writeClientFiles(t, tlsCfg)
+
+	client, err := signer.NewSignerClient(testlog.Logger(t, log.LvlInfo), server.URL, tlsCfg)
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = signTestTx(t, client, from)
+	require.NoError(t, err)
+
+	// rotate the whole PKI on the server side, so the client can neither verify the server nor authenticate
+	rotated := newTestPKI(t)
+	mu.Lock()
+	pki = rotated
+	mu.Unlock()
+	server.CloseClientConnections()
+	_, err = signTestTx(t, client, from)
+	require.Error(t, err)
+
+	// the client picks up the rotated files without a restart
+	rotated.writeClientFiles(t, tlsCfg)
+	require.Eventually(t, func() bool {
+		_, err := signTestTx(t, client, from)
+		return err == nil
+	}, 20*time.Second, 250*time.Millisecond)
+}
+
+func TestSignerClientMissingCA(t *testing.T) {
+	dir := t.TempDir()
+	_, err := signer.NewSignerClient(testlog.Logger(t, log.LvlInfo), "http://127.0.0.1:0", optls.CLIConfig{
+		TLSCaCert: filepath.Join(dir, "ca.crt"),
+		TLSCert:   filepath.Join(dir, "tls.crt"),
+		TLSKey:    filepath.Join(dir, "tls.key"),
+	})
+	require.ErrorContains(t, err, "failed to read tls.ca")
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"crypto/ecdsa"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/signer"
+)
+
+// MockSignerHealthAPI serves the health namespace of a remote signer.
+type MockSignerHealthAPI struct{}
+
+func (MockSignerHealthAPI) Status() string {
+	return "mock"
+}
+
+// MockSignerEthAPI serves the eth namespace of a remote signer, and signs transactions with its key.
+type MockSignerEthAPI struct {
+	Key *ecdsa.PrivateKey
+	// Calls counts the signed transactions.
+	Calls atomic.Int64
+}
+
+func (m *MockSignerEthAPI) SignTransaction(args signer.TransactionArgs) (hexutil.Bytes, error) {
+	m.Calls.Add(1)
+	tx, err := types.SignTx(args.ToTransaction(), types.LatestSignerForChainID(args.ChainID.ToInt()), m.Key)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+// NewMockSigner returns a local remote signer, which serves the signer RPC methods over plain HTTP.
+// The server is not started, so that callers may configure TLS before starting it.
+func NewMockSigner(t *testing.T, key *ecdsa.PrivateKey) (*httptest.Server, *MockSignerEthAPI) {
+	ethAPI := &MockSignerEthAPI{Key: key}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("health", MockSignerHealthAPI{}))
+	require.NoError(t, server.RegisterName("eth", ethAPI))
+	t.Cleanup(server.Stop)
+	return httptest.NewUnstartedServer(server), ethAPI
+}
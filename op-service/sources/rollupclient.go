@@ -68,6 +68,14 @@ func (r *RollupClient) SetLogLevel(ctx context.Context, lvl log.Lvl) error {
 	return r.rpc.CallContext(ctx, nil, "admin_setLogLevel", lvl.String())
 }
 
+func (r *RollupClient) SetModuleLogLevel(ctx context.Context, module string, lvl log.Lvl) error {
+	return r.rpc.CallContext(ctx, nil, "admin_setModuleLogLevel", module, lvl.String())
+}
+
+func (r *RollupClient) ResetModuleLogLevel(ctx context.Context, module string) error {
+	return r.rpc.CallContext(ctx, nil, "admin_resetModuleLogLevel", module)
+}
+
 func (r *RollupClient) Close() {
 	r.rpc.Close()
 }
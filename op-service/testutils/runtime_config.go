@@ -4,8 +4,18 @@ import "github.com/ethereum/go-ethereum/common"
 
 type MockRuntimeConfig struct {
 	P2PSeqAddress common.Address
+	// P2PSeqAddresses are accepted next to P2PSeqAddress
+	P2PSeqAddresses []common.Address
 }
 
 func (m *MockRuntimeConfig) P2PSequencerAddress() common.Address {
 	return m.P2PSeqAddress
 }
+
+func (m *MockRuntimeConfig) P2PSequencerAddresses() []common.Address {
+	var addrs []common.Address
+	if m.P2PSeqAddress != (common.Address{}) {
+		addrs = append(addrs, m.P2PSeqAddress)
+	}
+	return append(addrs, m.P2PSeqAddresses...)
+}
@@ -1,6 +1,7 @@
 // Package certman provides live reloading of the certificate and key
 // files used by the standard library http.Server. It defines a type,
-// certMan, with methods watching and getting the files.
+// certMan, with methods watching and getting the files. Optionally, the
+// CA certificate used to verify peers can be watched and reloaded as well.
 // Only valid certificate and key pairs are loaded and an optional
 // logger can be passed to certman for logging providing it implements
 // the logger interface.
@@ -8,7 +9,10 @@ package certman
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -23,8 +27,10 @@ import (
 // and key pairs for changes.
 type CertMan struct {
 	mu       sync.RWMutex
+	caFile   string
 	certFile string
 	keyFile  string
+	caPool   *x509.CertPool
 	keyPair  *tls.Certificate
 	watcher  *fsnotify.Watcher
 	watching chan bool
@@ -53,6 +59,21 @@ func New(logger log.Logger, certFile, keyFile string) (*CertMan, error) {
 	return cm, nil
 }
 
+// NewWithCA creates a new certMan that, in addition to the certificate
+// and key pair, watches the CA certificate file at caFile. The loaded
+// CA certificates are used by VerifyConnection.
+func NewWithCA(logger log.Logger, caFile, certFile, keyFile string) (*CertMan, error) {
+	cm, err := New(logger, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cm.caFile, err = filepath.Abs(caFile)
+	if err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
 // Watch starts watching for changes to the certificate
 // and key files. On any change the certificate and key
 // are reloaded. If there is an issue the load will fail
@@ -75,6 +96,14 @@ func (cm *CertMan) Watch() error {
 			return fmt.Errorf("certman: can't watch %s: %w", certPath, err)
 		}
 	}
+	if cm.caFile != "" {
+		caPath := path.Dir(cm.caFile)
+		if caPath != certPath && caPath != keyPath {
+			if err = cm.watcher.Add(caPath); err != nil {
+				return fmt.Errorf("certman: can't watch %s: %w", caPath, err)
+			}
+		}
+	}
 	if err := cm.load(); err != nil {
 		cm.log.Error("certman: can't load cert or key file", "err", err)
 	}
@@ -92,7 +121,26 @@ func (cm *CertMan) load() error {
 		cm.mu.Unlock()
 		cm.log.Info("certman: certificate and key loaded")
 	}
-	return err
+	if cm.caFile == "" {
+		return err
+	}
+	return errors.Join(err, cm.loadCA())
+}
+
+func (cm *CertMan) loadCA() error {
+	caCert, err := os.ReadFile(cm.caFile)
+	if err != nil {
+		return err
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		return fmt.Errorf("no valid certificates in %s", cm.caFile)
+	}
+	cm.mu.Lock()
+	cm.caPool = caPool
+	cm.mu.Unlock()
+	cm.log.Info("certman: ca certificate loaded")
+	return nil
 }
 
 func (cm *CertMan) run() {
@@ -100,6 +148,9 @@ func (cm *CertMan) run() {
 
 	ticker := time.NewTicker(2 * time.Second)
 	files := []string{cm.certFile, cm.keyFile}
+	if cm.caFile != "" {
+		files = append(files, cm.caFile)
+	}
 	reload := time.Time{}
 
 loop:
@@ -150,6 +201,38 @@ func (cm *CertMan) GetClientCertificate(hello *tls.CertificateRequestInfo) (*tls
 	return cm.keyPair, nil
 }
 
+// CAPool returns the loaded CA certificates, or nil if no CA
+// certificate was loaded.
+func (cm *CertMan) CAPool() *x509.CertPool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.caPool
+}
+
+// VerifyConnection verifies the peer certificate chain against the
+// loaded CA certificates, for use by the VerifyConnection field in
+// tls.Config. Since the CA certificates can change, the standard
+// verification has to be disabled with InsecureSkipVerify.
+func (cm *CertMan) VerifyConnection(cs tls.ConnectionState) error {
+	caPool := cm.CAPool()
+	if caPool == nil {
+		return errors.New("certman: no ca certificate loaded")
+	}
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("certman: no peer certificate")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         caPool,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
 // Stop tells certMan to stop watching for changes to the
 // certificate and key files.
 func (cm *CertMan) Stop() {
@@ -5,13 +5,24 @@
 package certman_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidPair(t *testing.T) {
@@ -79,3 +90,113 @@ func TestGetCertificate(t *testing.T) {
 		t.Errorf("certman certificate doesn't match expected certificate")
 	}
 }
+
+// testCA is a CA that issues leaf certificates for VerifyConnection.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// connState returns the connection state of a peer that presents a leaf certificate issued by the CA.
+func (ca *testCA) connState(t *testing.T) tls.ConnectionState {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "peer"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    ca.cert.NotBefore,
+		NotAfter:     ca.cert.NotAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.ConnectionState{ServerName: "localhost", PeerCertificates: []*x509.Certificate{cert}}
+}
+
+func newCAFile(t *testing.T, ca *testCA) string {
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, ca.pem, 0o600))
+	return caFile
+}
+
+func TestVerifyConnection(t *testing.T) {
+	ca := newTestCA(t)
+	cm, err := certman.NewWithCA(log.Root(), newCAFile(t, ca), "./testdata/server1.crt", "./testdata/server1.key")
+	require.NoError(t, err)
+	require.NoError(t, cm.Watch())
+	defer cm.Stop()
+	require.NotNil(t, cm.CAPool())
+
+	require.NoError(t, cm.VerifyConnection(ca.connState(t)))
+
+	// peers of another CA are rejected
+	require.Error(t, cm.VerifyConnection(newTestCA(t).connState(t)))
+
+	// the peer must present a certificate for the server name
+	cs := ca.connState(t)
+	cs.ServerName = "example.com"
+	require.Error(t, cm.VerifyConnection(cs))
+
+	require.ErrorContains(t, cm.VerifyConnection(tls.ConnectionState{}), "no peer certificate")
+}
+
+func TestCAReload(t *testing.T) {
+	ca := newTestCA(t)
+	caFile := newCAFile(t, ca)
+	cm, err := certman.NewWithCA(log.Root(), caFile, "./testdata/server1.crt", "./testdata/server1.key")
+	require.NoError(t, err)
+	require.NoError(t, cm.Watch())
+	defer cm.Stop()
+
+	rotated := newTestCA(t)
+	require.Error(t, cm.VerifyConnection(rotated.connState(t)))
+	require.NoError(t, os.WriteFile(caFile, rotated.pem, 0o600))
+	require.Eventually(t, func() bool {
+		return cm.VerifyConnection(rotated.connState(t)) == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Error(t, cm.VerifyConnection(ca.connState(t)), "the old CA is no longer trusted")
+
+	// an invalid CA file is not loaded, and the previous CA stays in use
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	time.Sleep(5 * time.Second)
+	require.NoError(t, cm.VerifyConnection(rotated.connState(t)))
+}
+
+func TestCANotLoaded(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	cm, err := certman.NewWithCA(log.Root(), caFile, "./testdata/server1.crt", "./testdata/server1.key")
+	require.NoError(t, err)
+	require.NoError(t, cm.Watch())
+	defer cm.Stop()
+
+	require.Nil(t, cm.CAPool())
+	require.ErrorContains(t, cm.VerifyConnection(newTestCA(t).connState(t)), "no ca certificate loaded")
+	// the key pair is loaded regardless
+	cert, err := cm.GetClientCertificate(&tls.CertificateRequestInfo{})
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+}
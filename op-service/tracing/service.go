@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Service exports the spans of the process to an OTLP/HTTP collector.
+// The tracer provider is installed globally, so instrumented code does not need a reference to the service.
+// If tracing is disabled, the global no-op tracer provider stays in place.
+type Service struct {
+	log         log.Logger
+	cfg         CLIConfig
+	serviceName string
+	version     string
+
+	provider *sdktrace.TracerProvider
+}
+
+func New(log log.Logger, cfg CLIConfig, serviceName string, version string) *Service {
+	return &Service{
+		log:         log,
+		cfg:         cfg,
+		serviceName: serviceName,
+		version:     version,
+	}
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(s.cfg.Endpoint)}
+	if s.cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(s.serviceName),
+		semconv.ServiceVersion(s.version),
+	)
+	s.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(s.cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(s.provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	s.log.Info("started exporting traces", "endpoint", s.cfg.Endpoint, "sample_ratio", s.cfg.SampleRatio)
+	return nil
+}
+
+// Stop flushes the remaining spans to the collector.
+// Services stop it last, so that the spans of the shutdown of their other components are exported too.
+func (s *Service) Stop(ctx context.Context) error {
+	if s.provider == nil {
+		return nil
+	}
+	if err := s.provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shut down tracer provider: %w", err)
+	}
+	return nil
+}
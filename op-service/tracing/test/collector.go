@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace/noop"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+)
+
+// Collector is an in-process OTLP/HTTP trace collector.
+type Collector struct {
+	// Endpoint is the host:port to export the spans to
+	Endpoint string
+
+	mu    sync.Mutex
+	spans map[string][]*tracepb.Span
+	// service name of each span, from the resource the span was exported with
+	services map[string]string
+}
+
+func NewCollector(t *testing.T) *Collector {
+	c := &Collector{spans: make(map[string][]*tracepb.Span), services: make(map[string]string)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			http.NotFound(w, r)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req collectortrace.ExportTraceServiceRequest
+		if err := proto.Unmarshal(data, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.mu.Lock()
+		for _, rs := range req.ResourceSpans {
+			var service string
+			for _, attr := range rs.Resource.Attributes {
+				if attr.Key == "service.name" {
+					service = attr.Value.GetStringValue()
+				}
+			}
+			for _, ss := range rs.ScopeSpans {
+				for _, span := range ss.Spans {
+					c.spans[span.Name] = append(c.spans[span.Name], span)
+					c.services[span.Name] = service
+				}
+			}
+		}
+		c.mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		out, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+		_, _ = w.Write(out)
+	}))
+	t.Cleanup(srv.Close)
+	c.Endpoint = strings.TrimPrefix(srv.URL, "http://")
+	return c
+}
+
+// Span returns the last collected span with the given name, and the name of the service that exported it.
+func (c *Collector) Span(name string) (*tracepb.Span, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	spans := c.spans[name]
+	if len(spans) == 0 {
+		return nil, ""
+	}
+	return spans[len(spans)-1], c.services[name]
+}
+
+// Spans returns all collected spans with the given name, in the order they were exported.
+func (c *Collector) Spans(name string) []*tracepb.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*tracepb.Span(nil), c.spans[name]...)
+}
+
+// StartService starts a tracing service that exports all spans to the collector.
+// The spans are flushed to the collector when the service is stopped.
+func (c *Collector) StartService(t *testing.T) *tracing.Service {
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+	cfg := tracing.DefaultCLIConfig()
+	cfg.Enabled = true
+	cfg.Endpoint = c.Endpoint
+	cfg.Insecure = true
+	require.NoError(t, cfg.Check())
+	svc := tracing.New(testlog.Logger(t, log.LvlInfo), cfg, "test-service", "v1.2.3")
+	require.NoError(t, svc.Start(context.Background()))
+	return svc
+}
+
+// Attribute returns the value of the span attribute with the given key, or nil if the span doesn't have it.
+func Attribute(span *tracepb.Span, key string) *commonpb.AnyValue {
+	for _, attr := range span.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return nil
+}
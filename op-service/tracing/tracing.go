@@ -0,0 +1,118 @@
+// Package tracing provides optional OpenTelemetry tracing, exported to an OTLP collector,
+// and the helpers to propagate traces over JSON-RPC.
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const instrumentationPrefix = "github.com/ethereum-optimism/optimism/"
+
+// maxPeekSize is the largest JSON-RPC request body that is inspected for the method name.
+// Matches the default body limit of the geth RPC server.
+const maxPeekSize = 5 * 1024 * 1024
+
+// Tracer returns the tracer of the given instrumented package, e.g. "op-service/txmgr".
+// The tracer uses the global tracer provider, which is a no-op unless a Service is started.
+// The provider is looked up on every span, so package-level tracers follow the last started Service.
+func Tracer(pkg string) trace.Tracer {
+	return globalTracer{name: instrumentationPrefix + pkg}
+}
+
+type globalTracer struct {
+	embedded.Tracer
+	name string
+}
+
+func (t globalTracer) Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(t.name).Start(ctx, spanName, opts...)
+}
+
+// EndSpan records the error, if any, on the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ContextWithRPCHeaders returns a context that makes the geth RPC client
+// send the trace context of ctx along with the HTTP request.
+func ContextWithRPCHeaders(ctx context.Context) context.Context {
+	header := make(http.Header)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	if len(header) == 0 {
+		return ctx
+	}
+	return rpc.NewContextWithHeaders(ctx, header)
+}
+
+// NewRPCServerMiddleware wraps a JSON-RPC HTTP handler with a server span per request.
+// The trace context of the caller, if any, is continued.
+// The span is named after the requested method, or "batch" for batch requests.
+func NewRPCServerMiddleware(next http.Handler) http.Handler {
+	tracer := Tracer("op-service/rpc")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, "rpc.request",
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.RPCSystemKey.String("jsonrpc")))
+		defer span.End()
+		if span.IsRecording() && r.Body != nil {
+			peek, err := io.ReadAll(io.LimitReader(r.Body, maxPeekSize))
+			if err == nil {
+				nameRPCSpan(span, peek)
+			}
+			// Leave enforcing the body limit, and handling read errors, to the wrapped handler
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(peek), r.Body), r.Body}
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type jsonrpcMethod struct {
+	Method string `json:"method"`
+}
+
+func nameRPCSpan(span trace.Span, body []byte) {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var msgs []jsonrpcMethod
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return
+		}
+		methods := make([]string, len(msgs))
+		for i, msg := range msgs {
+			methods[i] = msg.Method
+		}
+		span.SetName("batch")
+		span.SetAttributes(
+			attribute.Int("rpc.jsonrpc.batch_size", len(msgs)),
+			attribute.StringSlice("rpc.jsonrpc.methods", methods))
+		return
+	}
+	var msg jsonrpcMethod
+	if err := json.Unmarshal(body, &msg); err != nil || msg.Method == "" {
+		return
+	}
+	span.SetName(msg.Method)
+	span.SetAttributes(semconv.RPCMethod(msg.Method))
+}
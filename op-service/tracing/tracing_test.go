@@ -0,0 +1,107 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+	tracingTest "github.com/ethereum-optimism/optimism/op-service/tracing/test"
+)
+
+type testAPI struct{}
+
+func (testAPI) Echo(ctx context.Context, v string) (string, error) {
+	_, span := tracing.Tracer("op-service/tracing").Start(ctx, "echo.inner")
+	defer span.End()
+	return v, nil
+}
+
+func TestRPCPropagation(t *testing.T) {
+	c := tracingTest.NewCollector(t)
+	svc := c.StartService(t)
+
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testAPI{}))
+	rpcSrv := httptest.NewServer(tracing.NewRPCServerMiddleware(srv))
+	t.Cleanup(rpcSrv.Close)
+	cl, err := rpc.Dial(rpcSrv.URL)
+	require.NoError(t, err)
+	t.Cleanup(cl.Close)
+
+	ctx, root := tracing.Tracer("op-service/tracing").Start(context.Background(), "client")
+	var out string
+	require.NoError(t, cl.CallContext(tracing.ContextWithRPCHeaders(ctx), &out, "test_echo", "hello"))
+	require.Equal(t, "hello", out)
+	root.End()
+
+	// Stop flushes all spans to the collector
+	require.NoError(t, svc.Stop(context.Background()))
+
+	client, service := c.Span("client")
+	require.NotNil(t, client)
+	require.Equal(t, "test-service", service)
+	server, _ := c.Span("test_echo")
+	require.NotNil(t, server, "server span is named after the RPC method")
+	inner, _ := c.Span("echo.inner")
+	require.NotNil(t, inner)
+
+	require.Equal(t, client.TraceId, server.TraceId, "server continues the trace of the client")
+	require.Equal(t, client.SpanId, server.ParentSpanId)
+	require.Equal(t, tracepb.Span_SPAN_KIND_SERVER, server.Kind)
+	require.Equal(t, server.SpanId, inner.ParentSpanId, "RPC handlers run in the context of the server span")
+}
+
+func TestRPCBatchSpan(t *testing.T) {
+	c := tracingTest.NewCollector(t)
+	svc := c.StartService(t)
+
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testAPI{}))
+	rpcSrv := httptest.NewServer(tracing.NewRPCServerMiddleware(srv))
+	t.Cleanup(rpcSrv.Close)
+	cl, err := rpc.Dial(rpcSrv.URL)
+	require.NoError(t, err)
+	t.Cleanup(cl.Close)
+
+	var a, b string
+	batch := []rpc.BatchElem{
+		{Method: "test_echo", Args: []any{"a"}, Result: &a},
+		{Method: "test_echo", Args: []any{"b"}, Result: &b},
+	}
+	require.NoError(t, cl.BatchCallContext(context.Background(), batch))
+	require.Equal(t, "a", a)
+	require.Equal(t, "b", b)
+	require.NoError(t, svc.Stop(context.Background()))
+
+	span, _ := c.Span("batch")
+	require.NotNil(t, span)
+	require.Equal(t, int64(2), tracingTest.Attribute(span, "rpc.jsonrpc.batch_size").GetIntValue())
+}
+
+func TestDisabled(t *testing.T) {
+	svc := tracing.New(testlog.Logger(t, log.LvlInfo), tracing.DefaultCLIConfig(), "test-service", "v1.2.3")
+	require.NoError(t, svc.Start(context.Background()))
+	_, span := tracing.Tracer("op-service/tracing").Start(context.Background(), "noop")
+	require.False(t, span.IsRecording(), "spans are not recorded when tracing is disabled")
+	span.End()
+	require.NoError(t, svc.Stop(context.Background()))
+}
+
+func TestCheck(t *testing.T) {
+	cfg := tracing.DefaultCLIConfig()
+	require.NoError(t, cfg.Check())
+	cfg.Enabled = true
+	cfg.SampleRatio = 1.5
+	require.Error(t, cfg.Check())
+	cfg.SampleRatio = 0.5
+	cfg.Endpoint = ""
+	require.Error(t, cfg.Check())
+}
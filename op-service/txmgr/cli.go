@@ -287,8 +287,21 @@ func NewConfig(cfg CLIConfig, l log.Logger) (Config, error) {
 	if err != nil {
 		return Config{}, fmt.Errorf("could not dial eth client: %w", err)
 	}
+	return newConfig(cfg, l, l1)
+}
 
-	ctx, cancel = context.WithTimeout(context.Background(), cfg.NetworkTimeout)
+// NewConfigWithClient creates a Config like NewConfig, but uses the given, already dialed,
+// L1 client instead of dialing the L1RPCURL, e.g. to share a client with multiple L1 endpoints.
+func NewConfigWithClient(cfg CLIConfig, l log.Logger, l1 *ethclient.Client) (Config, error) {
+	if err := cfg.Check(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	return newConfig(cfg, l, l1)
+}
+
+// newConfig creates a Config from the already validated cfg and the dialed L1 client.
+func newConfig(cfg CLIConfig, l log.Logger, l1 *ethclient.Client) (Config, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.NetworkTimeout)
 	defer cancel()
 	chainID, err := l1.ChainID(ctx)
 	if err != nil {
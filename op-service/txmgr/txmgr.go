@@ -20,12 +20,18 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
 
+var tracer = tracing.Tracer("op-service/txmgr")
+
 const (
 	// geth requires a minimum fee bump of 10% for regular tx resubmission
 	priceBump int64 = 10
@@ -140,7 +146,7 @@ func NewSimpleTxManagerFromConfig(name string, l log.Logger, m metrics.TxMetrice
 		name:    name,
 		cfg:     conf,
 		backend: conf.Backend,
-		l:       l.New("service", name),
+		l:       oplog.ForModule(l, "txmgr").New("service", name),
 		metr:    m,
 	}, nil
 }
@@ -169,6 +175,18 @@ func (m *SimpleTxManager) txLogger(tx *types.Transaction, logGas bool) log.Logge
 	return m.l.New(fields...)
 }
 
+// txAttributes are the span attributes of a transaction, matching the fields of the txLogger.
+func txAttributes(tx *types.Transaction) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("tx.hash", tx.Hash().String()),
+		attribute.Int64("tx.nonce", int64(tx.Nonce())),
+		attribute.String("tx.gas_tip_cap", tx.GasTipCap().String()),
+		attribute.String("tx.gas_fee_cap", tx.GasFeeCap().String()),
+		attribute.Int64("tx.gas_limit", int64(tx.Gas())),
+		attribute.Int("tx.blobs", len(tx.BlobHashes())),
+	}
+}
+
 // TxCandidate is a transaction candidate that can be submitted to ask the
 // [TxManager] to construct a transaction with gas price bounds.
 type TxCandidate struct {
@@ -195,6 +213,14 @@ type TxCandidate struct {
 //
 // NOTE: Send can be called concurrently, the nonce will be managed internally.
 func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*types.Receipt, error) {
+	ctx, span := tracer.Start(ctx, "txmgr.Send", trace.WithAttributes(
+		attribute.String("txmgr.name", m.name),
+		attribute.Int64("tx.gas_limit", int64(candidate.GasLimit)),
+		attribute.Int("tx.blobs", len(candidate.Blobs)),
+	))
+	if candidate.To != nil {
+		span.SetAttributes(attribute.String("tx.to", candidate.To.String()))
+	}
 	m.metr.RecordPendingTx(m.pending.Add(1))
 	defer func() {
 		m.metr.RecordPendingTx(m.pending.Add(-1))
@@ -202,7 +228,14 @@ func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*typ
 	receipt, err := m.send(ctx, candidate)
 	if err != nil {
 		m.resetNonce()
+	} else {
+		span.SetAttributes(
+			attribute.String("tx.hash", receipt.TxHash.String()),
+			attribute.Int64("tx.block_number", receipt.BlockNumber.Int64()),
+			attribute.Int64("tx.gas_used", int64(receipt.GasUsed)),
+		)
 	}
+	tracing.EndSpan(span, err)
 	return receipt, err
 }
 
@@ -460,7 +493,10 @@ func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction,
 		}
 
 		cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+		cCtx, span := tracer.Start(cCtx, "txmgr.publish", trace.WithAttributes(txAttributes(tx)...))
+		span.SetAttributes(attribute.Int("txmgr.bump_count", sendState.bumpCount))
 		err := m.backend.SendTransaction(cCtx, tx)
+		tracing.EndSpan(span, err)
 		cancel()
 		sendState.ProcessSendError(err)
 
@@ -595,7 +631,18 @@ func (m *SimpleTxManager) queryReceipt(ctx context.Context, txHash common.Hash,
 // higher fees that should satisfy geth's tx replacement rules. It also computes an updated gas
 // limit estimate. To avoid runaway price increases, fees are capped at a `feeLimitMultiplier`
 // multiple of the suggested values.
-func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transaction) (newTx *types.Transaction, err error) {
+	ctx, span := tracer.Start(ctx, "txmgr.increaseGasPrice", trace.WithAttributes(txAttributes(tx)...))
+	defer func() {
+		if newTx != nil {
+			span.SetAttributes(
+				attribute.String("tx.bumped_hash", newTx.Hash().String()),
+				attribute.String("tx.bumped_gas_tip_cap", newTx.GasTipCap().String()),
+				attribute.String("tx.bumped_gas_fee_cap", newTx.GasFeeCap().String()),
+			)
+		}
+		tracing.EndSpan(span, err)
+	}()
 	m.txLogger(tx, true).Info("bumping gas price for transaction")
 	tip, basefee, blobBasefee, err := m.suggestGasPriceCaps(ctx)
 	if err != nil {
@@ -631,7 +678,6 @@ func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transa
 			"gasFeeCap", bumpedFee, "gasTipCap", bumpedTip)
 	}
 
-	var newTx *types.Transaction
 	if tx.Type() == types.BlobTxType {
 		// Blob transactions have an additional blob gas price we must specify, so we must make sure it is
 		// getting bumped appropriately.
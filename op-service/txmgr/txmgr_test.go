@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	tracingTest "github.com/ethereum-optimism/optimism/op-service/tracing/test"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
 
@@ -421,6 +422,50 @@ func TestTxMgrConfirmsAtHigherGasPrice(t *testing.T) {
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
 }
 
+// TestTxMgrSendSpans asserts that Send traces each publication of the tx, and each fee bump in between.
+func TestTxMgrSendSpans(t *testing.T) {
+	c := tracingTest.NewCollector(t)
+	svc := c.StartService(t)
+
+	conf := configWithNumConfs(1)
+	conf.ResubmissionTimeout = 100 * time.Millisecond
+	h := newTestHarnessWithConfig(t, conf)
+	sendTx := func(ctx context.Context, tx *types.Transaction) error {
+		if h.gasPricer.shouldMine(tx.GasFeeCap()) {
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap(), nil)
+		}
+		return nil
+	}
+	h.backend.setTxSender(sendTx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	receipt, err := h.mgr.Send(ctx, h.createTxCandidate())
+	require.NoError(t, err)
+	require.NoError(t, svc.Stop(context.Background()))
+
+	send, _ := c.Span("txmgr.Send")
+	require.NotNil(t, send)
+	require.Equal(t, receipt.TxHash.String(), tracingTest.Attribute(send, "tx.hash").GetStringValue())
+
+	publishes := c.Spans("txmgr.publish")
+	require.Greater(t, len(publishes), 1, "tx is published again after each fee bump")
+	for i, span := range publishes {
+		require.Equal(t, send.SpanId, span.ParentSpanId)
+		require.Equal(t, int64(i), tracingTest.Attribute(span, "txmgr.bump_count").GetIntValue())
+	}
+	require.Equal(t, receipt.TxHash.String(), tracingTest.Attribute(publishes[len(publishes)-1], "tx.hash").GetStringValue())
+
+	bumps := c.Spans("txmgr.increaseGasPrice")
+	require.Len(t, bumps, len(publishes)-1)
+	for i, span := range bumps {
+		require.Equal(t, send.SpanId, span.ParentSpanId)
+		require.Equal(t, tracingTest.Attribute(publishes[i], "tx.hash").GetStringValue(), tracingTest.Attribute(span, "tx.hash").GetStringValue())
+		require.Equal(t, tracingTest.Attribute(publishes[i+1], "tx.hash").GetStringValue(), tracingTest.Attribute(span, "tx.bumped_hash").GetStringValue())
+	}
+}
+
 // TestTxMgrConfirmsBlobTxAtMaxGasPrice asserts that Send properly returns the max gas price
 // receipt if none of the lower gas price txs were mined when attempting to send a blob tx.
 func TestTxMgrConfirmsBlobTxAtHigherGasPrice(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/pkg/errors"
+
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 )
 
 type Config struct {
@@ -13,6 +15,7 @@ type Config struct {
 	Signer  SignerServiceConfig `toml:"signer_service"`
 	Metrics MetricsConfig       `toml:"metrics"`
 	Healthz HealthzConfig       `toml:"healthz"`
+	SLO     SLOConfig           `toml:"slo"`
 
 	Wallets   map[string]*WalletConfig   `toml:"wallets"`
 	Providers map[string]*ProviderConfig `toml:"providers"`
@@ -25,6 +28,16 @@ type SignerServiceConfig struct {
 	TLSKey    string `toml:"tls_key"`
 }
 
+// TLSConfig returns the TLS config of the shared op-service signer client.
+// The files are watched by the client, so certificates can be rotated without a restart.
+func (c *SignerServiceConfig) TLSConfig() optls.CLIConfig {
+	return optls.CLIConfig{
+		TLSCaCert: c.TLSCaCert,
+		TLSCert:   c.TLSCert,
+		TLSKey:    c.TLSKey,
+	}
+}
+
 type MetricsConfig struct {
 	Enabled bool   `toml:"enabled"`
 	Debug   bool   `toml:"debug"`
@@ -38,6 +51,18 @@ type HealthzConfig struct {
 	Port    string `toml:"port"`
 }
 
+type SLOConfig struct {
+	Enabled bool `toml:"enabled"`
+	// rolling windows to compute percentiles and error budgets over, e.g. 5m, 1h
+	Windows []TOMLDuration `toml:"windows"`
+	// round trips slower than this count against the error budget
+	InclusionTarget TOMLDuration `toml:"inclusion_target"`
+	// ratio of round trips that must succeed within the inclusion target, e.g. 0.99
+	Objective float64 `toml:"objective"`
+	// interval to update the SLO metrics and alerts
+	EvaluationInterval TOMLDuration `toml:"evaluation_interval"`
+}
+
 type WalletConfig struct {
 	ChainID big.Int `toml:"chain_id"`
 
@@ -88,6 +113,26 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.SLO.Enabled {
+		if len(c.SLO.Windows) == 0 {
+			return errors.New("slo is enabled but windows are missing")
+		}
+		for _, window := range c.SLO.Windows {
+			if window <= 0 {
+				return errors.New("slo windows must be positive")
+			}
+		}
+		if c.SLO.InclusionTarget <= 0 {
+			return errors.New("slo inclusion_target is missing")
+		}
+		if c.SLO.Objective <= 0 || c.SLO.Objective >= 1 {
+			return errors.New("slo objective must be between 0 and 1")
+		}
+		if c.SLO.EvaluationInterval <= 0 {
+			return errors.New("slo evaluation_interval is missing")
+		}
+	}
+
 	if len(c.Wallets) == 0 {
 		return errors.New("at least one wallet must be set")
 	}
@@ -40,3 +40,7 @@ func (i *InstrumentedSignerClient) SignTransaction(ctx context.Context, chainId
 	metrics.RecordRPCLatency(i.providerName, "signer", "SignTransaction", time.Since(start))
 	return tx, err
 }
+
+func (i *InstrumentedSignerClient) Close() {
+	i.c.Close()
+}
@@ -78,6 +78,34 @@ var (
 	}, []string{
 		"network",
 	})
+
+	sloLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: MetricsNamespace,
+		Name:      "slo_latency",
+		Help:      "SLO latency percentiles per provider, window and measure (ms)",
+	}, []string{
+		"provider",
+		"window",
+		"measure",
+		"quantile",
+	})
+
+	sloErrorBudgetRemaining = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: MetricsNamespace,
+		Name:      "slo_error_budget_remaining",
+		Help:      "Remaining SLO error budget per provider and window (ratio)",
+	}, []string{
+		"provider",
+		"window",
+	})
+
+	sloAlert = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: MetricsNamespace,
+		Name:      "slo_alert",
+		Help:      "Whether the SLO of the provider is violated (0 or 1)",
+	}, []string{
+		"provider",
+	})
 )
 
 var nonAlphanumericRegex = regexp.MustCompile(`[^a-zA-Z ]+`)
@@ -164,3 +192,41 @@ func RecordTransactionsInFlight(network string, count int) {
 	}
 	networkTransactionsInFlight.WithLabelValues(network).Set(float64(count))
 }
+
+func RecordSLOLatency(provider string, window string, measure string, quantile string, latency time.Duration) {
+	if Debug {
+		log.Debug("metric set",
+			"m", "slo_latency",
+			"provider", provider,
+			"window", window,
+			"measure", measure,
+			"quantile", quantile,
+			"latency", latency)
+	}
+	sloLatency.WithLabelValues(provider, window, measure, quantile).Set(float64(latency.Milliseconds()))
+}
+
+func RecordSLOErrorBudgetRemaining(provider string, window string, val float64) {
+	if Debug {
+		log.Debug("metric set",
+			"m", "slo_error_budget_remaining",
+			"provider", provider,
+			"window", window,
+			"val", val)
+	}
+	sloErrorBudgetRemaining.WithLabelValues(provider, window).Set(val)
+}
+
+func RecordSLOAlert(provider string, alert bool) {
+	if Debug {
+		log.Debug("metric set",
+			"m", "slo_alert",
+			"provider", provider,
+			"alert", alert)
+	}
+	val := 0.0
+	if alert {
+		val = 1
+	}
+	sloAlert.WithLabelValues(provider).Set(val)
+}
@@ -78,6 +78,7 @@ func (p *Provider) Heartbeat(ctx context.Context) {
 		if st.FirstSeen.IsZero() {
 			st.FirstSeen = time.Now()
 			metrics.RecordFirstSeenLatency(st.ProviderSource, p.name, latency)
+			p.slo.RecordFirstSeen(st.ProviderSource, latency)
 			log.Info("transaction first seen",
 				"hash", hash,
 				"firstSeenLatency", latency,
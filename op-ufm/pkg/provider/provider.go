@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-ufm/pkg/config"
+	iclients "github.com/ethereum-optimism/optimism/op-ufm/pkg/metrics/clients"
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/slo"
 )
 
 type Provider struct {
@@ -13,6 +16,11 @@ type Provider struct {
 	signerConfig *config.SignerServiceConfig
 	walletConfig *config.WalletConfig
 	txPool       *NetworkTransactionPool
+	slo          *slo.Monitor
+
+	// signer is created on first use and shared by all round trips of the provider
+	signerMu sync.Mutex
+	signer   *iclients.InstrumentedSignerClient
 
 	cancelFunc context.CancelFunc
 }
@@ -20,13 +28,15 @@ type Provider struct {
 func New(name string, cfg *config.ProviderConfig,
 	signerConfig *config.SignerServiceConfig,
 	walletConfig *config.WalletConfig,
-	txPool *NetworkTransactionPool) *Provider {
+	txPool *NetworkTransactionPool,
+	sloMonitor *slo.Monitor) *Provider {
 	p := &Provider{
 		name:         name,
 		config:       cfg,
 		signerConfig: signerConfig,
 		walletConfig: walletConfig,
 		txPool:       txPool,
+		slo:          sloMonitor,
 	}
 	return p
 }
@@ -45,6 +55,12 @@ func (p *Provider) Shutdown() {
 	if p.cancelFunc != nil {
 		p.cancelFunc()
 	}
+	p.signerMu.Lock()
+	defer p.signerMu.Unlock()
+	if p.signer != nil {
+		p.signer.Close()
+		p.signer = nil
+	}
 }
 
 func (p *Provider) Name() string {
@@ -9,7 +9,6 @@ import (
 	iclients "github.com/ethereum-optimism/optimism/op-ufm/pkg/metrics/clients"
 	"github.com/ethereum/go-ethereum/core"
 
-	"github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/txpool"
@@ -97,6 +96,7 @@ func (p *Provider) RoundTrip(ctx context.Context) {
 						"elapsed", time.Since(firstAttemptAt),
 						"attempt", attempt)
 					metrics.RecordErrorDetails(p.name, "send.timeout", err)
+					p.slo.RecordFailure(p.name)
 					return
 				}
 
@@ -120,6 +120,7 @@ func (p *Provider) RoundTrip(ctx context.Context) {
 					"nonce", nonce,
 					"err", err)
 				metrics.RecordErrorDetails(p.name, "ethclient.SendTransaction", err)
+				p.slo.RecordFailure(p.name)
 				return
 			}
 		} else {
@@ -154,6 +155,7 @@ func (p *Provider) RoundTrip(ctx context.Context) {
 				"nonce", nonce,
 				"elapsed", time.Since(sentAt))
 			metrics.RecordErrorDetails(p.name, "receipt.timeout", err)
+			p.slo.RecordFailure(p.name)
 			return
 		}
 		time.Sleep(time.Duration(p.config.ReceiptRetrievalInterval))
@@ -172,6 +174,7 @@ func (p *Provider) RoundTrip(ctx context.Context) {
 				"hash", txHash.Hex(),
 				"nonce", nonce,
 				"err", err)
+			p.slo.RecordFailure(p.name)
 			return
 		}
 		attempt++
@@ -180,6 +183,7 @@ func (p *Provider) RoundTrip(ctx context.Context) {
 	roundTripLatency := time.Since(roundTripStartedAt)
 
 	metrics.RecordRoundTripLatency(p.name, roundTripLatency)
+	p.slo.RecordInclusion(p.name, roundTripLatency)
 	metrics.RecordGasUsed(p.name, receipt.GasUsed)
 
 	log.Info("got transaction receipt",
@@ -285,18 +289,10 @@ func (p *Provider) sign(ctx context.Context, from *common.Address, tx *types.Tra
 		}
 		return types.SignTx(tx, types.LatestSignerForChainID(&p.walletConfig.ChainID), privateKey)
 	} else if p.walletConfig.SignerMethod == "signer" {
-		tlsConfig := tls.CLIConfig{
-			TLSCaCert: p.signerConfig.TLSCaCert,
-			TLSCert:   p.signerConfig.TLSCert,
-			TLSKey:    p.signerConfig.TLSKey,
-		}
-		client, err := iclients.NewSignerClient(p.name, log.Root(), p.signerConfig.URL, tlsConfig)
-		if err != nil || client == nil {
+		client, err := p.signerClient()
+		if err != nil {
 			log.Error("failed to create signer client", "err", err)
-		}
-
-		if client == nil {
-			return nil, errors.New("could not initialize signer client")
+			return nil, errors.Wrap(err, "could not initialize signer client")
 		}
 
 		signedTx, err := client.SignTransaction(ctx, &p.walletConfig.ChainID, from, tx)
@@ -309,3 +305,18 @@ func (p *Provider) sign(ctx context.Context, from *common.Address, tx *types.Tra
 		return nil, errors.New("invalid signer method")
 	}
 }
+
+// signerClient returns the signer client of the provider, connecting to the signer service on first use.
+func (p *Provider) signerClient() (*iclients.InstrumentedSignerClient, error) {
+	p.signerMu.Lock()
+	defer p.signerMu.Unlock()
+	if p.signer != nil {
+		return p.signer, nil
+	}
+	client, err := iclients.NewSignerClient(p.name, log.Root(), p.signerConfig.URL, p.signerConfig.TLSConfig())
+	if err != nil {
+		return nil, err
+	}
+	p.signer = client
+	return client, nil
+}
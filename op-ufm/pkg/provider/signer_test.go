@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	signerTest "github.com/ethereum-optimism/optimism/op-service/signer/test"
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/config"
+)
+
+func TestSignWithRemoteSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	httpServer, ethAPI := signerTest.NewMockSigner(t, key)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	wallet := &config.WalletConfig{
+		ChainID:      *big.NewInt(10),
+		SignerMethod: "signer",
+		Address:      from.Hex(),
+	}
+	p := New("test", &config.ProviderConfig{}, &config.SignerServiceConfig{URL: httpServer.URL}, wallet, nil, nil)
+	defer p.Shutdown()
+
+	for i := 0; i < 2; i++ {
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   &wallet.ChainID,
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       21_000,
+			To:        &common.Address{0xaa},
+		})
+		signed, err := p.sign(context.Background(), &from, tx)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(&wallet.ChainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, from, sender)
+	}
+	require.Equal(t, int64(2), ethAPI.Calls.Load())
+
+	// the signer client is created once and reused for all transactions
+	client, err := p.signerClient()
+	require.NoError(t, err)
+	require.Same(t, p.signer, client)
+}
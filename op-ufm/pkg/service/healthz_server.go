@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/slo"
+
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 )
@@ -11,11 +15,17 @@ import (
 type HealthzServer struct {
 	ctx    context.Context
 	server *http.Server
+
+	// SLO is optional, and serves its status if set
+	SLO *slo.Monitor
 }
 
 func (h *HealthzServer) Start(ctx context.Context, addr string) error {
 	hdlr := mux.NewRouter()
 	hdlr.HandleFunc("/healthz", h.Handle).Methods("GET")
+	if h.SLO != nil {
+		hdlr.HandleFunc("/slo", h.HandleSLO).Methods("GET")
+	}
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
 	})
@@ -35,3 +45,11 @@ func (h *HealthzServer) Shutdown() error {
 func (h *HealthzServer) Handle(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte("OK"))
 }
+
+func (h *HealthzServer) HandleSLO(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(h.SLO.Status()); err != nil {
+		log.Error("error encoding slo status",
+			"err", err)
+	}
+}
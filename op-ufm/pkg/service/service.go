@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-ufm/pkg/config"
 	"github.com/ethereum-optimism/optimism/op-ufm/pkg/metrics"
 	"github.com/ethereum-optimism/optimism/op-ufm/pkg/provider"
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/slo"
 
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -15,6 +16,7 @@ type Service struct {
 	Config    *config.Config
 	Healthz   *HealthzServer
 	Metrics   *MetricsServer
+	SLO       *slo.Monitor
 	Providers map[string]*provider.Provider
 }
 
@@ -30,6 +32,13 @@ func New(cfg *config.Config) *Service {
 
 func (s *Service) Start(ctx context.Context) {
 	log.Info("service starting")
+	if s.Config.SLO.Enabled {
+		s.SLO = slo.New(&s.Config.SLO)
+		s.SLO.Start(ctx)
+		s.Healthz.SLO = s.SLO
+		log.Info("slo monitor started")
+	}
+
 	if s.Config.Healthz.Enabled {
 		addr := net.JoinHostPort(s.Config.Healthz.Host, s.Config.Healthz.Port)
 		log.Info("starting healthz server",
@@ -79,7 +88,8 @@ func (s *Service) Start(ctx context.Context) {
 			providerConfig,
 			&s.Config.Signer,
 			s.Config.Wallets[providerConfig.Wallet],
-			(*txpool)[providerConfig.Network])
+			(*txpool)[providerConfig.Network],
+			s.SLO)
 		s.Providers[name].Start(ctx)
 		log.Info("provider started",
 			"provider", name)
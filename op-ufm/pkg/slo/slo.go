@@ -0,0 +1,275 @@
+package slo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/config"
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/metrics"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	MeasureFirstSeen = "first_seen"
+	MeasureInclusion = "inclusion"
+)
+
+type sample struct {
+	at      time.Time
+	latency time.Duration
+}
+
+type providerSamples struct {
+	firstSeen []sample
+	inclusion []sample
+	failures  []time.Time
+}
+
+// Monitor keeps rolling time-to-first-seen and time-to-inclusion samples per provider,
+// and evaluates them against the configured SLO.
+// A nil Monitor is valid and ignores all samples.
+type Monitor struct {
+	cfg *config.SLOConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	providers map[string]*providerSamples
+	alerting  map[string]bool
+}
+
+func New(cfg *config.SLOConfig) *Monitor {
+	return &Monitor{
+		cfg:       cfg,
+		now:       time.Now,
+		providers: make(map[string]*providerSamples),
+		alerting:  make(map[string]bool),
+	}
+}
+
+// Start periodically updates the SLO metrics and alerts until the context is done
+func (m *Monitor) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Duration(m.cfg.EvaluationInterval))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.Evaluate()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// RecordFirstSeen records the time until a transaction sent through the provider was seen by another provider
+func (m *Monitor) RecordFirstSeen(provider string, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ps := m.samples(provider)
+	ps.firstSeen = append(ps.firstSeen, sample{at: m.now(), latency: latency})
+}
+
+// RecordInclusion records the time until a transaction sent through the provider was included
+func (m *Monitor) RecordInclusion(provider string, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ps := m.samples(provider)
+	ps.inclusion = append(ps.inclusion, sample{at: m.now(), latency: latency})
+}
+
+// RecordFailure records a round trip of the provider that failed to send or include its transaction
+func (m *Monitor) RecordFailure(provider string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ps := m.samples(provider)
+	ps.failures = append(ps.failures, m.now())
+}
+
+func (m *Monitor) samples(provider string) *providerSamples {
+	ps, ok := m.providers[provider]
+	if !ok {
+		ps = &providerSamples{}
+		m.providers[provider] = ps
+	}
+	return ps
+}
+
+type Percentiles struct {
+	Samples int   `json:"samples"`
+	P50     int64 `json:"p50_ms"`
+	P90     int64 `json:"p90_ms"`
+	P99     int64 `json:"p99_ms"`
+}
+
+type WindowStatus struct {
+	Window    string      `json:"window"`
+	FirstSeen Percentiles `json:"first_seen"`
+	Inclusion Percentiles `json:"inclusion"`
+	// RoundTrips counts included and failed round trips
+	RoundTrips     int `json:"round_trips"`
+	Failures       int `json:"failures"`
+	SlowInclusions int `json:"slow_inclusions"`
+	// ErrorBudgetRemaining is the ratio of the error budget left in the window, negative once overspent
+	ErrorBudgetRemaining float64 `json:"error_budget_remaining"`
+}
+
+type ProviderStatus struct {
+	// Alert is set once the error budget is exhausted in every window, so that
+	// a violation has to be both recent and sustained
+	Alert   bool            `json:"alert"`
+	Windows []*WindowStatus `json:"windows"`
+}
+
+type Status struct {
+	Timestamp       time.Time                  `json:"timestamp"`
+	Objective       float64                    `json:"objective"`
+	InclusionTarget string                     `json:"inclusion_target"`
+	Providers       map[string]*ProviderStatus `json:"providers"`
+}
+
+// Status computes the current SLO status of all providers, and drops samples outside of all windows
+func (m *Monitor) Status() *Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.prune(now)
+	status := &Status{
+		Timestamp:       now,
+		Objective:       m.cfg.Objective,
+		InclusionTarget: time.Duration(m.cfg.InclusionTarget).String(),
+		Providers:       make(map[string]*ProviderStatus, len(m.providers)),
+	}
+	for name, ps := range m.providers {
+		providerStatus := &ProviderStatus{}
+		exhausted := 0
+		for _, window := range m.cfg.Windows {
+			ws := m.windowStatus(ps, now.Add(-time.Duration(window)))
+			ws.Window = time.Duration(window).String()
+			providerStatus.Windows = append(providerStatus.Windows, ws)
+			if ws.ErrorBudgetRemaining <= 0 {
+				exhausted++
+			}
+		}
+		providerStatus.Alert = len(m.cfg.Windows) > 0 && exhausted == len(m.cfg.Windows)
+		status.Providers[name] = providerStatus
+	}
+	return status
+}
+
+func (m *Monitor) windowStatus(ps *providerSamples, since time.Time) *WindowStatus {
+	ws := &WindowStatus{
+		FirstSeen: percentiles(ps.firstSeen, since),
+		Inclusion: percentiles(ps.inclusion, since),
+	}
+	for _, s := range ps.inclusion {
+		if s.at.After(since) && s.latency > time.Duration(m.cfg.InclusionTarget) {
+			ws.SlowInclusions++
+		}
+	}
+	for _, at := range ps.failures {
+		if at.After(since) {
+			ws.Failures++
+		}
+	}
+	ws.RoundTrips = ws.Inclusion.Samples + ws.Failures
+	ws.ErrorBudgetRemaining = 1
+	if ws.RoundTrips > 0 {
+		badRatio := float64(ws.Failures+ws.SlowInclusions) / float64(ws.RoundTrips)
+		ws.ErrorBudgetRemaining = 1 - badRatio/(1-m.cfg.Objective)
+	}
+	return ws
+}
+
+// prune drops the samples that are older than the largest window
+func (m *Monitor) prune(now time.Time) {
+	var maxWindow time.Duration
+	for _, window := range m.cfg.Windows {
+		if time.Duration(window) > maxWindow {
+			maxWindow = time.Duration(window)
+		}
+	}
+	since := now.Add(-maxWindow)
+	for _, ps := range m.providers {
+		ps.firstSeen = pruneSamples(ps.firstSeen, since)
+		ps.inclusion = pruneSamples(ps.inclusion, since)
+		i := sort.Search(len(ps.failures), func(i int) bool { return ps.failures[i].After(since) })
+		ps.failures = ps.failures[i:]
+	}
+}
+
+// pruneSamples drops the samples up to since; samples are ordered by time
+func pruneSamples(samples []sample, since time.Time) []sample {
+	i := sort.Search(len(samples), func(i int) bool { return samples[i].at.After(since) })
+	return samples[i:]
+}
+
+func percentiles(samples []sample, since time.Time) Percentiles {
+	latencies := make([]time.Duration, 0, len(samples))
+	for _, s := range samples {
+		if s.at.After(since) {
+			latencies = append(latencies, s.latency)
+		}
+	}
+	if len(latencies) == 0 {
+		return Percentiles{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	return Percentiles{
+		Samples: len(latencies),
+		P50:     percentile(latencies, 50).Milliseconds(),
+		P90:     percentile(latencies, 90).Milliseconds(),
+		P99:     percentile(latencies, 99).Milliseconds(),
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// Evaluate updates the SLO metrics, and logs providers that start or stop violating their SLO
+func (m *Monitor) Evaluate() *Status {
+	status := m.Status()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, ps := range status.Providers {
+		for _, ws := range ps.Windows {
+			for measure, p := range map[string]Percentiles{MeasureFirstSeen: ws.FirstSeen, MeasureInclusion: ws.Inclusion} {
+				metrics.RecordSLOLatency(name, ws.Window, measure, "0.5", time.Duration(p.P50)*time.Millisecond)
+				metrics.RecordSLOLatency(name, ws.Window, measure, "0.9", time.Duration(p.P90)*time.Millisecond)
+				metrics.RecordSLOLatency(name, ws.Window, measure, "0.99", time.Duration(p.P99)*time.Millisecond)
+			}
+			metrics.RecordSLOErrorBudgetRemaining(name, ws.Window, ws.ErrorBudgetRemaining)
+		}
+		metrics.RecordSLOAlert(name, ps.Alert)
+
+		if ps.Alert && !m.alerting[name] {
+			log.Warn("provider SLO violated, error budget exhausted",
+				"provider", name,
+				"objective", status.Objective)
+		} else if !ps.Alert && m.alerting[name] {
+			log.Info("provider SLO recovered",
+				"provider", name)
+		}
+		m.alerting[name] = ps.Alert
+	}
+	return status
+}
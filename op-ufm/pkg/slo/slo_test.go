@@ -0,0 +1,124 @@
+package slo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-ufm/pkg/config"
+)
+
+func testMonitor() (*Monitor, *time.Time) {
+	now := time.Unix(1_700_000_000, 0)
+	m := New(&config.SLOConfig{
+		Enabled:            true,
+		Windows:            []config.TOMLDuration{config.TOMLDuration(time.Minute), config.TOMLDuration(time.Hour)},
+		InclusionTarget:    config.TOMLDuration(10 * time.Second),
+		Objective:          0.9,
+		EvaluationInterval: config.TOMLDuration(time.Second),
+	})
+	m.now = func() time.Time { return now }
+	return m, &now
+}
+
+func TestPercentiles(t *testing.T) {
+	m, _ := testMonitor()
+	for i := 1; i <= 100; i++ {
+		m.RecordFirstSeen("p1", time.Duration(i)*time.Millisecond)
+		m.RecordInclusion("p1", time.Duration(i)*100*time.Millisecond)
+	}
+
+	status := m.Status()
+	require.Len(t, status.Providers, 1)
+	windows := status.Providers["p1"].Windows
+	require.Len(t, windows, 2)
+	require.Equal(t, "1m0s", windows[0].Window)
+	require.Equal(t, Percentiles{Samples: 100, P50: 50, P90: 90, P99: 99}, windows[0].FirstSeen)
+	require.Equal(t, Percentiles{Samples: 100, P50: 5000, P90: 9000, P99: 9900}, windows[0].Inclusion)
+	require.Equal(t, 100, windows[0].RoundTrips)
+	require.Zero(t, windows[0].SlowInclusions)
+	require.Equal(t, 1.0, windows[0].ErrorBudgetRemaining)
+	require.False(t, status.Providers["p1"].Alert)
+}
+
+func TestErrorBudget(t *testing.T) {
+	m, now := testMonitor()
+
+	// 1 bad round trip out of 20 spends half of the 10% error budget
+	for i := 0; i < 19; i++ {
+		m.RecordInclusion("p1", time.Second)
+	}
+	m.RecordInclusion("p1", time.Minute)
+	// a healthy provider is not affected by the other provider
+	m.RecordInclusion("p2", time.Second)
+
+	status := m.Status()
+	window := status.Providers["p1"].Windows[0]
+	require.Equal(t, 20, window.RoundTrips)
+	require.Equal(t, 1, window.SlowInclusions)
+	require.InDelta(t, 0.5, window.ErrorBudgetRemaining, 1e-9)
+	require.False(t, status.Providers["p1"].Alert)
+
+	// failures exhaust the budget of both windows
+	*now = now.Add(30 * time.Second)
+	m.RecordFailure("p1")
+	m.RecordFailure("p1")
+	status = m.Evaluate()
+	for _, window := range status.Providers["p1"].Windows {
+		require.Equal(t, 22, window.RoundTrips)
+		require.Equal(t, 2, window.Failures)
+		require.LessOrEqual(t, window.ErrorBudgetRemaining, 0.0)
+	}
+	require.True(t, status.Providers["p1"].Alert)
+	require.True(t, m.alerting["p1"])
+	require.False(t, status.Providers["p2"].Alert)
+
+	// once the failures leave the short window, the long window alone doesn't alert
+	*now = now.Add(2 * time.Minute)
+	status = m.Evaluate()
+	require.Zero(t, status.Providers["p1"].Windows[0].RoundTrips)
+	require.Equal(t, 1.0, status.Providers["p1"].Windows[0].ErrorBudgetRemaining)
+	require.Less(t, status.Providers["p1"].Windows[1].ErrorBudgetRemaining, 0.0)
+	require.False(t, status.Providers["p1"].Alert)
+	require.False(t, m.alerting["p1"])
+}
+
+func TestPruning(t *testing.T) {
+	m, now := testMonitor()
+	m.RecordInclusion("p1", time.Second)
+	m.RecordFirstSeen("p1", time.Second)
+	m.RecordFailure("p1")
+
+	*now = now.Add(time.Hour + time.Second)
+	m.RecordInclusion("p1", 2*time.Second)
+
+	status := m.Status()
+	require.Equal(t, 1, status.Providers["p1"].Windows[1].RoundTrips)
+	require.Len(t, m.providers["p1"].inclusion, 1)
+	require.Empty(t, m.providers["p1"].firstSeen)
+	require.Empty(t, m.providers["p1"].failures)
+}
+
+func TestStatusJSON(t *testing.T) {
+	m, _ := testMonitor()
+	m.RecordInclusion("p1", 1500*time.Millisecond)
+
+	data, err := json.Marshal(m.Status())
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, 0.9, decoded["objective"])
+	require.Equal(t, "10s", decoded["inclusion_target"])
+	p1 := decoded["providers"].(map[string]any)["p1"].(map[string]any)
+	window := p1["windows"].([]any)[0].(map[string]any)
+	require.Equal(t, float64(1500), window["inclusion"].(map[string]any)["p99_ms"])
+}
+
+func TestNilMonitor(t *testing.T) {
+	var m *Monitor
+	m.RecordFirstSeen("p1", time.Second)
+	m.RecordInclusion("p1", time.Second)
+	m.RecordFailure("p1")
+}
@@ -0,0 +1,188 @@
+package cheat
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// keyPreimager resolves the preimage of a hashed trie key, if the database has it.
+type keyPreimager interface {
+	GetKey([]byte) []byte
+}
+
+// mergeTries walks the leaves of two secure tries in key order, and calls fn for every key
+// of which the value differs between the tries. The value is nil if the key is missing in that trie.
+func mergeTries(a, b trie.NodeIterator, fn func(key, aValue, bValue []byte) error) error {
+	aIter := trie.NewIterator(a)
+	bIter := trie.NewIterator(b)
+	hasA := aIter.Next()
+	hasB := bIter.Next()
+	for hasA || hasB {
+		cmp := 0
+		switch {
+		case !hasA:
+			cmp = 1
+		case !hasB:
+			cmp = -1
+		default:
+			cmp = bytes.Compare(aIter.Key, bIter.Key)
+		}
+		if cmp < 0 {
+			// a is smaller, and thus missing in b
+			if err := fn(aIter.Key, aIter.Value, nil); err != nil {
+				return err
+			}
+			hasA = aIter.Next()
+		} else if cmp > 0 {
+			// b is smaller, and thus missing in a
+			if err := fn(bIter.Key, nil, bIter.Value); err != nil {
+				return err
+			}
+			hasB = bIter.Next()
+		} else {
+			if !bytes.Equal(aIter.Value, bIter.Value) {
+				if err := fn(aIter.Key, aIter.Value, bIter.Value); err != nil {
+					return err
+				}
+			}
+			hasA = aIter.Next()
+			hasB = bIter.Next()
+		}
+	}
+	if aIter.Err != nil {
+		return aIter.Err
+	}
+	return bIter.Err
+}
+
+// StateDiff compares the state of two blocks, and writes the changed accounts and storage.
+//
+// Each changed account starts with a "# account" comment line, followed by comment lines with
+// the changes of the balance, nonce and code-hash. Changed storage slots follow in the format of
+// StorageDiff, so the storage changes of an account can be applied with StoragePatch.
+// Storage slots of which the key preimage is not known are written as comments with the hashed key.
+func StateDiff(out io.Writer, db state.Database, a, b *types.Header) error {
+	aTrie, err := db.OpenTrie(a.Root)
+	if err != nil {
+		return fmt.Errorf("failed to open state of block A %d: %w", a.Number, err)
+	}
+	bTrie, err := db.OpenTrie(b.Root)
+	if err != nil {
+		return fmt.Errorf("failed to open state of block B %d: %w", b.Number, err)
+	}
+	aNodeIter, err := aTrie.NodeIterator(nil)
+	if err != nil {
+		return fmt.Errorf("failed to create node iterator for state of block A: %w", err)
+	}
+	bNodeIter, err := bTrie.NodeIterator(nil)
+	if err != nil {
+		return fmt.Errorf("failed to create node iterator for state of block B: %w", err)
+	}
+	return mergeTries(aNodeIter, bNodeIter, func(key, aValue, bValue []byte) error {
+		aAcc, err := decodeAccount(aValue)
+		if err != nil {
+			return fmt.Errorf("failed to decode account %x of block A: %w", key, err)
+		}
+		bAcc, err := decodeAccount(bValue)
+		if err != nil {
+			return fmt.Errorf("failed to decode account %x of block B: %w", key, err)
+		}
+		addrHash := common.BytesToHash(key)
+		name := "hashed " + addrHash.String()
+		if preimage := bTrie.GetKey(key); preimage != nil {
+			name = common.BytesToAddress(preimage).String()
+		}
+		switch {
+		case aValue == nil:
+			name += " (created)"
+		case bValue == nil:
+			name += " (deleted)"
+		}
+		if _, err := fmt.Fprintf(out, "# account %s\n", name); err != nil {
+			return err
+		}
+		if aAcc.Balance.Cmp(bAcc.Balance) != 0 {
+			if _, err := fmt.Fprintf(out, "# balance: %s -> %s\n", aAcc.Balance, bAcc.Balance); err != nil {
+				return err
+			}
+		}
+		if aAcc.Nonce != bAcc.Nonce {
+			if _, err := fmt.Fprintf(out, "# nonce: %d -> %d\n", aAcc.Nonce, bAcc.Nonce); err != nil {
+				return err
+			}
+		}
+		if !bytes.Equal(aAcc.CodeHash, bAcc.CodeHash) {
+			if _, err := fmt.Fprintf(out, "# code: %s -> %s\n",
+				common.BytesToHash(aAcc.CodeHash), common.BytesToHash(bAcc.CodeHash)); err != nil {
+				return err
+			}
+		}
+		if aAcc.Root == bAcc.Root {
+			return nil
+		}
+		aStorage, err := openStorageIterator(db, a.Root, addrHash, aAcc.Root)
+		if err != nil {
+			return fmt.Errorf("failed to open storage of account %s in block A: %w", name, err)
+		}
+		bStorage, err := openStorageIterator(db, b.Root, addrHash, bAcc.Root)
+		if err != nil {
+			return fmt.Errorf("failed to open storage of account %s in block B: %w", name, err)
+		}
+		return mergeTries(aStorage, bStorage, func(key, aValue, bValue []byte) error {
+			return writeStorageDiff(out, bTrie, key, aValue, bValue)
+		})
+	})
+}
+
+// decodeAccount decodes the account from the state trie, or returns an empty account if enc is nil.
+func decodeAccount(enc []byte) (*types.StateAccount, error) {
+	if enc == nil {
+		return types.NewEmptyStateAccount(), nil
+	}
+	var acc types.StateAccount
+	if err := rlp.DecodeBytes(enc, &acc); err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// openStorageIterator iterates the storage of the account, or nothing if the storage is empty.
+func openStorageIterator(db state.Database, stateRoot, addrHash, storageRoot common.Hash) (trie.NodeIterator, error) {
+	if storageRoot == types.EmptyRootHash {
+		return trie.NewEmpty(db.TrieDB()).NodeIterator(nil)
+	}
+	storage, err := trie.NewStateTrie(trie.StorageTrieID(stateRoot, addrHash, storageRoot), db.TrieDB())
+	if err != nil {
+		return nil, err
+	}
+	return storage.NodeIterator(nil)
+}
+
+func writeStorageDiff(out io.Writer, preimages keyPreimager, key, aValue, bValue []byte) error {
+	prefix := ""
+	slot := common.BytesToHash(key)
+	if preimage := preimages.GetKey(key); preimage != nil {
+		slot = common.BytesToHash(preimage)
+	} else {
+		// without the preimage, the slot cannot be patched, so only write it as a comment
+		prefix = "# hashed "
+	}
+	if aValue != nil {
+		if _, err := fmt.Fprintf(out, "%s- %s = %s\n", prefix, slot, dbValueToHash(aValue)); err != nil {
+			return err
+		}
+	}
+	if bValue != nil {
+		if _, err := fmt.Fprintf(out, "%s+ %s = %s\n", prefix, slot, dbValueToHash(bValue)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cheat
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateDiff(t *testing.T) {
+	db := newTestStateDB()
+	a := newTestState(t, db)
+	created := common.Address{0x11}
+	b := commitState(t, db, a.Root, 2, func(s *state.StateDB) {
+		// changed account
+		s.SetBalance(testEOA, big.NewInt(2000))
+		s.SetNonce(testEOA, 4)
+		// deleted account
+		s.SelfDestruct(testContract)
+		// created account
+		s.SetBalance(created, big.NewInt(1))
+		s.SetState(created, common.Hash{0x03}, common.Hash{0xcc})
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, StateDiff(&buf, db, a, b))
+
+	// group the lines by account, the accounts and slots are in order of their hashed keys
+	diff := make(map[string][]string)
+	var account string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if strings.HasPrefix(line, "# account ") {
+			account = strings.TrimPrefix(line, "# account ")
+			continue
+		}
+		require.NotEmpty(t, account, "changes must follow an account line")
+		diff[account] = append(diff[account], line)
+	}
+
+	require.Len(t, diff, 3)
+	require.Equal(t, []string{
+		"# balance: 1000 -> 2000",
+		"# nonce: 3 -> 4",
+	}, diff[testEOA.String()])
+	require.ElementsMatch(t, []string{
+		"# nonce: 1 -> 0",
+		"# code: " + crypto.Keccak256Hash(testCode).String() + " -> " + types.EmptyCodeHash.String(),
+		"- " + common.Hash{0x01}.String() + " = " + common.Hash{0xaa}.String(),
+		"- " + common.Hash{0x02}.String() + " = " + common.Hash{0xbb}.String(),
+	}, diff[testContract.String()+" (deleted)"])
+	require.Equal(t, []string{
+		"# balance: 0 -> 1",
+		"+ " + common.Hash{0x03}.String() + " = " + common.Hash{0xcc}.String(),
+	}, diff[created.String()+" (created)"])
+}
+
+func TestStateDiffUnchanged(t *testing.T) {
+	db := newTestStateDB()
+	a := newTestState(t, db)
+
+	var buf bytes.Buffer
+	require.NoError(t, StateDiff(&buf, db, a, a))
+	require.Empty(t, buf.String())
+}
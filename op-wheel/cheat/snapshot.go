@@ -0,0 +1,293 @@
+package cheat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+type SnapshotFormat string
+
+const (
+	// SnapshotJSON encodes the snapshot as a single JSON object. Useful for small filtered snapshots.
+	SnapshotJSON SnapshotFormat = "json"
+	// SnapshotJSONL encodes the snapshot header on the first line, followed by one account per line.
+	// The accounts are streamed, so this format is suitable for whole-state snapshots.
+	SnapshotJSONL SnapshotFormat = "jsonl"
+)
+
+var SnapshotFormats = []SnapshotFormat{SnapshotJSON, SnapshotJSONL}
+
+func (f SnapshotFormat) String() string {
+	return string(f)
+}
+
+func (f *SnapshotFormat) Set(value string) error {
+	switch SnapshotFormat(value) {
+	case SnapshotJSON, SnapshotJSONL:
+		*f = SnapshotFormat(value)
+		return nil
+	default:
+		return fmt.Errorf("unknown snapshot format: %q", value)
+	}
+}
+
+func (f *SnapshotFormat) Clone() any {
+	cpy := *f
+	return &cpy
+}
+
+// SnapshotHeader identifies the block that a state snapshot was taken at.
+type SnapshotHeader struct {
+	Number    hexutil.Uint64 `json:"number"`
+	Hash      common.Hash    `json:"hash"`
+	StateRoot common.Hash    `json:"stateRoot"`
+	// Filtered is true if the snapshot only contains a selection of the accounts.
+	Filtered bool `json:"filtered,omitempty"`
+}
+
+// SnapshotAccount is the complete state of a single account.
+//
+// The state trie is keyed by hashes of addresses and storage keys.
+// The original address and keys can only be recovered from the preimages that geth stored alongside the trie,
+// which is only the case for nodes that run with --cache.preimages.
+// Data of which the preimage is missing is exported by hashed key, and cannot be imported again.
+type SnapshotAccount struct {
+	Address *common.Address `json:"address,omitempty"`
+	// AddressHash is set instead of the address if the address preimage is not known.
+	AddressHash *common.Hash `json:"addressHash,omitempty"`
+
+	Balance *hexutil.Big   `json:"balance"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	Code    hexutil.Bytes  `json:"code,omitempty"`
+
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	// HashedStorage holds the storage slots of which the key preimage is not known, by hashed key.
+	HashedStorage map[common.Hash]common.Hash `json:"hashedStorage,omitempty"`
+}
+
+// Snapshot is a portable snapshot of (a part of) the state at a block.
+// In the JSONL format the accounts are not part of the header object, but follow it one per line.
+type Snapshot struct {
+	Header   SnapshotHeader     `json:"header"`
+	Accounts []*SnapshotAccount `json:"accounts,omitempty"`
+}
+
+// StateExport writes a snapshot of the head state to w.
+// If addresses are specified, only these accounts are exported, otherwise the whole state is exported.
+func StateExport(w io.Writer, format SnapshotFormat, addresses []common.Address) HeadFn {
+	return func(head *types.Header, headState *state.StateDB) error {
+		snapshot := &Snapshot{Header: SnapshotHeader{
+			Number:    hexutil.Uint64(head.Number.Uint64()),
+			Hash:      head.Hash(),
+			StateRoot: head.Root,
+			Filtered:  len(addresses) > 0,
+		}}
+		enc := json.NewEncoder(w)
+		var onAccount func(acc *SnapshotAccount) error
+		switch format {
+		case SnapshotJSON:
+			enc.SetIndent("", "  ")
+			onAccount = func(acc *SnapshotAccount) error {
+				snapshot.Accounts = append(snapshot.Accounts, acc)
+				return nil
+			}
+		case SnapshotJSONL:
+			if err := enc.Encode(snapshot); err != nil {
+				return fmt.Errorf("failed to write snapshot header: %w", err)
+			}
+			onAccount = func(acc *SnapshotAccount) error {
+				return enc.Encode(acc)
+			}
+		default:
+			return fmt.Errorf("unknown snapshot format: %q", format)
+		}
+
+		db := headState.Database()
+		if len(addresses) > 0 {
+			for _, addr := range addresses {
+				if !headState.Exist(addr) {
+					return fmt.Errorf("account %s does not exist in head state", addr)
+				}
+				addrHash := crypto.Keccak256Hash(addr.Bytes())
+				acc, err := exportAccount(db, head.Root, addrHash, headState.GetStorageRoot(addr))
+				if err != nil {
+					return fmt.Errorf("failed to export account %s: %w", addr, err)
+				}
+				addr := addr
+				acc.Address = &addr
+				acc.Balance = (*hexutil.Big)(headState.GetBalance(addr))
+				acc.Nonce = hexutil.Uint64(headState.GetNonce(addr))
+				acc.Code = headState.GetCode(addr)
+				if err := onAccount(acc); err != nil {
+					return err
+				}
+			}
+		} else {
+			accTrie, err := db.OpenTrie(head.Root)
+			if err != nil {
+				return fmt.Errorf("failed to open account trie: %w", err)
+			}
+			nodeIter, err := accTrie.NodeIterator(nil)
+			if err != nil {
+				return fmt.Errorf("failed to create node iterator for account trie: %w", err)
+			}
+			iter := trie.NewIterator(nodeIter)
+			for iter.Next() {
+				var data types.StateAccount
+				if err := rlp.DecodeBytes(iter.Value, &data); err != nil {
+					return fmt.Errorf("failed to decode account %x: %w", iter.Key, err)
+				}
+				addrHash := common.BytesToHash(iter.Key)
+				acc, err := exportAccount(db, head.Root, addrHash, data.Root)
+				if err != nil {
+					return fmt.Errorf("failed to export account %s: %w", addrHash, err)
+				}
+				var addr common.Address
+				if preimage := accTrie.GetKey(iter.Key); preimage != nil {
+					addr = common.BytesToAddress(preimage)
+					acc.Address = &addr
+				} else {
+					acc.AddressHash = &addrHash
+				}
+				acc.Balance = (*hexutil.Big)(data.Balance)
+				acc.Nonce = hexutil.Uint64(data.Nonce)
+				if codeHash := common.BytesToHash(data.CodeHash); codeHash != types.EmptyCodeHash {
+					code, err := db.ContractCode(addr, codeHash)
+					if err != nil {
+						return fmt.Errorf("failed to read code of account %s: %w", addrHash, err)
+					}
+					acc.Code = code
+				}
+				if err := onAccount(acc); err != nil {
+					return err
+				}
+			}
+			if iter.Err != nil {
+				return fmt.Errorf("failed to iterate account trie: %w", iter.Err)
+			}
+		}
+
+		if format == SnapshotJSON {
+			return enc.Encode(snapshot)
+		}
+		return nil
+	}
+}
+
+// exportAccount reads the storage of the account with the given address hash and storage root.
+func exportAccount(db state.Database, stateRoot common.Hash, addrHash common.Hash, storageRoot common.Hash) (*SnapshotAccount, error) {
+	acc := &SnapshotAccount{}
+	if storageRoot == types.EmptyRootHash || storageRoot == (common.Hash{}) {
+		return acc, nil
+	}
+	storage, err := trie.NewStateTrie(trie.StorageTrieID(stateRoot, addrHash, storageRoot), db.TrieDB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open storage trie: %w", err)
+	}
+	nodeIter, err := storage.NodeIterator(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create node iterator for storage: %w", err)
+	}
+	iter := trie.NewIterator(nodeIter)
+	for iter.Next() {
+		value := dbValueToHash(iter.Value)
+		if preimage := storage.GetKey(iter.Key); preimage != nil {
+			if acc.Storage == nil {
+				acc.Storage = make(map[common.Hash]common.Hash)
+			}
+			acc.Storage[common.BytesToHash(preimage)] = value
+		} else {
+			if acc.HashedStorage == nil {
+				acc.HashedStorage = make(map[common.Hash]common.Hash)
+			}
+			acc.HashedStorage[common.BytesToHash(iter.Key)] = value
+		}
+	}
+	if iter.Err != nil {
+		return nil, fmt.Errorf("failed to iterate storage: %w", iter.Err)
+	}
+	return acc, nil
+}
+
+// StateImport reads a snapshot, in either format, and applies it to the head state.
+// Each account in the snapshot replaces the account in the head state entirely, including all of its storage.
+// Accounts of the head state that are not in the snapshot are left as-is:
+// import into a new devnet datadir to reproduce a whole state. Like any cheat, the import is rejected while
+// the chain is still at genesis, so the devnet has to build at least one block first.
+func StateImport(r io.Reader) HeadFn {
+	return func(head *types.Header, headState *state.StateDB) error {
+		dec := json.NewDecoder(r)
+		var snapshot Snapshot
+		if err := dec.Decode(&snapshot); err != nil {
+			return fmt.Errorf("failed to read snapshot header: %w", err)
+		}
+		i := 0
+		apply := func(acc *SnapshotAccount) error {
+			if err := importAccount(headState, acc); err != nil {
+				return err
+			}
+			i += 1 + len(acc.Storage)
+			if i >= 1000 { // for every 1000 values, commit to disk
+				if _, err := headState.Commit(head.Number.Uint64(), true); err != nil {
+					return fmt.Errorf("failed to commit state to disk after importing account %s: %w", acc.Address, err)
+				}
+				i = 0
+			}
+			return nil
+		}
+		for _, acc := range snapshot.Accounts {
+			if err := apply(acc); err != nil {
+				return err
+			}
+		}
+		for {
+			var acc SnapshotAccount
+			if err := dec.Decode(&acc); errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("failed to read snapshot account: %w", err)
+			}
+			if err := apply(&acc); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func importAccount(headState *state.StateDB, acc *SnapshotAccount) error {
+	if acc.Address == nil {
+		if acc.AddressHash != nil {
+			return fmt.Errorf("account %s has no address preimage, export from a node with preimages to import it", acc.AddressHash)
+		}
+		return errors.New("account has no address")
+	}
+	addr := *acc.Address
+	if len(acc.HashedStorage) > 0 {
+		return fmt.Errorf("account %s has %d storage slots without key preimage, export from a node with preimages to import it", addr, len(acc.HashedStorage))
+	}
+	// Creating the account over the existing one drops the existing storage.
+	headState.CreateAccount(addr)
+	if acc.Balance != nil {
+		headState.SetBalance(addr, acc.Balance.ToInt())
+	} else {
+		headState.SetBalance(addr, common.Big0)
+	}
+	headState.SetNonce(addr, uint64(acc.Nonce))
+	if len(acc.Code) > 0 {
+		headState.SetCode(addr, acc.Code)
+	}
+	for k, v := range acc.Storage {
+		headState.SetState(addr, k, v)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package cheat
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEOA      = common.Address{0xee}
+	testContract = common.Address{0xcc}
+	testCode     = []byte{0x60, 0x00, 0x60, 0x00, 0xfd}
+)
+
+// newTestStateDB returns an in-memory state database that keeps the preimages of the trie keys,
+// like a node that runs with --cache.preimages.
+func newTestStateDB() state.Database {
+	return state.NewDatabaseWithConfig(rawdb.NewMemoryDatabase(), &trie.Config{Preimages: true})
+}
+
+// commitState applies fn to the state at the given root, and returns the header of the resulting state.
+func commitState(t *testing.T, db state.Database, root common.Hash, number uint64, fn func(s *state.StateDB)) *types.Header {
+	s, err := state.New(root, db, nil)
+	require.NoError(t, err)
+	fn(s)
+	root, err = s.Commit(number, true)
+	require.NoError(t, err)
+	return &types.Header{Number: new(big.Int).SetUint64(number), Root: root}
+}
+
+// newTestState returns the header of a state with an EOA and a contract with storage.
+func newTestState(t *testing.T, db state.Database) *types.Header {
+	return commitState(t, db, types.EmptyRootHash, 1, func(s *state.StateDB) {
+		s.SetBalance(testEOA, big.NewInt(1000))
+		s.SetNonce(testEOA, 3)
+		s.SetNonce(testContract, 1)
+		s.SetCode(testContract, testCode)
+		s.SetState(testContract, common.Hash{0x01}, common.Hash{0xaa})
+		s.SetState(testContract, common.Hash{0x02}, common.Hash{0xbb})
+	})
+}
+
+// runHeadFn runs fn on the state of the given header, and commits the changes.
+func runHeadFn(t *testing.T, db state.Database, head *types.Header, fn HeadFn) (*types.Header, error) {
+	s, err := state.New(head.Root, db, nil)
+	require.NoError(t, err)
+	if err := fn(head, s); err != nil {
+		return nil, err
+	}
+	root, err := s.Commit(head.Number.Uint64(), true)
+	require.NoError(t, err)
+	return &types.Header{Number: head.Number, Root: root}, nil
+}
+
+func TestStateExportImportRoundTrip(t *testing.T) {
+	for _, format := range SnapshotFormats {
+		format := format
+		t.Run(format.String(), func(t *testing.T) {
+			db := newTestStateDB()
+			head := newTestState(t, db)
+
+			var buf bytes.Buffer
+			_, err := runHeadFn(t, db, head, StateExport(&buf, format, nil))
+			require.NoError(t, err)
+
+			// import into a fresh state
+			otherDB := newTestStateDB()
+			empty := &types.Header{Number: big.NewInt(1), Root: types.EmptyRootHash}
+			imported, err := runHeadFn(t, otherDB, empty, StateImport(&buf))
+			require.NoError(t, err)
+			require.Equal(t, head.Root, imported.Root)
+
+			s, err := state.New(imported.Root, otherDB, nil)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1000), s.GetBalance(testEOA))
+			require.Equal(t, uint64(3), s.GetNonce(testEOA))
+			require.Equal(t, testCode, s.GetCode(testContract))
+			require.Equal(t, common.Hash{0xbb}, s.GetState(testContract, common.Hash{0x02}))
+		})
+	}
+}
+
+func TestStateExportJSONL(t *testing.T) {
+	db := newTestStateDB()
+	head := newTestState(t, db)
+
+	var buf bytes.Buffer
+	_, err := runHeadFn(t, db, head, StateExport(&buf, SnapshotJSONL, nil))
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3, "header and one line per account")
+	var snapshot Snapshot
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &snapshot))
+	require.Equal(t, head.Root, snapshot.Header.StateRoot)
+	require.False(t, snapshot.Header.Filtered)
+	require.Empty(t, snapshot.Accounts)
+}
+
+func TestStateExportFiltered(t *testing.T) {
+	db := newTestStateDB()
+	head := newTestState(t, db)
+
+	var buf bytes.Buffer
+	_, err := runHeadFn(t, db, head, StateExport(&buf, SnapshotJSON, []common.Address{testContract}))
+	require.NoError(t, err)
+
+	var snapshot Snapshot
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &snapshot))
+	require.True(t, snapshot.Header.Filtered)
+	require.Equal(t, hexutil.Uint64(1), snapshot.Header.Number)
+	require.Len(t, snapshot.Accounts, 1)
+	acc := snapshot.Accounts[0]
+	require.Equal(t, testContract, *acc.Address)
+	require.Nil(t, acc.AddressHash)
+	require.Equal(t, hexutil.Uint64(1), acc.Nonce)
+	require.Equal(t, hexutil.Bytes(testCode), acc.Code)
+	require.Equal(t, map[common.Hash]common.Hash{
+		{0x01}: {0xaa},
+		{0x02}: {0xbb},
+	}, acc.Storage)
+	require.Empty(t, acc.HashedStorage)
+
+	_, err = runHeadFn(t, db, head, StateExport(&bytes.Buffer{}, SnapshotJSON, []common.Address{{0x99}}))
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestStateImportRejectsHashedEntries(t *testing.T) {
+	db := newTestStateDB()
+	head := newTestState(t, db)
+	addrHash := common.Hash{0x01}
+	addr := testEOA
+
+	tests := []struct {
+		name    string
+		account SnapshotAccount
+		err     string
+	}{
+		{
+			name:    "HashedAddress",
+			account: SnapshotAccount{AddressHash: &addrHash, Balance: (*hexutil.Big)(big.NewInt(1))},
+			err:     "has no address preimage",
+		},
+		{
+			name: "HashedStorage",
+			account: SnapshotAccount{
+				Address:       &addr,
+				Balance:       (*hexutil.Big)(big.NewInt(1)),
+				HashedStorage: map[common.Hash]common.Hash{{0x01}: {0x02}},
+			},
+			err: "storage slots without key preimage",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(&Snapshot{Accounts: []*SnapshotAccount{&test.account}})
+			require.NoError(t, err)
+			_, err = runHeadFn(t, db, head, StateImport(bytes.NewReader(data)))
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
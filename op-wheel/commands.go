@@ -1,6 +1,7 @@
 package wheel
 
 import (
+	"bufio"
 	"context"
 	"encoding"
 	"encoding/json"
@@ -283,6 +284,139 @@ var (
 			CheatStoragePatchCmd,
 		},
 	}
+	CheatStateExportCmd = &cli.Command{
+		Name:    "export",
+		Aliases: []string{"dump"},
+		Usage:   "Export a snapshot of the head state, or of selected accounts only",
+		Description: "Addresses and storage keys can only be exported if the node stored their preimages (geth --cache.preimages). " +
+			"Data without preimages is exported by hashed key, and cannot be imported again.",
+		Flags: []cli.Flag{
+			DataDirFlag,
+			&cli.GenericFlag{
+				Name:    "format",
+				Usage:   "Snapshot format, one of: json, jsonl. The jsonl format streams accounts one per line, for whole-state snapshots.",
+				EnvVars: prefixEnvVars("SNAPSHOT_FORMAT"),
+				Value: func() *cheat.SnapshotFormat {
+					f := cheat.SnapshotJSONL
+					return &f
+				}(),
+			},
+			&cli.StringSliceFlag{
+				Name:    "address",
+				Usage:   "Address of account to export, may be repeated. All accounts are exported if none are specified.",
+				EnvVars: prefixEnvVars("SNAPSHOT_ADDRESSES"),
+			},
+			&cli.StringFlag{
+				Name:      "out",
+				Usage:     "File to write the snapshot to, instead of STDOUT",
+				TakesFile: true,
+				EnvVars:   prefixEnvVars("SNAPSHOT_OUT"),
+			},
+		},
+		Action: CheatAction(true, func(ctx *cli.Context, ch *cheat.Cheater) error {
+			var addresses []common.Address
+			for _, addrStr := range ctx.StringSlice("address") {
+				addr, err := opservice.ParseAddress(addrStr)
+				if err != nil {
+					_ = ch.Close()
+					return fmt.Errorf("invalid address %q: %w", addrStr, err)
+				}
+				addresses = append(addresses, addr)
+			}
+			format := *ctx.Generic("format").(*cheat.SnapshotFormat)
+			path := ctx.String("out")
+			if path == "" {
+				return ch.RunAndClose(cheat.StateExport(ctx.App.Writer, format, addresses))
+			}
+			f, err := os.Create(path)
+			if err != nil {
+				_ = ch.Close()
+				return fmt.Errorf("failed to create snapshot file: %w", err)
+			}
+			defer f.Close()
+			w := bufio.NewWriter(f)
+			if err := ch.RunAndClose(cheat.StateExport(w, format, addresses)); err != nil {
+				return err
+			}
+			if err := w.Flush(); err != nil {
+				return fmt.Errorf("failed to write snapshot file: %w", err)
+			}
+			return f.Close()
+		}),
+	}
+	CheatStateImportCmd = &cli.Command{
+		Name:  "import",
+		Usage: "Import a state snapshot into the head state",
+		Description: "Each account in the snapshot replaces the account in the head state, including its storage. " +
+			"Accounts that are not in the snapshot are left as-is. Import into a new devnet datadir to reproduce a whole state. " +
+			"The head of the chain must be at least one block past genesis, cheating at genesis is not supported.",
+		Flags: []cli.Flag{
+			DataDirFlag,
+			&cli.StringFlag{
+				Name:      "in",
+				Usage:     "File to read the snapshot from, instead of STDIN",
+				TakesFile: true,
+				EnvVars:   prefixEnvVars("SNAPSHOT_IN"),
+			},
+		},
+		Action: CheatAction(false, func(ctx *cli.Context, ch *cheat.Cheater) error {
+			r := ctx.App.Reader
+			if path := ctx.String("in"); path != "" {
+				f, err := os.Open(path)
+				if err != nil {
+					_ = ch.Close()
+					return fmt.Errorf("failed to open snapshot file: %w", err)
+				}
+				defer f.Close()
+				r = bufio.NewReader(f)
+			}
+			return ch.RunAndClose(cheat.StateImport(r))
+		}),
+	}
+	CheatStateDiffCmd = &cli.Command{
+		Name:  "diff",
+		Usage: "Diff the state of two blocks, listing the changed accounts and storage",
+		Description: "The state of both blocks must still be available in the database. " +
+			"The storage changes of each account are formatted as a storage patch.",
+		Flags: []cli.Flag{
+			DataDirFlag,
+			&cli.Uint64Flag{
+				Name:     "from",
+				Usage:    "Number of the block to diff from",
+				Required: true,
+				EnvVars:  prefixEnvVars("DIFF_FROM"),
+			},
+			&cli.Uint64Flag{
+				Name:    "to",
+				Usage:   "Number of the block to diff to, the head block if not specified",
+				EnvVars: prefixEnvVars("DIFF_TO"),
+			},
+		},
+		Action: CheatAction(true, func(ctx *cli.Context, ch *cheat.Cheater) error {
+			defer ch.Close()
+			from := ch.Blockchain.GetHeaderByNumber(ctx.Uint64("from"))
+			if from == nil {
+				return fmt.Errorf("block %d not found", ctx.Uint64("from"))
+			}
+			to := ch.Blockchain.CurrentBlock()
+			if ctx.IsSet("to") {
+				to = ch.Blockchain.GetHeaderByNumber(ctx.Uint64("to"))
+				if to == nil {
+					return fmt.Errorf("block %d not found", ctx.Uint64("to"))
+				}
+			}
+			return cheat.StateDiff(ctx.App.Writer, ch.Blockchain.StateCache(), from, to)
+		}),
+	}
+	CheatStateCmd = &cli.Command{
+		Name:  "state",
+		Usage: "Export, import and diff the state, e.g. to reproduce production state in a devnet",
+		Subcommands: []*cli.Command{
+			CheatStateExportCmd,
+			CheatStateImportCmd,
+			CheatStateDiffCmd,
+		},
+	}
 	CheatSetBalanceCmd = &cli.Command{
 		Name: "balance",
 		Flags: []cli.Flag{
@@ -532,6 +666,7 @@ var CheatCmd = &cli.Command{
 		"The Geth node will live in its own false reality, other nodes cannot sync the cheated state if they process the blocks.",
 	Subcommands: []*cli.Command{
 		CheatStorageCmd,
+		CheatStateCmd,
 		CheatSetBalanceCmd,
 		CheatSetCodeCmd,
 		CheatSetNonceCmd,